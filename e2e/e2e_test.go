@@ -438,7 +438,7 @@ var _ = Describe("Central", func() {
 				},
 			}
 
-			_, _, err = adminAPI.UpdateCentralById(context.TODO(), centralID, updateReq)
+			_, _, err = adminAPI.UpdateCentralById(context.TODO(), centralID, updateReq, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Eventually(func() corev1.ResourceRequirements {
 				central := &v1alpha1.Central{}
@@ -553,9 +553,9 @@ var _ = Describe("Central", func() {
 			if createdCentral == nil {
 				Fail("central not created")
 			}
-			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id)
+			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id, nil)
 			Expect(err).ToNot(HaveOccurred())
-			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id)
+			_, err = adminAPI.DeleteDbCentralById(context.TODO(), createdCentral.Id, nil)
 			Expect(err).To(HaveOccurred())
 			central, _, err := client.PublicAPI().GetCentralById(context.TODO(), createdCentral.Id)
 			Expect(err).To(HaveOccurred())
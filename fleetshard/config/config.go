@@ -8,6 +8,7 @@ import (
 
 	"github.com/caarlos0/env/v6"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Config contains this application's runtime configuration.
@@ -17,6 +18,10 @@ type Config struct {
 	ClusterName                       string        `env:"CLUSTER_NAME"`
 	Environment                       string        `env:"ENVIRONMENT"`
 	RuntimePollPeriod                 time.Duration `env:"RUNTIME_POLL_PERIOD" envDefault:"5s"`
+	RuntimeWatchEnabled               bool          `env:"RUNTIME_WATCH_ENABLED" envDefault:"true"`
+	RuntimeWatchTimeout               time.Duration `env:"RUNTIME_WATCH_TIMEOUT" envDefault:"20s"`
+	RuntimeReconcileParallelism       int           `env:"RUNTIME_RECONCILE_PARALLELISM" envDefault:"10"`
+	RuntimeShutdownTimeout            time.Duration `env:"RUNTIME_SHUTDOWN_TIMEOUT" envDefault:"20s"`
 	AuthType                          string        `env:"AUTH_TYPE" envDefault:"RHSSO"`
 	RHSSOClientID                     string        `env:"RHSSO_SERVICE_ACCOUNT_CLIENT_ID"`
 	RHSSOClientSecret                 string        `env:"RHSSO_SERVICE_ACCOUNT_CLIENT_SECRET"`
@@ -28,10 +33,16 @@ type Config struct {
 	MetricsAddress                    string        `env:"FLEETSHARD_METRICS_ADDRESS" envDefault:":8080"`
 	EgressProxyImage                  string        `env:"EGRESS_PROXY_IMAGE"`
 	FeatureFlagUpgradeOperatorEnabled bool          `env:"FEATURE_FLAG_UPGRADE_OPERATOR_ENABLED" envDefault:"false"`
+	// OperatorReconcilePeriod is the interval in which the ACS operator versions requested by fleet-manager are
+	// installed and unused versions are removed, if FEATURE_FLAG_UPGRADE_OPERATOR_ENABLED is set.
+	OperatorReconcilePeriod time.Duration `env:"OPERATOR_RECONCILE_PERIOD" envDefault:"1m"`
+	// Namespace is the namespace fleetshard-sync runs in.
+	Namespace string `env:"NAMESPACE" envDefault:"rhacs"`
 
-	AWS       AWS
-	ManagedDB ManagedDB
-	Telemetry Telemetry
+	AWS           AWS
+	ManagedDB     ManagedDB
+	Telemetry     Telemetry
+	ClusterStatus ClusterStatus
 }
 
 // AWS for configuring AWS specific parameters
@@ -40,12 +51,39 @@ type AWS struct {
 	RoleARN string `env:"AWS_ROLE_ARN"`
 }
 
+const (
+	// ManagedDBTypeAWSRDS provisions the managed DBs of Centrals as AWS RDS Aurora clusters.
+	ManagedDBTypeAWSRDS = "aws-rds"
+	// ManagedDBTypeCNPG provisions the managed DBs of Centrals as CloudNativePG clusters on the data-plane cluster.
+	ManagedDBTypeCNPG = "cnpg"
+)
+
 // ManagedDB for configuring managed DB specific parameters
 type ManagedDB struct {
 	Enabled             bool   `env:"MANAGED_DB_ENABLED" envDefault:"false"`
+	Type                string `env:"MANAGED_DB_TYPE" envDefault:"aws-rds"`
 	SecurityGroup       string `env:"MANAGED_DB_SECURITY_GROUP"`
 	SubnetGroup         string `env:"MANAGED_DB_SUBNET_GROUP"`
 	PerformanceInsights bool   `env:"MANAGED_DB_PERFORMANCE_INSIGHTS" envDefault:"false"`
+	// BackupPollPeriod is the interval in which on-demand backup and restore requests are polled from fleet-manager.
+	BackupPollPeriod time.Duration `env:"MANAGED_DB_BACKUP_POLL_PERIOD" envDefault:"1m"`
+
+	CNPG CNPG
+}
+
+// CNPG for configuring the CloudNativePG clusters used as managed DBs if MANAGED_DB_TYPE is cnpg
+type CNPG struct {
+	// Namespace is the namespace the PostgreSQL clusters of all Centrals are created in.
+	Namespace    string `env:"MANAGED_DB_CNPG_NAMESPACE" envDefault:"rhacs-managed-db"`
+	Instances    int    `env:"MANAGED_DB_CNPG_INSTANCES" envDefault:"2"`
+	ImageName    string `env:"MANAGED_DB_CNPG_IMAGE_NAME"`
+	StorageSize  string `env:"MANAGED_DB_CNPG_STORAGE_SIZE" envDefault:"20Gi"`
+	StorageClass string `env:"MANAGED_DB_CNPG_STORAGE_CLASS"`
+	// ServerCASecret is the secret in Namespace with the CA (ca.crt and ca.key) that signs the server certificates
+	// of all PostgreSQL clusters. The same CA has to be present in the DB CA bundle of fleetshard-sync, because
+	// connections to the managed DBs verify the server certificate. It is required, because the certificates
+	// CloudNativePG signs with its own self-signed CA cannot be verified.
+	ServerCASecret string `env:"MANAGED_DB_CNPG_SERVER_CA_SECRET"`
 }
 
 // Telemetry defines parameters for pushing telemetry to a remote storage.
@@ -54,6 +92,15 @@ type Telemetry struct {
 	StorageKey      string `env:"TELEMETRY_STORAGE_KEY"`
 }
 
+// ClusterStatus defines parameters for reporting the data plane cluster status to fleet-manager.
+type ClusterStatus struct {
+	ReportPeriod time.Duration `env:"CLUSTER_STATUS_REPORT_PERIOD" envDefault:"1m"`
+	// The estimated resource requests of a single tenant, used to calculate the remaining tenant capacity
+	// on a cluster without running tenants.
+	TenantCPURequest    string `env:"CLUSTER_STATUS_TENANT_CPU_REQUEST" envDefault:"500m"`
+	TenantMemoryRequest string `env:"CLUSTER_STATUS_TENANT_MEMORY_REQUEST" envDefault:"2Gi"`
+}
+
 // GetConfig retrieves the current runtime configuration from the environment and returns it.
 func GetConfig() (*Config, error) {
 	c := Config{}
@@ -71,7 +118,14 @@ func GetConfig() (*Config, error) {
 	if c.AuthType == "" {
 		configErrors.AddError(errors.New("AUTH_TYPE unset in the environment"))
 	}
+	if c.RuntimeReconcileParallelism <= 0 {
+		configErrors.AddError(errors.New("RUNTIME_RECONCILE_PARALLELISM must be positive"))
+	}
+	if c.FeatureFlagUpgradeOperatorEnabled && c.OperatorReconcilePeriod <= 0 {
+		configErrors.AddError(errors.New("OPERATOR_RECONCILE_PERIOD must be positive"))
+	}
 	validateManagedDBConfig(c, &configErrors)
+	validateClusterStatusConfig(c, &configErrors)
 
 	cfgErr := configErrors.ToError()
 	if cfgErr != nil {
@@ -84,10 +138,41 @@ func validateManagedDBConfig(c Config, configErrors *errorhelpers.ErrorList) {
 	if !c.ManagedDB.Enabled {
 		return
 	}
-	if c.AWS.RoleARN == "" {
-		configErrors.AddError(errors.New("MANAGED_DB_ENABLED == true and AWS_ROLE_ARN unset in the environment"))
+	switch c.ManagedDB.Type {
+	case ManagedDBTypeAWSRDS:
+		if c.AWS.RoleARN == "" {
+			configErrors.AddError(errors.New("MANAGED_DB_ENABLED == true and AWS_ROLE_ARN unset in the environment"))
+		}
+		if c.ManagedDB.SecurityGroup == "" {
+			configErrors.AddError(errors.New("MANAGED_DB_ENABLED == true and MANAGED_DB_SECURITY_GROUP unset in the environment"))
+		}
+	case ManagedDBTypeCNPG:
+		if c.ManagedDB.CNPG.Namespace == "" {
+			configErrors.AddError(errors.New("MANAGED_DB_TYPE == cnpg and MANAGED_DB_CNPG_NAMESPACE unset in the environment"))
+		}
+		if c.ManagedDB.CNPG.ServerCASecret == "" {
+			configErrors.AddError(errors.New("MANAGED_DB_TYPE == cnpg and MANAGED_DB_CNPG_SERVER_CA_SECRET unset in the environment"))
+		}
+		if c.ManagedDB.CNPG.Instances <= 0 {
+			configErrors.AddError(errors.New("MANAGED_DB_CNPG_INSTANCES must be positive"))
+		}
+		if _, err := resource.ParseQuantity(c.ManagedDB.CNPG.StorageSize); err != nil {
+			configErrors.AddError(errors.Wrap(err, "parsing MANAGED_DB_CNPG_STORAGE_SIZE"))
+		}
+	default:
+		configErrors.AddError(errors.Errorf("unknown MANAGED_DB_TYPE %q, must be one of %q, %q",
+			c.ManagedDB.Type, ManagedDBTypeAWSRDS, ManagedDBTypeCNPG))
+	}
+}
+
+func validateClusterStatusConfig(c Config, configErrors *errorhelpers.ErrorList) {
+	if c.ClusterStatus.ReportPeriod <= 0 {
+		configErrors.AddError(errors.New("CLUSTER_STATUS_REPORT_PERIOD must be positive"))
+	}
+	if _, err := resource.ParseQuantity(c.ClusterStatus.TenantCPURequest); err != nil {
+		configErrors.AddError(errors.Wrap(err, "parsing CLUSTER_STATUS_TENANT_CPU_REQUEST"))
 	}
-	if c.ManagedDB.SecurityGroup == "" {
-		configErrors.AddError(errors.New("MANAGED_DB_ENABLED == true and MANAGED_DB_SECURITY_GROUP unset in the environment"))
+	if _, err := resource.ParseQuantity(c.ClusterStatus.TenantMemoryRequest); err != nil {
+		configErrors.AddError(errors.Wrap(err, "parsing CLUSTER_STATUS_TENANT_MEMORY_REQUEST"))
 	}
 }
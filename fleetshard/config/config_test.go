@@ -15,6 +15,10 @@ func TestSingleton_Success(t *testing.T) {
 	assert.Equal(t, cfg.FleetManagerEndpoint, "http://127.0.0.1:8000")
 	assert.Equal(t, cfg.ClusterID, "some-value")
 	assert.Equal(t, cfg.RuntimePollPeriod, 5*time.Second)
+	assert.True(t, cfg.RuntimeWatchEnabled)
+	assert.Equal(t, cfg.RuntimeWatchTimeout, 20*time.Second)
+	assert.Equal(t, cfg.RuntimeReconcileParallelism, 10)
+	assert.Equal(t, cfg.RuntimeShutdownTimeout, 20*time.Second)
 	assert.Equal(t, cfg.AuthType, "RHSSO")
 	assert.Equal(t, cfg.RHSSORealm, "redhat-external")
 	assert.Equal(t, cfg.RHSSOEndpoint, "https://sso.redhat.com")
@@ -58,3 +62,43 @@ func TestSingleton_Failure_WhenManagedDBEnabledAndManagedDbSecurityGroupNotSet(t
 	assert.Error(t, err, "MANAGED_DB_ENABLED == true and MANAGED_DB_SECURITY_GROUP unset in the environment")
 	assert.Nil(t, cfg)
 }
+
+func TestSingleton_Success_WhenManagedDBTypeCNPG(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_TYPE", "cnpg")
+	t.Setenv("MANAGED_DB_CNPG_SERVER_CA_SECRET", "managed-db-ca")
+	cfg, err := GetConfig()
+	require.NoError(t, err)
+	assert.Equal(t, cfg.ManagedDB.Type, ManagedDBTypeCNPG)
+	assert.Equal(t, cfg.ManagedDB.CNPG.Namespace, "rhacs-managed-db")
+	assert.Equal(t, cfg.ManagedDB.CNPG.Instances, 2)
+	assert.Equal(t, cfg.ManagedDB.CNPG.StorageSize, "20Gi")
+	assert.Equal(t, cfg.ManagedDB.CNPG.ServerCASecret, "managed-db-ca")
+}
+
+func TestSingleton_Failure_WhenManagedDBTypeCNPGWithoutServerCA(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_TYPE", "cnpg")
+	cfg, err := GetConfig()
+	assert.ErrorContains(t, err, "MANAGED_DB_CNPG_SERVER_CA_SECRET unset in the environment")
+	assert.Nil(t, cfg)
+}
+
+func TestSingleton_Failure_WhenManagedDBTypeUnknown(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_TYPE", "unknown")
+	cfg, err := GetConfig()
+	assert.Error(t, err)
+	assert.Nil(t, cfg)
+}
+
+func TestSingleton_Failure_WhenClusterStatusTenantRequestInvalid(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("CLUSTER_STATUS_TENANT_CPU_REQUEST", "not-a-quantity")
+	cfg, err := GetConfig()
+	assert.Error(t, err)
+	assert.Nil(t, cfg)
+}
@@ -34,6 +34,11 @@ func main() {
 	glog.Infof("FleetManagerEndpoint: %s", config.FleetManagerEndpoint)
 	glog.Infof("ClusterID: %s", config.ClusterID)
 	glog.Infof("RuntimePollPeriod: %s", config.RuntimePollPeriod.String())
+	glog.Infof("RuntimeWatchEnabled: %t", config.RuntimeWatchEnabled)
+	glog.Infof("RuntimeWatchTimeout: %s", config.RuntimeWatchTimeout.String())
+	glog.Infof("RuntimeReconcileParallelism: %d", config.RuntimeReconcileParallelism)
+	glog.Infof("RuntimeShutdownTimeout: %s", config.RuntimeShutdownTimeout.String())
+	glog.Infof("ClusterStatus.ReportPeriod: %s", config.ClusterStatus.ReportPeriod.String())
 	glog.Infof("AuthType: %s", config.AuthType)
 	glog.Infof("FeatureFlagUpgradeOperatorEnabled: %t", config.FeatureFlagUpgradeOperatorEnabled)
 
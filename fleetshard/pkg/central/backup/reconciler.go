@@ -0,0 +1,146 @@
+// Package backup carries out the on-demand backups and restores of managed Central databases requested in fleet-manager.
+package backup
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"github.com/stackrox/rox/pkg/random"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// restoredDBSecretPrefix is the prefix of the secrets holding the master password of restored databases. Switching
+// a Central over to a restored database is a manual step, which needs the password to access the database.
+const restoredDBSecretPrefix = "central-db-restore-" // pragma: allowlist secret
+
+// Reconciler polls fleet-manager for the pending backup and restore requests of the data-plane cluster, drives
+// them with the DBClient and reports their progress back to fleet-manager. Transient errors of the DBClient are
+// retried on the next reconciliation, only terminal errors fail a request.
+type Reconciler struct {
+	client    fleetmanager.PrivateAPI
+	clusterID string
+	dbClient  cloudprovider.DBClient
+	k8sClient ctrlClient.Client
+	namespace string
+}
+
+// NewReconciler creates a new backup Reconciler. The master passwords of restored databases are stored in secrets
+// in the given namespace.
+func NewReconciler(client fleetmanager.PrivateAPI, clusterID string, dbClient cloudprovider.DBClient,
+	k8sClient ctrlClient.Client, namespace string) *Reconciler {
+	return &Reconciler{
+		client:    client,
+		clusterID: clusterID,
+		dbClient:  dbClient,
+		k8sClient: k8sClient,
+		namespace: namespace,
+	}
+}
+
+// Reconcile makes progress on all pending backup and restore requests of the cluster. It does not block until
+// the requests are completed, so it has to be called periodically.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	list, _, err := r.client.GetCentralBackupRequests(ctx, r.clusterID)
+	if err != nil {
+		return errors.Wrap(err, "retrieving list of pending central backup requests")
+	}
+
+	var multiErr *multierror.Error
+	for _, backupRequest := range list.Items {
+		if err := r.reconcileRequest(ctx, backupRequest); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func (r *Reconciler) reconcileRequest(ctx context.Context, backupRequest private.ManagedCentralBackupRequest) error {
+	var done bool
+	var err error
+	switch backupRequest.Type {
+	case constants.CentralBackupRequestTypeBackup.String():
+		done, err = r.dbClient.EnsureDBSnapshotCreated(backupRequest.CentralId, backupRequest.SnapshotId)
+	case constants.CentralBackupRequestTypeRestore.String():
+		done, err = r.dbClient.EnsureDBRestored(backupRequest.TargetDatabaseId, cloudprovider.DBRestoreSource{
+			DatabaseID:  backupRequest.CentralId,
+			SnapshotID:  backupRequest.SnapshotId,
+			RestoreTime: backupRequest.RestoreTime,
+		})
+	default:
+		err = errors.Errorf("unknown type %q", backupRequest.Type)
+	}
+
+	if err != nil && !cloudprovider.IsTerminalError(err) {
+		return errors.Wrapf(err, "%s request %s of central %s", backupRequest.Type, backupRequest.Id, backupRequest.CentralId)
+	}
+	if err != nil {
+		glog.Errorf("%s request %s of central %s failed: %v", backupRequest.Type, backupRequest.Id, backupRequest.CentralId, err)
+		return r.updateStatus(ctx, backupRequest, constants.CentralBackupRequestStatusFailed, err.Error())
+	}
+	if done && backupRequest.Type == constants.CentralBackupRequestTypeRestore.String() {
+		if err := r.ensureRestoredDBCredentials(ctx, backupRequest.TargetDatabaseId); err != nil {
+			return errors.Wrapf(err, "setting credentials of database %s restored by request %s of central %s",
+				backupRequest.TargetDatabaseId, backupRequest.Id, backupRequest.CentralId)
+		}
+	}
+	if done {
+		glog.Infof("%s request %s of central %s succeeded", backupRequest.Type, backupRequest.Id, backupRequest.CentralId)
+		return r.updateStatus(ctx, backupRequest, constants.CentralBackupRequestStatusSucceeded, "")
+	}
+	if backupRequest.Status != constants.CentralBackupRequestStatusInProgress.String() {
+		return r.updateStatus(ctx, backupRequest, constants.CentralBackupRequestStatusInProgress, "")
+	}
+	return nil
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, backupRequest private.ManagedCentralBackupRequest,
+	status constants.CentralBackupRequestStatus, failedReason string) error {
+	_, err := r.client.UpdateCentralBackupRequestStatus(ctx, r.clusterID, backupRequest.Id,
+		private.ManagedCentralBackupRequestStatusUpdateRequest{
+			Status:       status.String(),
+			FailedReason: failedReason,
+		})
+	if err != nil {
+		return errors.Wrapf(err, "updating status of %s request %s of central %s", backupRequest.Type, backupRequest.Id, backupRequest.CentralId)
+	}
+	return nil
+}
+
+// ensureRestoredDBCredentials sets a new master password for a restored database and stores it in a secret. The
+// restored database keeps the master password of the source database, which fleetshard does not store.
+func (r *Reconciler) ensureRestoredDBCredentials(ctx context.Context, databaseID string) error {
+	secret := &corev1.Secret{}
+	key := ctrlClient.ObjectKey{Namespace: r.namespace, Name: restoredDBSecretPrefix + databaseID}
+	err := r.k8sClient.Get(ctx, key, secret)
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return errors.Wrapf(err, "getting secret %s", key)
+	}
+	if apiErrors.IsNotFound(err) {
+		password, err := random.GenerateString(25, random.AlphanumericCharacters)
+		if err != nil {
+			return errors.Wrap(err, "generating DB password")
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Data:       map[string][]byte{"password": []byte(password)},
+		}
+		// the password is stored before it is set, so that it is not lost if fleetshard restarts in between
+		if err := r.k8sClient.Create(ctx, secret); err != nil {
+			return errors.Wrapf(err, "creating secret %s", key)
+		}
+	}
+
+	if err := r.dbClient.ResetDBMasterPassword(databaseID, string(secret.Data["password"])); err != nil {
+		return errors.Wrap(err, "resetting DB master password")
+	}
+	return nil
+}
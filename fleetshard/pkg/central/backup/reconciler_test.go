@@ -0,0 +1,194 @@
+package backup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newClientMock(backupRequests ...private.ManagedCentralBackupRequest) *fleetmanager.PrivateAPIMock {
+	return &fleetmanager.PrivateAPIMock{
+		GetCentralBackupRequestsFunc: func(ctx context.Context, id string) (private.ManagedCentralBackupRequestList, *http.Response, error) {
+			return private.ManagedCentralBackupRequestList{Items: backupRequests}, nil, nil
+		},
+		UpdateCentralBackupRequestStatusFunc: func(ctx context.Context, id string, backupRequestId string, request private.ManagedCentralBackupRequestStatusUpdateRequest) (*http.Response, error) {
+			return nil, nil
+		},
+	}
+}
+
+func newTestReconciler(t *testing.T, client fleetmanager.PrivateAPI, dbClient cloudprovider.DBClient) *Reconciler {
+	return NewReconciler(client, "cluster-id", dbClient, testutils.NewFakeClientBuilder(t).Build(), "rhacs")
+}
+
+func TestReconciler_Backup(t *testing.T) {
+	tests := []struct {
+		name             string
+		currentStatus    string
+		snapshotDone     bool
+		snapshotErr      error
+		wantErr          bool
+		wantStatus       string
+		wantFailedReason string
+	}{
+		{
+			name:          "should report an initiated snapshot in progress",
+			currentStatus: "accepted",
+			wantStatus:    "in_progress",
+		},
+		{
+			name:          "should not report an unchanged status",
+			currentStatus: "in_progress",
+		},
+		{
+			name:          "should report an available snapshot",
+			currentStatus: "in_progress",
+			snapshotDone:  true,
+			wantStatus:    "succeeded",
+		},
+		{
+			name:             "should report a failed snapshot",
+			currentStatus:    "in_progress",
+			snapshotErr:      cloudprovider.NewTerminalError(assert.AnError),
+			wantStatus:       "failed",
+			wantFailedReason: assert.AnError.Error(),
+		},
+		{
+			name:          "should retry transient errors",
+			currentStatus: "in_progress",
+			snapshotErr:   assert.AnError,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newClientMock(private.ManagedCentralBackupRequest{
+				Id:         "backup-id",
+				CentralId:  "central-id",
+				Type:       "backup",
+				Status:     tt.currentStatus,
+				SnapshotId: "backup-id",
+			})
+			dbClient := &cloudprovider.DBClientMock{
+				EnsureDBSnapshotCreatedFunc: func(databaseID string, snapshotID string) (bool, error) {
+					return tt.snapshotDone, tt.snapshotErr
+				},
+			}
+
+			err := newTestReconciler(t, client, dbClient).Reconcile(context.Background())
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.snapshotErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, dbClient.EnsureDBSnapshotCreatedCalls(), 1)
+			assert.Equal(t, "central-id", dbClient.EnsureDBSnapshotCreatedCalls()[0].DatabaseID)
+			assert.Equal(t, "backup-id", dbClient.EnsureDBSnapshotCreatedCalls()[0].SnapshotID)
+
+			updates := client.UpdateCentralBackupRequestStatusCalls()
+			if tt.wantStatus == "" {
+				assert.Empty(t, updates)
+				return
+			}
+			require.Len(t, updates, 1)
+			assert.Equal(t, "cluster-id", updates[0].ID)
+			assert.Equal(t, "backup-id", updates[0].BackupRequestId)
+			assert.Equal(t, tt.wantStatus, updates[0].ManagedCentralBackupRequestStatusUpdateRequest.Status)
+			assert.Equal(t, tt.wantFailedReason, updates[0].ManagedCentralBackupRequestStatusUpdateRequest.FailedReason)
+		})
+	}
+}
+
+func TestReconciler_Restore(t *testing.T) {
+	restoreTime := time.Now().Add(-time.Hour)
+	client := newClientMock(private.ManagedCentralBackupRequest{
+		Id:               "restore-id",
+		CentralId:        "central-id",
+		Type:             "restore",
+		Status:           "in_progress",
+		RestoreTime:      &restoreTime,
+		TargetDatabaseId: "restore-id",
+	})
+	dbClient := &cloudprovider.DBClientMock{
+		EnsureDBRestoredFunc: func(targetDatabaseID string, source cloudprovider.DBRestoreSource) (bool, error) {
+			return true, nil
+		},
+		ResetDBMasterPasswordFunc: func(databaseID string, masterPassword string) error {
+			return nil
+		},
+	}
+	k8sClient := testutils.NewFakeClientBuilder(t).Build()
+
+	err := NewReconciler(client, "cluster-id", dbClient, k8sClient, "rhacs").Reconcile(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, dbClient.EnsureDBRestoredCalls(), 1)
+	assert.Equal(t, "restore-id", dbClient.EnsureDBRestoredCalls()[0].TargetDatabaseID)
+	assert.Equal(t, cloudprovider.DBRestoreSource{DatabaseID: "central-id", RestoreTime: &restoreTime},
+		dbClient.EnsureDBRestoredCalls()[0].Source)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(context.Background(), ctrlClient.ObjectKey{Namespace: "rhacs", Name: "central-db-restore-restore-id"}, secret))
+	require.Len(t, dbClient.ResetDBMasterPasswordCalls(), 1)
+	assert.Equal(t, "restore-id", dbClient.ResetDBMasterPasswordCalls()[0].DatabaseID)
+	assert.NotEmpty(t, dbClient.ResetDBMasterPasswordCalls()[0].MasterPassword)
+	assert.Equal(t, dbClient.ResetDBMasterPasswordCalls()[0].MasterPassword, string(secret.Data["password"]))
+
+	updates := client.UpdateCentralBackupRequestStatusCalls()
+	require.Len(t, updates, 1)
+	assert.Equal(t, "succeeded", updates[0].ManagedCentralBackupRequestStatusUpdateRequest.Status)
+}
+
+func TestReconciler_RestoreRetriesFailedPasswordReset(t *testing.T) {
+	client := newClientMock(private.ManagedCentralBackupRequest{
+		Id:               "restore-id",
+		CentralId:        "central-id",
+		Type:             "restore",
+		Status:           "in_progress",
+		TargetDatabaseId: "restore-id",
+	})
+	dbClient := &cloudprovider.DBClientMock{
+		EnsureDBRestoredFunc: func(targetDatabaseID string, source cloudprovider.DBRestoreSource) (bool, error) {
+			return true, nil
+		},
+		ResetDBMasterPasswordFunc: func(databaseID string, masterPassword string) error {
+			return assert.AnError
+		},
+	}
+	k8sClient := testutils.NewFakeClientBuilder(t).Build()
+	reconciler := NewReconciler(client, "cluster-id", dbClient, k8sClient, "rhacs")
+
+	assert.ErrorIs(t, reconciler.Reconcile(context.Background()), assert.AnError)
+	assert.ErrorIs(t, reconciler.Reconcile(context.Background()), assert.AnError)
+
+	assert.Empty(t, client.UpdateCentralBackupRequestStatusCalls())
+	require.Len(t, dbClient.ResetDBMasterPasswordCalls(), 2)
+	assert.Equal(t, dbClient.ResetDBMasterPasswordCalls()[0].MasterPassword, dbClient.ResetDBMasterPasswordCalls()[1].MasterPassword)
+}
+
+func TestReconciler_ReportsFailedStatusUpdates(t *testing.T) {
+	client := newClientMock(private.ManagedCentralBackupRequest{Id: "backup-id", Type: "backup", Status: "accepted"})
+	client.UpdateCentralBackupRequestStatusFunc = func(ctx context.Context, id string, backupRequestId string, request private.ManagedCentralBackupRequestStatusUpdateRequest) (*http.Response, error) {
+		return nil, assert.AnError
+	}
+	dbClient := &cloudprovider.DBClientMock{
+		EnsureDBSnapshotCreatedFunc: func(databaseID string, snapshotID string) (bool, error) {
+			return false, nil
+		},
+	}
+
+	err := newTestReconciler(t, client, dbClient).Reconcile(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+}
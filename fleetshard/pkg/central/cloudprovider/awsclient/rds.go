@@ -11,11 +11,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
 )
@@ -23,12 +25,14 @@ import (
 const (
 	dbAvailableStatus = "available"
 	dbDeletingStatus  = "deleting"
+	dbFailedStatus    = "failed"
 
 	dbUser           = "rhacs_master"
 	dbPrefix         = "rhacs-"
 	dbInstanceSuffix = "-db-instance"
 	dbFailoverSuffix = "-db-failover"
 	dbClusterSuffix  = "-db-cluster"
+	dbSnapshotSuffix = "-db-snapshot"
 	awsRetrySeconds  = 30
 
 	// DB cluster / instance configuration parameters
@@ -60,28 +64,29 @@ type RDS struct {
 	rdsClient *rds.RDS
 }
 
-// EnsureDBProvisioned is a blocking function that makes sure that an RDS database was provisioned for a Central
-func (r *RDS) EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string) error {
+// EnsureDBProvisioned initiates the provisioning of an RDS database for a Central. It does not block until the
+// database is available, but reports whether it is.
+func (r *RDS) EnsureDBProvisioned(_ context.Context, databaseID, masterPassword string) (bool, error) {
 	clusterID := getClusterID(databaseID)
 	if err := r.ensureDBClusterCreated(clusterID, masterPassword); err != nil {
-		return fmt.Errorf("ensuring DB cluster %s exists: %w", clusterID, err)
+		return false, fmt.Errorf("ensuring DB cluster %s exists: %w", clusterID, err)
 	}
 
 	instanceID := getInstanceID(databaseID)
 	if err := r.ensureDBInstanceCreated(instanceID, clusterID); err != nil {
-		return fmt.Errorf("ensuring DB instance %s exists in cluster %s: %w", instanceID, clusterID, err)
+		return false, fmt.Errorf("ensuring DB instance %s exists in cluster %s: %w", instanceID, clusterID, err)
 	}
 
 	failoverID := getFailoverInstanceID(databaseID)
 	if err := r.ensureDBInstanceCreated(failoverID, clusterID); err != nil {
-		return fmt.Errorf("ensuring failover DB instance %s exists in cluster %s: %w", failoverID, clusterID, err)
+		return false, fmt.Errorf("ensuring failover DB instance %s exists in cluster %s: %w", failoverID, clusterID, err)
 	}
 
-	return r.waitForInstanceToBeAvailable(ctx, instanceID)
+	return r.instanceAvailable(instanceID)
 }
 
 // EnsureDBDeprovisioned is a function that initiates the deprovisioning of the RDS database of a Central
-// Unlike EnsureDBProvisioned, this function does not block until the DB is deprovisioned
+// Like EnsureDBProvisioned, this function does not block until the DB is deprovisioned
 func (r *RDS) EnsureDBDeprovisioned(databaseID string) error {
 	err := r.ensureInstanceDeleted(getInstanceID(databaseID))
 	if err != nil {
@@ -117,6 +122,124 @@ func (r *RDS) GetDBConnection(databaseID string) (postgres.DBConnection, error)
 	return connection, nil
 }
 
+// ResetDBMasterPassword sets the master password of the RDS database cluster of a Central. The new password
+// is applied asynchronously by AWS.
+func (r *RDS) ResetDBMasterPassword(databaseID, masterPassword string) error {
+	clusterID := getClusterID(databaseID)
+	glog.Infof("Resetting master password of RDS database cluster %s.", clusterID)
+	_, err := r.rdsClient.ModifyDBCluster(&rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		MasterUserPassword:  aws.String(masterPassword),
+		ApplyImmediately:    aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("resetting master password of DB cluster %s: %w", clusterID, err)
+	}
+
+	return nil
+}
+
+// EnsureDBSnapshotCreated initiates a manual snapshot of the RDS database cluster of a Central. It does not block
+// until the snapshot is available, but reports whether it is.
+func (r *RDS) EnsureDBSnapshotCreated(databaseID, snapshotID string) (bool, error) {
+	done, err := r.ensureDBSnapshotCreated(databaseID, snapshotID)
+	return done, markTerminalAWSError(err)
+}
+
+func (r *RDS) ensureDBSnapshotCreated(databaseID, snapshotID string) (bool, error) {
+	clusterID := getClusterID(databaseID)
+	dbSnapshotID := getSnapshotID(snapshotID)
+	snapshotExists, snapshotStatus, err := r.snapshotStatus(dbSnapshotID)
+	if err != nil {
+		return false, fmt.Errorf("getting DB snapshot status: %w", err)
+	}
+	if !snapshotExists {
+		glog.Infof("Initiating snapshot %s of RDS database cluster %s.", dbSnapshotID, clusterID)
+		_, err = r.rdsClient.CreateDBClusterSnapshot(newCreateCentralDBClusterSnapshotInput(clusterID, dbSnapshotID,
+			r.dataplaneClusterName))
+		if err != nil {
+			return false, fmt.Errorf("creating DB snapshot %s of cluster %s: %w", dbSnapshotID, clusterID, err)
+		}
+		return false, nil
+	}
+
+	switch snapshotStatus {
+	case dbAvailableStatus:
+		return true, nil
+	case dbFailedStatus:
+		return false, cloudprovider.NewTerminalError(fmt.Errorf("DB snapshot %s of cluster %s failed", dbSnapshotID, clusterID))
+	default:
+		glog.Infof("RDS snapshot status: %s (snapshot ID: %s)", snapshotStatus, dbSnapshotID)
+		return false, nil
+	}
+}
+
+// EnsureDBRestored initiates the restore of the RDS database cluster of a Central to a new database cluster, either
+// from a snapshot or to a point in time. It does not block until the new database is available, but reports whether
+// it is.
+func (r *RDS) EnsureDBRestored(targetDatabaseID string, source cloudprovider.DBRestoreSource) (bool, error) {
+	done, err := r.ensureDBRestored(targetDatabaseID, source)
+	return done, markTerminalAWSError(err)
+}
+
+func (r *RDS) ensureDBRestored(targetDatabaseID string, source cloudprovider.DBRestoreSource) (bool, error) {
+	clusterID := getClusterID(targetDatabaseID)
+	if err := r.ensureDBClusterRestored(clusterID, source); err != nil {
+		return false, fmt.Errorf("ensuring DB cluster %s is restored: %w", clusterID, err)
+	}
+
+	instanceID := getInstanceID(targetDatabaseID)
+	if err := r.ensureDBInstanceCreated(instanceID, clusterID); err != nil {
+		return false, fmt.Errorf("ensuring DB instance %s exists in cluster %s: %w", instanceID, clusterID, err)
+	}
+
+	failoverID := getFailoverInstanceID(targetDatabaseID)
+	if err := r.ensureDBInstanceCreated(failoverID, clusterID); err != nil {
+		return false, fmt.Errorf("ensuring failover DB instance %s exists in cluster %s: %w", failoverID, clusterID, err)
+	}
+
+	_, instanceStatus, err := r.instanceStatus(instanceID)
+	if err != nil {
+		return false, fmt.Errorf("getting DB instance status: %w", err)
+	}
+	switch instanceStatus {
+	case dbAvailableStatus:
+		return true, nil
+	case dbFailedStatus:
+		return false, cloudprovider.NewTerminalError(fmt.Errorf("DB instance %s of restored cluster %s failed", instanceID, clusterID))
+	default:
+		glog.Infof("RDS instance status: %s (instance ID: %s)", instanceStatus, instanceID)
+		return false, nil
+	}
+}
+
+func (r *RDS) ensureDBClusterRestored(clusterID string, source cloudprovider.DBRestoreSource) error {
+	clusterExists, _, err := r.clusterStatus(clusterID)
+	if err != nil {
+		return fmt.Errorf("checking if DB cluster exists: %w", err)
+	}
+	if clusterExists {
+		return nil
+	}
+
+	sourceClusterID := getClusterID(source.DatabaseID)
+	if source.SnapshotID != "" {
+		dbSnapshotID := getSnapshotID(source.SnapshotID)
+		glog.Infof("Initiating restore of RDS database cluster %s from snapshot %s.", clusterID, dbSnapshotID)
+		_, err = r.rdsClient.RestoreDBClusterFromSnapshot(newRestoreCentralDBClusterFromSnapshotInput(clusterID,
+			dbSnapshotID, r.dbSecurityGroup, r.dbSubnetGroup, r.dataplaneClusterName))
+	} else {
+		glog.Infof("Initiating point-in-time restore of RDS database cluster %s to %s.", sourceClusterID, clusterID)
+		_, err = r.rdsClient.RestoreDBClusterToPointInTime(newRestoreCentralDBClusterToPointInTimeInput(clusterID,
+			sourceClusterID, source.RestoreTime, r.dbSecurityGroup, r.dbSubnetGroup, r.dataplaneClusterName))
+	}
+	if err != nil {
+		return fmt.Errorf("restoring DB cluster from %s: %w", sourceClusterID, err)
+	}
+
+	return nil
+}
+
 func (r *RDS) ensureDBClusterCreated(clusterID, masterPassword string) error {
 	clusterExists, _, err := r.clusterStatus(clusterID)
 	if err != nil {
@@ -197,6 +320,19 @@ func (r *RDS) ensureClusterDeleted(clusterID string) error {
 	return nil
 }
 
+// markTerminalAWSError marks errors of requests which were rejected by AWS as terminal. Throttling, server and
+// network errors are transient and returned unchanged.
+func markTerminalAWSError(err error) error {
+	var requestErr awserr.RequestFailure
+	if !errors.As(err, &requestErr) || requestErr.StatusCode() < 400 || requestErr.StatusCode() >= 500 {
+		return err
+	}
+	if request.IsErrorThrottle(requestErr) || request.IsErrorRetryable(requestErr) {
+		return err
+	}
+	return cloudprovider.NewTerminalError(err)
+}
+
 func (r *RDS) clusterStatus(clusterID string) (bool, string, error) {
 	dbCluster, err := r.describeDBCluster(clusterID)
 	if err != nil {
@@ -229,6 +365,29 @@ func (r *RDS) instanceStatus(instanceID string) (bool, string, error) {
 	return true, *dbInstance.DBInstanceStatus, nil
 }
 
+func (r *RDS) snapshotStatus(snapshotID string) (bool, string, error) {
+	result, err := r.rdsClient.DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) {
+			switch aerr.Code() {
+			case rds.ErrCodeDBClusterSnapshotNotFoundFault:
+				return false, "", nil
+			}
+		}
+		return false, "", fmt.Errorf("retrieving DB snapshot state: %w", err)
+	}
+
+	if len(result.DBClusterSnapshots) != 1 {
+		// this should never happen (DescribeDBClusterSnapshots should return either 1 snapshot, or ErrCodeDBClusterSnapshotNotFoundFault)
+		return false, "", fmt.Errorf("unexpected number of DB snapshots: %d", len(result.DBClusterSnapshots))
+	}
+
+	return true, *result.DBClusterSnapshots[0].Status, nil
+}
+
 func (r *RDS) describeDBInstance(instanceID string) (*rds.DBInstance, error) {
 	result, err := r.rdsClient.DescribeDBInstances(
 		&rds.DescribeDBInstancesInput{
@@ -262,30 +421,21 @@ func (r *RDS) describeDBCluster(clusterID string) (*rds.DBCluster, error) {
 	return result.DBClusters[0], nil
 }
 
-func (r *RDS) waitForInstanceToBeAvailable(ctx context.Context, instanceID string) error {
-	for {
-		dbInstanceExists, dbInstanceStatus, err := r.instanceStatus(instanceID)
-		if err != nil {
-			return err
-		}
-
-		if !dbInstanceExists {
-			return fmt.Errorf("DB instance does not exist: %s", instanceID)
-		}
+func (r *RDS) instanceAvailable(instanceID string) (bool, error) {
+	dbInstanceExists, dbInstanceStatus, err := r.instanceStatus(instanceID)
+	if err != nil {
+		return false, err
+	}
 
-		if dbInstanceStatus == dbAvailableStatus {
-			return nil
-		}
+	if !dbInstanceExists {
+		return false, fmt.Errorf("DB instance does not exist: %s", instanceID)
+	}
 
+	if dbInstanceStatus != dbAvailableStatus {
 		glog.Infof("RDS instance status: %s (instance ID: %s)", dbInstanceStatus, instanceID)
-		ticker := time.NewTicker(awsRetrySeconds * time.Second)
-		select {
-		case <-ticker.C:
-			continue
-		case <-ctx.Done():
-			return fmt.Errorf("waiting for RDS instance to be available: %w", ctx.Err())
-		}
+		return false, nil
 	}
+	return true, nil
 }
 
 // NewRDSClient initializes a new awsclient.RDS
@@ -316,6 +466,10 @@ func getFailoverInstanceID(databaseID string) string {
 	return dbPrefix + databaseID + dbFailoverSuffix
 }
 
+func getSnapshotID(snapshotID string) string {
+	return dbPrefix + snapshotID + dbSnapshotSuffix
+}
+
 func newCreateCentralDBClusterInput(clusterID, dbPassword, securityGroup, subnetGroup, dataplaneClusterName string) *rds.CreateDBClusterInput {
 	return &rds.CreateDBClusterInput{
 		DBClusterIdentifier: aws.String(clusterID),
@@ -358,6 +512,63 @@ func newCreateCentralDBInstanceInput(clusterID, instanceID, dataplaneClusterName
 	}
 }
 
+func newCreateCentralDBClusterSnapshotInput(clusterID, snapshotID, dataplaneClusterName string) *rds.CreateDBClusterSnapshotInput {
+	return &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(clusterID),
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+		Tags: []*rds.Tag{
+			{
+				Key:   aws.String(dataplaneClusterNameKey),
+				Value: aws.String(dataplaneClusterName)},
+		},
+	}
+}
+
+func newRestoreCentralDBClusterFromSnapshotInput(clusterID, snapshotID, securityGroup, subnetGroup, dataplaneClusterName string) *rds.RestoreDBClusterFromSnapshotInput {
+	return &rds.RestoreDBClusterFromSnapshotInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		SnapshotIdentifier:  aws.String(snapshotID),
+		Engine:              aws.String(dbEngine),
+		EngineVersion:       aws.String(dbEngineVersion),
+		VpcSecurityGroupIds: aws.StringSlice([]string{securityGroup}),
+		DBSubnetGroupName:   aws.String(subnetGroup),
+		ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(dbMinCapacityACU),
+			MaxCapacity: aws.Float64(dbMaxCapacityACU),
+		},
+		Tags: []*rds.Tag{
+			{
+				Key:   aws.String(dataplaneClusterNameKey),
+				Value: aws.String(dataplaneClusterName)},
+		},
+	}
+}
+
+func newRestoreCentralDBClusterToPointInTimeInput(clusterID, sourceClusterID string, restoreTime *time.Time, securityGroup, subnetGroup, dataplaneClusterName string) *rds.RestoreDBClusterToPointInTimeInput {
+	input := &rds.RestoreDBClusterToPointInTimeInput{
+		DBClusterIdentifier:       aws.String(clusterID),
+		SourceDBClusterIdentifier: aws.String(sourceClusterID),
+		RestoreType:               aws.String("full-copy"),
+		VpcSecurityGroupIds:       aws.StringSlice([]string{securityGroup}),
+		DBSubnetGroupName:         aws.String(subnetGroup),
+		ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(dbMinCapacityACU),
+			MaxCapacity: aws.Float64(dbMaxCapacityACU),
+		},
+		Tags: []*rds.Tag{
+			{
+				Key:   aws.String(dataplaneClusterNameKey),
+				Value: aws.String(dataplaneClusterName)},
+		},
+	}
+	if restoreTime != nil {
+		input.RestoreToTime = aws.Time(*restoreTime)
+	} else {
+		input.UseLatestRestorableTime = aws.Bool(true)
+	}
+	return input
+}
+
 func newDeleteCentralDBInstanceInput(instanceID string, skipFinalSnapshot bool) *rds.DeleteDBInstanceInput {
 	return &rds.DeleteDBInstanceInput{
 		DBInstanceIdentifier: aws.String(instanceID),
@@ -9,9 +9,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/google/uuid"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/rox/pkg/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -71,6 +73,27 @@ func waitForClusterToBeDeleted(ctx context.Context, rdsClient *RDS, clusterID st
 	}
 }
 
+func waitForDBToBeProvisioned(ctx context.Context, rdsClient *RDS, databaseID, masterPassword string) error {
+	for {
+		dbReady, err := rdsClient.EnsureDBProvisioned(ctx, databaseID, masterPassword)
+		if err != nil {
+			return err
+		}
+
+		if dbReady {
+			return nil
+		}
+
+		ticker := time.NewTicker(awsRetrySeconds * time.Second)
+		select {
+		case <-ticker.C:
+			continue
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for RDS instance to be available: %w", ctx.Err())
+		}
+	}
+}
+
 func TestRDSProvisioning(t *testing.T) {
 	if os.Getenv("RUN_RDS_TESTS") != "true" {
 		t.Skip("Skip RDS tests. Set RUN_RDS_TESTS=true env variable to enable RDS tests.")
@@ -102,7 +125,7 @@ func TestRDSProvisioning(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, failoverExists)
 
-	err = rdsClient.EnsureDBProvisioned(ctx, dbID, dbMasterPassword)
+	err = waitForDBToBeProvisioned(ctx, rdsClient, dbID, dbMasterPassword)
 	defer func() {
 		// clean-up AWS resources in case the test fails
 		deleteErr := rdsClient.EnsureDBDeprovisioned(dbID)
@@ -151,3 +174,37 @@ func TestGetDBConnection(t *testing.T) {
 	require.ErrorAs(t, err, &awsErr)
 	assert.Equal(t, awsErr.Code(), rds.ErrCodeDBClusterNotFoundFault)
 }
+
+func TestMarkTerminalAWSError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantTerminal bool
+	}{
+		{
+			name:         "should mark rejected requests as terminal",
+			err:          awserr.NewRequestFailure(awserr.New(rds.ErrCodeDBClusterSnapshotNotFoundFault, "not found", nil), 404, "request-id"),
+			wantTerminal: true,
+		},
+		{
+			name: "should retry throttled requests",
+			err:  awserr.NewRequestFailure(awserr.New("Throttling", "rate exceeded", nil), 400, "request-id"),
+		},
+		{
+			name: "should retry server errors",
+			err:  awserr.NewRequestFailure(awserr.New("InternalFailure", "internal error", nil), 500, "request-id"),
+		},
+		{
+			name: "should retry network errors",
+			err:  awserr.New(request.ErrCodeRequestError, "send request failed", assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := markTerminalAWSError(fmt.Errorf("restoring DB cluster: %w", tt.err))
+			assert.Equal(t, tt.wantTerminal, cloudprovider.IsTerminalError(err))
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
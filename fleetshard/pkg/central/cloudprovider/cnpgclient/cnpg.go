@@ -0,0 +1,271 @@
+// Package cnpgclient provides a CloudNativePG implementation of the interfaces in cloudprovider, for data-plane
+// clusters without a cloud provider database service, such as on-prem and dev clusters.
+package cnpgclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	dbPrefix              = "rhacs-"
+	superuserSecretSuffix = "-superuser"
+	readWriteSuffix       = "-rw"
+	dbUser                = "postgres"
+	dbName                = "postgres"
+	dbPostgresPort        = 5432
+)
+
+var (
+	clusterGVK = schema.GroupVersionKind{Group: "postgresql.cnpg.io", Version: "v1", Kind: "Cluster"}
+
+	errSnapshotsNotSupported = errors.New("on-demand snapshots and restores are not supported for CloudNativePG databases")
+)
+
+var _ cloudprovider.DBClient = &CNPG{}
+
+// CNPG provisions and deprovisions the databases of Centrals as CloudNativePG clusters on the data-plane cluster.
+//
+// Every Central gets a dedicated PostgreSQL cluster instead of a database on a shared server, because Central
+// expects to own the server: it creates its databases with fixed names, and the Central DB user is a role
+// of the whole server.
+type CNPG struct {
+	client         ctrlClient.Client
+	namespace      string
+	instances      int
+	imageName      string
+	storageSize    string
+	storageClass   string
+	serverCASecret string
+}
+
+// EnsureDBProvisioned initiates the provisioning of a PostgreSQL cluster for a Central. It does not block until
+// the cluster is ready, but reports whether it is.
+func (c *CNPG) EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string) (bool, error) {
+	clusterName := getClusterName(databaseID)
+	if err := c.ensureSuperuserSecretCreated(ctx, clusterName, masterPassword); err != nil {
+		return false, fmt.Errorf("ensuring superuser secret of DB cluster %s exists: %w", clusterName, err)
+	}
+	if err := c.ensureClusterCreated(ctx, clusterName); err != nil {
+		return false, fmt.Errorf("ensuring DB cluster %s exists: %w", clusterName, err)
+	}
+
+	return c.clusterReady(ctx, clusterName)
+}
+
+// EnsureDBDeprovisioned initiates the deletion of the PostgreSQL cluster of a Central. It does not block until
+// the cluster is deleted.
+func (c *CNPG) EnsureDBDeprovisioned(databaseID string) error {
+	ctx := context.Background()
+	clusterName := getClusterName(databaseID)
+
+	cluster := newCluster(c.namespace, clusterName)
+	if err := c.client.Delete(ctx, cluster); err != nil && !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("deleting DB cluster %s: %w", clusterName, err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: c.namespace, Name: getSuperuserSecretName(clusterName)}}
+	if err := c.client.Delete(ctx, secret); err != nil && !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("deleting superuser secret of DB cluster %s: %w", clusterName, err)
+	}
+
+	return nil
+}
+
+// GetDBConnection returns a postgres.DBConnection struct, which contains the data necessary
+// to construct a PostgreSQL connection string. It expects that the database was already provisioned.
+func (c *CNPG) GetDBConnection(databaseID string) (postgres.DBConnection, error) {
+	host := fmt.Sprintf("%s%s.%s.svc", getClusterName(databaseID), readWriteSuffix, c.namespace)
+	connection, err := postgres.NewDBConnection(host, dbPostgresPort, dbUser, dbName)
+	if err != nil {
+		return postgres.DBConnection{}, fmt.Errorf("incorrect DB connection parameters: %w", err)
+	}
+
+	return connection, nil
+}
+
+// ResetDBMasterPassword sets the superuser password of the PostgreSQL cluster of a Central. The new password
+// is applied asynchronously by the CloudNativePG operator.
+func (c *CNPG) ResetDBMasterPassword(databaseID, masterPassword string) error {
+	ctx := context.Background()
+	clusterName := getClusterName(databaseID)
+	glog.Infof("Resetting superuser password of CloudNativePG database cluster %s.", clusterName)
+
+	secret := &corev1.Secret{}
+	key := ctrlClient.ObjectKey{Namespace: c.namespace, Name: getSuperuserSecretName(clusterName)}
+	if err := c.client.Get(ctx, key, secret); err != nil {
+		return fmt.Errorf("getting superuser secret of DB cluster %s: %w", clusterName, err)
+	}
+	secret.Data = superuserSecretData(masterPassword)
+	if err := c.client.Update(ctx, secret); err != nil {
+		return fmt.Errorf("updating superuser secret of DB cluster %s: %w", clusterName, err)
+	}
+
+	return nil
+}
+
+// EnsureDBSnapshotCreated is not supported for CloudNativePG databases, because CloudNativePG backups require
+// an object store that is not available on every data-plane cluster.
+func (c *CNPG) EnsureDBSnapshotCreated(databaseID, snapshotID string) (bool, error) {
+	return false, cloudprovider.NewTerminalError(errSnapshotsNotSupported)
+}
+
+// EnsureDBRestored is not supported for CloudNativePG databases, see EnsureDBSnapshotCreated.
+func (c *CNPG) EnsureDBRestored(targetDatabaseID string, source cloudprovider.DBRestoreSource) (bool, error) {
+	return false, cloudprovider.NewTerminalError(errSnapshotsNotSupported)
+}
+
+func (c *CNPG) ensureSuperuserSecretCreated(ctx context.Context, clusterName, masterPassword string) error {
+	secret := &corev1.Secret{}
+	key := ctrlClient.ObjectKey{Namespace: c.namespace, Name: getSuperuserSecretName(clusterName)}
+	err := c.client.Get(ctx, key, secret)
+	if err == nil {
+		return nil
+	}
+	if !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("checking if superuser secret exists: %w", err)
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: key.Namespace,
+			Name:      key.Name,
+		},
+		Type: corev1.SecretTypeBasicAuth,
+		Data: superuserSecretData(masterPassword),
+	}
+	if err := c.client.Create(ctx, secret); err != nil {
+		return fmt.Errorf("creating superuser secret: %w", err)
+	}
+
+	return nil
+}
+
+func (c *CNPG) ensureClusterCreated(ctx context.Context, clusterName string) error {
+	clusterExists, _, err := c.clusterStatus(ctx, clusterName)
+	if err != nil {
+		return fmt.Errorf("checking if DB cluster exists: %w", err)
+	}
+	if clusterExists {
+		return nil
+	}
+
+	glog.Infof("Initiating provisioning of CloudNativePG database cluster %s.", clusterName)
+	if err := c.client.Create(ctx, c.newCentralDBCluster(clusterName)); err != nil {
+		return fmt.Errorf("creating DB cluster: %w", err)
+	}
+
+	return nil
+}
+
+// clusterStatus returns whether the cluster exists and how many of its instances are ready.
+func (c *CNPG) clusterStatus(ctx context.Context, clusterName string) (bool, int64, error) {
+	cluster := newCluster(c.namespace, clusterName)
+	err := c.client.Get(ctx, ctrlClient.ObjectKeyFromObject(cluster), cluster)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return false, 0, nil
+		}
+		return false, 0, fmt.Errorf("retrieving DB cluster state: %w", err)
+	}
+
+	readyInstances, _, err := unstructured.NestedInt64(cluster.Object, "status", "readyInstances")
+	if err != nil {
+		return false, 0, fmt.Errorf("reading ready instances of DB cluster: %w", err)
+	}
+
+	return true, readyInstances, nil
+}
+
+func (c *CNPG) clusterReady(ctx context.Context, clusterName string) (bool, error) {
+	clusterExists, readyInstances, err := c.clusterStatus(ctx, clusterName)
+	if err != nil {
+		return false, err
+	}
+
+	if !clusterExists {
+		return false, fmt.Errorf("DB cluster does not exist: %s", clusterName)
+	}
+
+	if readyInstances < int64(c.instances) {
+		glog.Infof("CloudNativePG cluster ready instances: %d/%d (cluster: %s)", readyInstances, c.instances, clusterName)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (c *CNPG) newCentralDBCluster(clusterName string) *unstructured.Unstructured {
+	storage := map[string]interface{}{
+		"size": c.storageSize,
+	}
+	if c.storageClass != "" {
+		storage["storageClass"] = c.storageClass
+	}
+
+	spec := map[string]interface{}{
+		"instances":             int64(c.instances),
+		"enableSuperuserAccess": true,
+		"superuserSecret": map[string]interface{}{
+			"name": getSuperuserSecretName(clusterName),
+		},
+		"storage": storage,
+	}
+	if c.imageName != "" {
+		spec["imageName"] = c.imageName
+	}
+	spec["certificates"] = map[string]interface{}{
+		"serverCASecret": c.serverCASecret,
+	}
+
+	cluster := newCluster(c.namespace, clusterName)
+	cluster.Object["spec"] = spec
+	return cluster
+}
+
+// NewCNPGClient initializes a new cnpgclient.CNPG
+func NewCNPGClient(config *config.Config, k8sClient ctrlClient.Client) *CNPG {
+	return &CNPG{
+		client:         k8sClient,
+		namespace:      config.ManagedDB.CNPG.Namespace,
+		instances:      config.ManagedDB.CNPG.Instances,
+		imageName:      config.ManagedDB.CNPG.ImageName,
+		storageSize:    config.ManagedDB.CNPG.StorageSize,
+		storageClass:   config.ManagedDB.CNPG.StorageClass,
+		serverCASecret: config.ManagedDB.CNPG.ServerCASecret,
+	}
+}
+
+func newCluster(namespace, clusterName string) *unstructured.Unstructured {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(clusterGVK)
+	cluster.SetNamespace(namespace)
+	cluster.SetName(clusterName)
+	return cluster
+}
+
+func superuserSecretData(password string) map[string][]byte {
+	return map[string][]byte{
+		corev1.BasicAuthUsernameKey: []byte(dbUser),
+		corev1.BasicAuthPasswordKey: []byte(password),
+	}
+}
+
+func getClusterName(databaseID string) string {
+	return dbPrefix + databaseID
+}
+
+func getSuperuserSecretName(clusterName string) string {
+	return clusterName + superuserSecretSuffix
+}
@@ -0,0 +1,108 @@
+package cnpgclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testNamespace = "rhacs-managed-db"
+
+func newTestCNPG(t *testing.T) (*CNPG, ctrlClient.Client) {
+	k8sClient := testutils.NewFakeClientBuilder(t).Build()
+	cfg := &config.Config{ManagedDB: config.ManagedDB{CNPG: config.CNPG{
+		Namespace:      testNamespace,
+		Instances:      2,
+		StorageSize:    "20Gi",
+		StorageClass:   "fast",
+		ServerCASecret: "managed-db-ca",
+	}}}
+	return NewCNPGClient(cfg, k8sClient), k8sClient
+}
+
+func setReadyInstances(t *testing.T, k8sClient ctrlClient.Client, clusterName string, readyInstances int64) {
+	cluster := newCluster(testNamespace, clusterName)
+	require.NoError(t, k8sClient.Get(context.Background(), ctrlClient.ObjectKeyFromObject(cluster), cluster))
+	require.NoError(t, unstructured.SetNestedField(cluster.Object, readyInstances, "status", "readyInstances"))
+	require.NoError(t, k8sClient.Update(context.Background(), cluster))
+}
+
+func TestCNPG_EnsureDBProvisioned(t *testing.T) {
+	cnpg, k8sClient := newTestCNPG(t)
+
+	// The cluster is not ready yet, so provisioning returns right after the cluster was created.
+	ready, err := cnpg.EnsureDBProvisioned(context.Background(), "central-id", "master-password")
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	cluster := newCluster(testNamespace, "rhacs-central-id")
+	require.NoError(t, k8sClient.Get(context.Background(), ctrlClient.ObjectKeyFromObject(cluster), cluster))
+	instances, _, _ := unstructured.NestedInt64(cluster.Object, "spec", "instances")
+	assert.Equal(t, int64(2), instances)
+	storageClass, _, _ := unstructured.NestedString(cluster.Object, "spec", "storage", "storageClass")
+	assert.Equal(t, "fast", storageClass)
+	serverCASecret, _, _ := unstructured.NestedString(cluster.Object, "spec", "certificates", "serverCASecret")
+	assert.Equal(t, "managed-db-ca", serverCASecret)
+	secretName, _, _ := unstructured.NestedString(cluster.Object, "spec", "superuserSecret", "name")
+	assert.Equal(t, "rhacs-central-id-superuser", secretName)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(context.Background(), ctrlClient.ObjectKey{Namespace: testNamespace, Name: secretName}, secret))
+	assert.Equal(t, "master-password", string(secret.Data[corev1.BasicAuthPasswordKey]))
+
+	setReadyInstances(t, k8sClient, "rhacs-central-id", 1)
+	ready, err = cnpg.EnsureDBProvisioned(context.Background(), "central-id", "master-password")
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	setReadyInstances(t, k8sClient, "rhacs-central-id", 2)
+	ready, err = cnpg.EnsureDBProvisioned(context.Background(), "central-id", "master-password")
+	require.NoError(t, err)
+	assert.True(t, ready)
+}
+
+func TestCNPG_GetDBConnection(t *testing.T) {
+	cnpg, _ := newTestCNPG(t)
+
+	connection, err := cnpg.GetDBConnection("central-id")
+	require.NoError(t, err)
+	assert.Equal(t, "host=rhacs-central-id-rw.rhacs-managed-db.svc port=5432 user=postgres dbname=postgres sslmode=verify-full",
+		connection.AsConnectionString())
+}
+
+func TestCNPG_ResetDBMasterPassword(t *testing.T) {
+	cnpg, k8sClient := newTestCNPG(t)
+	ctx := context.Background()
+	require.NoError(t, cnpg.ensureSuperuserSecretCreated(ctx, "rhacs-central-id", "old-password"))
+
+	require.NoError(t, cnpg.ResetDBMasterPassword("central-id", "new-password"))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(ctx, ctrlClient.ObjectKey{Namespace: testNamespace, Name: "rhacs-central-id-superuser"}, secret))
+	assert.Equal(t, "new-password", string(secret.Data[corev1.BasicAuthPasswordKey]))
+}
+
+func TestCNPG_EnsureDBDeprovisioned(t *testing.T) {
+	cnpg, k8sClient := newTestCNPG(t)
+	ctx := context.Background()
+	require.NoError(t, cnpg.ensureSuperuserSecretCreated(ctx, "rhacs-central-id", "master-password"))
+	require.NoError(t, cnpg.ensureClusterCreated(ctx, "rhacs-central-id"))
+
+	require.NoError(t, cnpg.EnsureDBDeprovisioned("central-id"))
+	// Deprovisioning an already deprovisioned DB succeeds.
+	require.NoError(t, cnpg.EnsureDBDeprovisioned("central-id"))
+
+	cluster := newCluster(testNamespace, "rhacs-central-id")
+	err := k8sClient.Get(ctx, ctrlClient.ObjectKeyFromObject(cluster), cluster)
+	assert.True(t, apiErrors.IsNotFound(err))
+	err = k8sClient.Get(ctx, ctrlClient.ObjectKey{Namespace: testNamespace, Name: "rhacs-central-id-superuser"}, &corev1.Secret{})
+	assert.True(t, apiErrors.IsNotFound(err))
+}
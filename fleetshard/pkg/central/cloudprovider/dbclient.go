@@ -3,6 +3,8 @@ package cloudprovider
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 )
@@ -11,13 +13,61 @@ import (
 //
 //go:generate moq -out dbclient_moq.go . DBClient
 type DBClient interface {
-	// EnsureDBProvisioned is a blocking function that makes sure that a database with the given databaseID was provisioned,
-	// using the master password given as parameter
-	EnsureDBProvisioned(ctx context.Context, databaseID, passwordSecretName string) error
+	// EnsureDBProvisioned is a non-blocking function that makes sure that the provisioning of a database with the
+	// given databaseID was initiated, using the master password given as parameter. It returns true once the
+	// database is available.
+	EnsureDBProvisioned(ctx context.Context, databaseID, masterPassword string) (bool, error)
 	// EnsureDBDeprovisioned is a non-blocking function that makes sure that a managed DB is deprovisioned (more
 	// specifically, that its deletion was initiated)
 	EnsureDBDeprovisioned(databaseID string) error
 	// GetDBConnection returns a postgres.DBConnection struct, which contains the data necessary
 	// to construct a PostgreSQL connection string. It expects that the database was already provisioned.
 	GetDBConnection(databaseID string) (postgres.DBConnection, error)
+	// ResetDBMasterPassword is a non-blocking function that sets the master password of an already provisioned
+	// database. It is used to gain access to the database of a Central migrated from another data-plane cluster.
+	ResetDBMasterPassword(databaseID, masterPassword string) error
+	// EnsureDBSnapshotCreated is a non-blocking function that makes sure that the creation of a snapshot with the
+	// given snapshotID of the database with the given databaseID was initiated. It returns true once the snapshot
+	// is available. Errors which will not go away when retried are marked with NewTerminalError.
+	EnsureDBSnapshotCreated(databaseID, snapshotID string) (bool, error)
+	// EnsureDBRestored is a non-blocking function that makes sure that the restore of the given source to a new
+	// database with the given targetDatabaseID was initiated. It returns true once the new database is available.
+	// Errors which will not go away when retried are marked with NewTerminalError.
+	EnsureDBRestored(targetDatabaseID string, source DBRestoreSource) (bool, error)
+}
+
+// DBRestoreSource describes the state of a database that a new database is restored from
+type DBRestoreSource struct {
+	// DatabaseID is the ID of the database that is restored
+	DatabaseID string
+	// SnapshotID is the ID of a snapshot of the database created by EnsureDBSnapshotCreated. If it is empty,
+	// the database is restored to RestoreTime instead.
+	SnapshotID string
+	// RestoreTime is the point in time the database is restored to. If it is nil, the latest restorable time is used.
+	RestoreTime *time.Time
+}
+
+// TerminalError marks an error of a DBClient operation which will not succeed when it is retried, for example
+// because the request was rejected as invalid. Other errors, such as throttling or network errors, are transient.
+type TerminalError struct {
+	err error
+}
+
+// NewTerminalError marks the given error as terminal.
+func NewTerminalError(err error) error {
+	return &TerminalError{err: err}
+}
+
+func (e *TerminalError) Error() string {
+	return e.err.Error()
+}
+
+func (e *TerminalError) Unwrap() error {
+	return e.err
+}
+
+// IsTerminalError returns true if the error or any error it wraps was marked with NewTerminalError.
+func IsTerminalError(err error) bool {
+	var terminalErr *TerminalError
+	return errors.As(err, &terminalErr)
 }
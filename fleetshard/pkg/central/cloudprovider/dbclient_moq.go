@@ -22,12 +22,21 @@ var _ DBClient = &DBClientMock{}
 //			EnsureDBDeprovisionedFunc: func(databaseID string) error {
 //				panic("mock out the EnsureDBDeprovisioned method")
 //			},
-//			EnsureDBProvisionedFunc: func(ctx context.Context, databaseID string, passwordSecretName string) error {
+//			EnsureDBProvisionedFunc: func(ctx context.Context, databaseID string, masterPassword string) (bool, error) {
 //				panic("mock out the EnsureDBProvisioned method")
 //			},
+//			EnsureDBRestoredFunc: func(targetDatabaseID string, source DBRestoreSource) (bool, error) {
+//				panic("mock out the EnsureDBRestored method")
+//			},
+//			EnsureDBSnapshotCreatedFunc: func(databaseID string, snapshotID string) (bool, error) {
+//				panic("mock out the EnsureDBSnapshotCreated method")
+//			},
 //			GetDBConnectionFunc: func(databaseID string) (postgres.DBConnection, error) {
 //				panic("mock out the GetDBConnection method")
 //			},
+//			ResetDBMasterPasswordFunc: func(databaseID string, masterPassword string) error {
+//				panic("mock out the ResetDBMasterPassword method")
+//			},
 //		}
 //
 //		// use mockedDBClient in code that requires DBClient
@@ -39,11 +48,20 @@ type DBClientMock struct {
 	EnsureDBDeprovisionedFunc func(databaseID string) error
 
 	// EnsureDBProvisionedFunc mocks the EnsureDBProvisioned method.
-	EnsureDBProvisionedFunc func(ctx context.Context, databaseID string, passwordSecretName string) error
+	EnsureDBProvisionedFunc func(ctx context.Context, databaseID string, masterPassword string) (bool, error)
+
+	// EnsureDBRestoredFunc mocks the EnsureDBRestored method.
+	EnsureDBRestoredFunc func(targetDatabaseID string, source DBRestoreSource) (bool, error)
+
+	// EnsureDBSnapshotCreatedFunc mocks the EnsureDBSnapshotCreated method.
+	EnsureDBSnapshotCreatedFunc func(databaseID string, snapshotID string) (bool, error)
 
 	// GetDBConnectionFunc mocks the GetDBConnection method.
 	GetDBConnectionFunc func(databaseID string) (postgres.DBConnection, error)
 
+	// ResetDBMasterPasswordFunc mocks the ResetDBMasterPassword method.
+	ResetDBMasterPasswordFunc func(databaseID string, masterPassword string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// EnsureDBDeprovisioned holds details about calls to the EnsureDBDeprovisioned method.
@@ -57,18 +75,42 @@ type DBClientMock struct {
 			Ctx context.Context
 			// DatabaseID is the databaseID argument value.
 			DatabaseID string
-			// PasswordSecretName is the passwordSecretName argument value.
-			PasswordSecretName string
+			// MasterPassword is the masterPassword argument value.
+			MasterPassword string
+		}
+		// EnsureDBRestored holds details about calls to the EnsureDBRestored method.
+		EnsureDBRestored []struct {
+			// TargetDatabaseID is the targetDatabaseID argument value.
+			TargetDatabaseID string
+			// Source is the source argument value.
+			Source DBRestoreSource
+		}
+		// EnsureDBSnapshotCreated holds details about calls to the EnsureDBSnapshotCreated method.
+		EnsureDBSnapshotCreated []struct {
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+			// SnapshotID is the snapshotID argument value.
+			SnapshotID string
 		}
 		// GetDBConnection holds details about calls to the GetDBConnection method.
 		GetDBConnection []struct {
 			// DatabaseID is the databaseID argument value.
 			DatabaseID string
 		}
+		// ResetDBMasterPassword holds details about calls to the ResetDBMasterPassword method.
+		ResetDBMasterPassword []struct {
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+			// MasterPassword is the masterPassword argument value.
+			MasterPassword string
+		}
 	}
-	lockEnsureDBDeprovisioned sync.RWMutex
-	lockEnsureDBProvisioned   sync.RWMutex
-	lockGetDBConnection       sync.RWMutex
+	lockEnsureDBDeprovisioned   sync.RWMutex
+	lockEnsureDBProvisioned     sync.RWMutex
+	lockEnsureDBRestored        sync.RWMutex
+	lockEnsureDBSnapshotCreated sync.RWMutex
+	lockGetDBConnection         sync.RWMutex
+	lockResetDBMasterPassword   sync.RWMutex
 }
 
 // EnsureDBDeprovisioned calls EnsureDBDeprovisionedFunc.
@@ -104,23 +146,23 @@ func (mock *DBClientMock) EnsureDBDeprovisionedCalls() []struct {
 }
 
 // EnsureDBProvisioned calls EnsureDBProvisionedFunc.
-func (mock *DBClientMock) EnsureDBProvisioned(ctx context.Context, databaseID string, passwordSecretName string) error {
+func (mock *DBClientMock) EnsureDBProvisioned(ctx context.Context, databaseID string, masterPassword string) (bool, error) {
 	if mock.EnsureDBProvisionedFunc == nil {
 		panic("DBClientMock.EnsureDBProvisionedFunc: method is nil but DBClient.EnsureDBProvisioned was just called")
 	}
 	callInfo := struct {
-		Ctx                context.Context
-		DatabaseID         string
-		PasswordSecretName string
+		Ctx            context.Context
+		DatabaseID     string
+		MasterPassword string
 	}{
-		Ctx:                ctx,
-		DatabaseID:         databaseID,
-		PasswordSecretName: passwordSecretName,
+		Ctx:            ctx,
+		DatabaseID:     databaseID,
+		MasterPassword: masterPassword,
 	}
 	mock.lockEnsureDBProvisioned.Lock()
 	mock.calls.EnsureDBProvisioned = append(mock.calls.EnsureDBProvisioned, callInfo)
 	mock.lockEnsureDBProvisioned.Unlock()
-	return mock.EnsureDBProvisionedFunc(ctx, databaseID, passwordSecretName)
+	return mock.EnsureDBProvisionedFunc(ctx, databaseID, masterPassword)
 }
 
 // EnsureDBProvisionedCalls gets all the calls that were made to EnsureDBProvisioned.
@@ -128,14 +170,14 @@ func (mock *DBClientMock) EnsureDBProvisioned(ctx context.Context, databaseID st
 //
 //	len(mockedDBClient.EnsureDBProvisionedCalls())
 func (mock *DBClientMock) EnsureDBProvisionedCalls() []struct {
-	Ctx                context.Context
-	DatabaseID         string
-	PasswordSecretName string
+	Ctx            context.Context
+	DatabaseID     string
+	MasterPassword string
 } {
 	var calls []struct {
-		Ctx                context.Context
-		DatabaseID         string
-		PasswordSecretName string
+		Ctx            context.Context
+		DatabaseID     string
+		MasterPassword string
 	}
 	mock.lockEnsureDBProvisioned.RLock()
 	calls = mock.calls.EnsureDBProvisioned
@@ -143,6 +185,78 @@ func (mock *DBClientMock) EnsureDBProvisionedCalls() []struct {
 	return calls
 }
 
+// EnsureDBRestored calls EnsureDBRestoredFunc.
+func (mock *DBClientMock) EnsureDBRestored(targetDatabaseID string, source DBRestoreSource) (bool, error) {
+	if mock.EnsureDBRestoredFunc == nil {
+		panic("DBClientMock.EnsureDBRestoredFunc: method is nil but DBClient.EnsureDBRestored was just called")
+	}
+	callInfo := struct {
+		TargetDatabaseID string
+		Source           DBRestoreSource
+	}{
+		TargetDatabaseID: targetDatabaseID,
+		Source:           source,
+	}
+	mock.lockEnsureDBRestored.Lock()
+	mock.calls.EnsureDBRestored = append(mock.calls.EnsureDBRestored, callInfo)
+	mock.lockEnsureDBRestored.Unlock()
+	return mock.EnsureDBRestoredFunc(targetDatabaseID, source)
+}
+
+// EnsureDBRestoredCalls gets all the calls that were made to EnsureDBRestored.
+// Check the length with:
+//
+//	len(mockedDBClient.EnsureDBRestoredCalls())
+func (mock *DBClientMock) EnsureDBRestoredCalls() []struct {
+	TargetDatabaseID string
+	Source           DBRestoreSource
+} {
+	var calls []struct {
+		TargetDatabaseID string
+		Source           DBRestoreSource
+	}
+	mock.lockEnsureDBRestored.RLock()
+	calls = mock.calls.EnsureDBRestored
+	mock.lockEnsureDBRestored.RUnlock()
+	return calls
+}
+
+// EnsureDBSnapshotCreated calls EnsureDBSnapshotCreatedFunc.
+func (mock *DBClientMock) EnsureDBSnapshotCreated(databaseID string, snapshotID string) (bool, error) {
+	if mock.EnsureDBSnapshotCreatedFunc == nil {
+		panic("DBClientMock.EnsureDBSnapshotCreatedFunc: method is nil but DBClient.EnsureDBSnapshotCreated was just called")
+	}
+	callInfo := struct {
+		DatabaseID string
+		SnapshotID string
+	}{
+		DatabaseID: databaseID,
+		SnapshotID: snapshotID,
+	}
+	mock.lockEnsureDBSnapshotCreated.Lock()
+	mock.calls.EnsureDBSnapshotCreated = append(mock.calls.EnsureDBSnapshotCreated, callInfo)
+	mock.lockEnsureDBSnapshotCreated.Unlock()
+	return mock.EnsureDBSnapshotCreatedFunc(databaseID, snapshotID)
+}
+
+// EnsureDBSnapshotCreatedCalls gets all the calls that were made to EnsureDBSnapshotCreated.
+// Check the length with:
+//
+//	len(mockedDBClient.EnsureDBSnapshotCreatedCalls())
+func (mock *DBClientMock) EnsureDBSnapshotCreatedCalls() []struct {
+	DatabaseID string
+	SnapshotID string
+} {
+	var calls []struct {
+		DatabaseID string
+		SnapshotID string
+	}
+	mock.lockEnsureDBSnapshotCreated.RLock()
+	calls = mock.calls.EnsureDBSnapshotCreated
+	mock.lockEnsureDBSnapshotCreated.RUnlock()
+	return calls
+}
+
 // GetDBConnection calls GetDBConnectionFunc.
 func (mock *DBClientMock) GetDBConnection(databaseID string) (postgres.DBConnection, error) {
 	if mock.GetDBConnectionFunc == nil {
@@ -174,3 +288,39 @@ func (mock *DBClientMock) GetDBConnectionCalls() []struct {
 	mock.lockGetDBConnection.RUnlock()
 	return calls
 }
+
+// ResetDBMasterPassword calls ResetDBMasterPasswordFunc.
+func (mock *DBClientMock) ResetDBMasterPassword(databaseID string, masterPassword string) error {
+	if mock.ResetDBMasterPasswordFunc == nil {
+		panic("DBClientMock.ResetDBMasterPasswordFunc: method is nil but DBClient.ResetDBMasterPassword was just called")
+	}
+	callInfo := struct {
+		DatabaseID     string
+		MasterPassword string
+	}{
+		DatabaseID:     databaseID,
+		MasterPassword: masterPassword,
+	}
+	mock.lockResetDBMasterPassword.Lock()
+	mock.calls.ResetDBMasterPassword = append(mock.calls.ResetDBMasterPassword, callInfo)
+	mock.lockResetDBMasterPassword.Unlock()
+	return mock.ResetDBMasterPasswordFunc(databaseID, masterPassword)
+}
+
+// ResetDBMasterPasswordCalls gets all the calls that were made to ResetDBMasterPassword.
+// Check the length with:
+//
+//	len(mockedDBClient.ResetDBMasterPasswordCalls())
+func (mock *DBClientMock) ResetDBMasterPasswordCalls() []struct {
+	DatabaseID     string
+	MasterPassword string
+} {
+	var calls []struct {
+		DatabaseID     string
+		MasterPassword string
+	}
+	mock.lockResetDBMasterPassword.RLock()
+	calls = mock.calls.ResetDBMasterPassword
+	mock.lockResetDBMasterPassword.RUnlock()
+	return calls
+}
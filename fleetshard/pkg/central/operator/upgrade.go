@@ -4,11 +4,15 @@ package operator
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/charts"
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chartutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,20 +21,47 @@ import (
 const (
 	operatorNamespace = "stackrox-operator"
 	releaseName       = "rhacs-operator"
-	operatorImage     = "quay.io/rhacs-eng/stackrox-operator:3.74.0"
+
+	operatorAppLabelKey     = "app"
+	operatorAppLabelValue   = "rhacs-operator"
+	operatorVersionLabelKey = "rhacs.redhat.com/operator-version"
+
+	// CentralOperatorVersionLabelKey is the label of a Central selecting the ACS operator version reconciling it.
+	CentralOperatorVersionLabelKey = "stackrox.io/operator-version"
+	centralOperatorVersionPrefix   = "rhacs-operator.v"
 )
 
+// OperatorImage is an ACS operator version installed on the cluster.
+type OperatorImage struct {
+	Version string
+	Image   string
+}
+
+// CentralOperatorVersionLabelValue returns the value of the CentralOperatorVersionLabelKey label of Centrals
+// reconciled by the given ACS operator version.
+func CentralOperatorVersionLabelValue(version string) string {
+	return centralOperatorVersionPrefix + version
+}
+
 // ACSOperatorManager keeps data necessary for managing ACS Operator
 type ACSOperatorManager struct {
 	client         ctrlClient.Client
 	resourcesChart *chart.Chart
 }
 
-// InstallOrUpgrade provisions or upgrades an existing ACS Operator from helm chart template
-func (u *ACSOperatorManager) InstallOrUpgrade(ctx context.Context) error {
+// InstallOrUpgrade provisions or upgrades the given ACS Operator versions side by side from helm chart template.
+// Each version is installed with its own deployment which only reconciles the Centrals labelled with the version.
+func (u *ACSOperatorManager) InstallOrUpgrade(ctx context.Context, images []OperatorImage) error {
+	var chartImages []interface{}
+	for _, image := range images {
+		chartImages = append(chartImages, chartutil.Values{
+			"version": image.Version,
+			"image":   image.Image,
+		})
+	}
 	chartVals := chartutil.Values{
 		"operator": chartutil.Values{
-			"image": operatorImage,
+			"images": chartImages,
 		},
 	}
 	u.resourcesChart = charts.MustGetChart("rhacs-operator")
@@ -72,6 +103,54 @@ func (u *ACSOperatorManager) InstallOrUpgrade(ctx context.Context) error {
 
 }
 
+// RemoveUnusedVersions deletes the ACS Operator versions which are neither desired nor referenced by a Central on
+// the cluster. Operators installed before the versions were installed side by side are deleted as well.
+func (u *ACSOperatorManager) RemoveUnusedVersions(ctx context.Context, desiredVersions []string) error {
+	usedVersions := make(map[string]bool, len(desiredVersions))
+	for _, version := range desiredVersions {
+		usedVersions[version] = true
+	}
+	centrals := &v1alpha1.CentralList{}
+	if err := u.client.List(ctx, centrals); err != nil {
+		return fmt.Errorf("listing centrals: %w", err)
+	}
+	for _, central := range centrals.Items {
+		label := central.GetLabels()[CentralOperatorVersionLabelKey]
+		if strings.HasPrefix(label, centralOperatorVersionPrefix) {
+			usedVersions[strings.TrimPrefix(label, centralOperatorVersionPrefix)] = true
+		}
+	}
+
+	operatorLabels := ctrlClient.MatchingLabels{operatorAppLabelKey: operatorAppLabelValue}
+	deployments := &appsv1.DeploymentList{}
+	if err := u.client.List(ctx, deployments, ctrlClient.InNamespace(operatorNamespace), operatorLabels); err != nil {
+		return fmt.Errorf("listing ACS Operator deployments: %w", err)
+	}
+	services := &corev1.ServiceList{}
+	if err := u.client.List(ctx, services, ctrlClient.InNamespace(operatorNamespace), operatorLabels); err != nil {
+		return fmt.Errorf("listing ACS Operator services: %w", err)
+	}
+
+	var objs []ctrlClient.Object
+	for i := range deployments.Items {
+		objs = append(objs, &deployments.Items[i])
+	}
+	for i := range services.Items {
+		objs = append(objs, &services.Items[i])
+	}
+	for _, obj := range objs {
+		version := obj.GetLabels()[operatorVersionLabelKey]
+		if usedVersions[version] {
+			continue
+		}
+		glog.Infof("Deleting unused ACS Operator %s/%s of version %q", obj.GetNamespace(), obj.GetName(), version)
+		if err := u.client.Delete(ctx, obj); err != nil && !apiErrors.IsNotFound(err) {
+			return fmt.Errorf("deleting ACS Operator %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
 // NewACSOperatorManager creates a new ACS Operator Manager
 func NewACSOperatorManager(k8sClient ctrlClient.Client) *ACSOperatorManager {
 	return &ACSOperatorManager{
@@ -2,10 +2,16 @@ package operator
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
@@ -48,22 +54,36 @@ var serviceAccount = &unstructured.Unstructured{
 	},
 }
 
-var operatorDeployment = &unstructured.Unstructured{
-	Object: map[string]interface{}{
-		"kind":       "Deployment",
-		"apiVersion": "apps/v1",
-		"metadata": map[string]interface{}{
-			"name":      "rhacs-operator-controller-manager",
-			"namespace": operatorNamespace,
+var operatorImages = []OperatorImage{
+	{Version: "3.74.0", Image: "quay.io/rhacs-eng/stackrox-operator:3.74.0"},
+	{Version: "4.0.0", Image: "quay.io/rhacs-eng/stackrox-operator:4.0.0"},
+}
+
+func newOperatorDeployment(name, version string) *appsv1.Deployment {
+	labels := map[string]string{operatorAppLabelKey: operatorAppLabelValue}
+	if version != "" {
+		labels[operatorVersionLabelKey] = version
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: operatorNamespace, Labels: labels},
+	}
+}
+
+func newCentral(name, operatorVersionLabel string) *v1alpha1.Central {
+	return &v1alpha1.Central{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "rhacs-" + name,
+			Labels:    map[string]string{CentralOperatorVersionLabelKey: operatorVersionLabel},
 		},
-	},
+	}
 }
 
 func TestOperatorUpgradeFreshInstall(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t).Build()
 	u := NewACSOperatorManager(fakeClient)
 
-	err := u.InstallOrUpgrade(context.Background())
+	err := u.InstallOrUpgrade(context.Background(), operatorImages)
 
 	require.NoError(t, err)
 
@@ -88,18 +108,59 @@ func TestOperatorUpgradeFreshInstall(t *testing.T) {
 	assert.NotEmpty(t, centralCRD.Object["metadata"])
 	assert.NotEmpty(t, centralCRD.Object["spec"])
 
-	// check Operator Deployment exists
-	err = fakeClient.Get(context.Background(), client.ObjectKey{Namespace: operatorNamespace, Name: operatorDeployment.GetName()}, operatorDeployment)
+	// check a Deployment exists for each Operator version
+	for _, image := range operatorImages {
+		operatorDeployment := &unstructured.Unstructured{}
+		operatorDeployment.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+		err = fakeClient.Get(context.Background(), client.ObjectKey{Namespace: operatorNamespace, Name: "rhacs-operator-controller-manager-" + strings.ReplaceAll(image.Version, ".", "-")}, operatorDeployment)
+		require.NoError(t, err)
+		assert.Equal(t, "apps/v1", operatorDeployment.GetAPIVersion())
+		assert.Equal(t, image.Version, operatorDeployment.GetLabels()[operatorVersionLabelKey])
+		assert.NotEmpty(t, operatorDeployment.Object["spec"])
+		templateSpec := operatorDeployment.Object["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"]
+		assert.NotEmpty(t, templateSpec)
+		assert.Contains(t, templateSpec, "containers")
+		containers := templateSpec.(map[string]interface{})["containers"].([]interface{})
+		assert.Len(t, containers, 2)
+		managerContainer := containers[1].(map[string]interface{})
+		assert.Equal(t, image.Image, managerContainer["image"])
+		assert.Contains(t, managerContainer["env"], map[string]interface{}{
+			"name":  "CENTRAL_LABEL_SELECTOR",
+			"value": "stackrox.io/operator-version=rhacs-operator.v" + image.Version,
+		})
+	}
+}
+
+func TestRemoveUnusedVersions(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t,
+		newOperatorDeployment("rhacs-operator-controller-manager", ""),
+		newOperatorDeployment("rhacs-operator-controller-manager-3-72-0", "3.72.0"),
+		newOperatorDeployment("rhacs-operator-controller-manager-3-73-0", "3.73.0"),
+		newOperatorDeployment("rhacs-operator-controller-manager-3-74-0", "3.74.0"),
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name:      "rhacs-operator-controller-manager-metrics-service-3-72-0",
+			Namespace: operatorNamespace,
+			Labels:    map[string]string{operatorAppLabelKey: operatorAppLabelValue, operatorVersionLabelKey: "3.72.0"},
+		}},
+		newCentral("central-1", CentralOperatorVersionLabelValue("3.73.0")),
+		newCentral("central-2", CentralOperatorVersionLabelValue("3.74.0")),
+	).Build()
+	u := NewACSOperatorManager(fakeClient)
+
+	err := u.RemoveUnusedVersions(context.Background(), []string{"3.74.0"})
 	require.NoError(t, err)
-	assert.Equal(t, "apps/v1", operatorDeployment.GetAPIVersion())
-	assert.NotEmpty(t, operatorDeployment.Object["metadata"])
-	assert.NotEmpty(t, operatorDeployment.Object["spec"])
-	templateSpec := operatorDeployment.Object["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"]
-	assert.NotEmpty(t, templateSpec)
-	assert.Contains(t, templateSpec, "containers")
-	containers := templateSpec.(map[string]interface{})["containers"].([]interface{})
-	assert.Len(t, containers, 2)
-	managerContainer := containers[1].(map[string]interface{})
-	assert.Equal(t, managerContainer["image"], operatorImage)
 
+	deployments := &appsv1.DeploymentList{}
+	require.NoError(t, fakeClient.List(context.Background(), deployments, client.InNamespace(operatorNamespace)))
+	var names []string
+	for _, deployment := range deployments.Items {
+		names = append(names, deployment.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"rhacs-operator-controller-manager-3-73-0",
+		"rhacs-operator-controller-manager-3-74-0",
+	}, names)
+
+	err = fakeClient.Get(context.Background(), client.ObjectKey{Namespace: operatorNamespace, Name: "rhacs-operator-controller-manager-metrics-service-3-72-0"}, &corev1.Service{})
+	assert.True(t, apiErrors.IsNotFound(err))
 }
@@ -54,6 +54,156 @@ func InitializeDatabase(ctx context.Context, con DBConnection, userName, userPas
 	return nil
 }
 
+// MigrationUserInitFunc returns a CentralDBInitFunc which creates a DB user for a Central migrated to another
+// data-plane cluster, without changing the credentials of the given Central DB user still used by the migration source.
+// The migration user is granted the role of the Central DB user, so that it can access all objects owned by it.
+func MigrationUserInitFunc(centralUserName string) CentralDBInitFunc {
+	return func(ctx context.Context, con DBConnection, userName, userPassword string) error {
+		db, err := sql.Open("postgres", con.asConnectionStringWithPassword())
+		if err != nil {
+			return fmt.Errorf("opening DB: %w", err)
+		}
+
+		defer func() {
+			if closeErr := db.Close(); closeErr != nil {
+				glog.Errorf("Error closing DB: %v", closeErr)
+			}
+		}()
+
+		if err := initializeCentralDBUser(ctx, db, userName, userPassword); err != nil {
+			return err
+		}
+		return grantRole(ctx, db, centralUserName, userName)
+	}
+}
+
+// DropDBUserFunc is a type for functions that remove a DB user which is no longer used by a Central.
+// It requires a valid DBConnection of a user with administrative privileges.
+type DropDBUserFunc func(ctx context.Context, con DBConnection, userName string) error
+
+// DropMigrationUserFunc returns a DropDBUserFunc which removes the DB user of a Central migrated to this data-plane
+// cluster once the Central switched over to the given Central DB user. The objects owned by the migration user are
+// handed over to the Central DB user first. Nothing is done if the migration user does not exist.
+func DropMigrationUserFunc(centralUserName string) DropDBUserFunc {
+	return func(ctx context.Context, con DBConnection, userName string) error {
+		db, err := sql.Open("postgres", con.asConnectionStringWithPassword())
+		if err != nil {
+			return fmt.Errorf("opening DB: %w", err)
+		}
+
+		defer func() {
+			if closeErr := db.Close(); closeErr != nil {
+				glog.Errorf("Error closing DB: %v", closeErr)
+			}
+		}()
+
+		exists, err := userExists(ctx, db, userName)
+		if err != nil || !exists {
+			return err
+		}
+
+		// The objects of a user can only be reassigned by members of its role.
+		if err := grantRole(ctx, db, userName, con.user); err != nil {
+			return err
+		}
+		databases, err := listOwnedDatabases(ctx, db, userName, centralUserName)
+		if err != nil {
+			return err
+		}
+		for _, database := range databases {
+			databaseCon := con
+			databaseCon.database = database
+			if err := reassignOwnedObjects(ctx, databaseCon, userName, centralUserName); err != nil {
+				return err
+			}
+		}
+
+		_, err = db.ExecContext(ctx, "DROP ROLE "+userName)
+		if err != nil {
+			return fmt.Errorf("dropping DB user %s: %w", userName, err)
+		}
+		glog.Infof("Dropped DB user %s", userName)
+		return nil
+	}
+}
+
+func userExists(ctx context.Context, db *sql.DB, userName string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname=$1)", userName).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("checking if DB user %s exists: %w", userName, err)
+	}
+
+	return exists, nil
+}
+
+// listOwnedDatabases returns the databases owned by any of the given users, i.e. the databases of a Central.
+func listOwnedDatabases(ctx context.Context, db *sql.DB, userNames ...string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT datname FROM pg_database WHERE pg_get_userbyid(datdba) = ANY($1)", pq.Array(userNames))
+	if err != nil {
+		return nil, fmt.Errorf("listing databases: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			glog.Errorf("Error closing rows: %v", closeErr)
+		}
+	}()
+
+	var databases []string
+	for rows.Next() {
+		var database string
+		if err := rows.Scan(&database); err != nil {
+			return nil, fmt.Errorf("reading database name: %w", err)
+		}
+		databases = append(databases, database)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing databases: %w", err)
+	}
+
+	return databases, nil
+}
+
+// reassignOwnedObjects hands the objects of a user in the database of the given connection over to the new owner,
+// and revokes the privileges of the user in it.
+func reassignOwnedObjects(ctx context.Context, con DBConnection, userName, newOwner string) error {
+	db, err := sql.Open("postgres", con.asConnectionStringWithPassword())
+	if err != nil {
+		return fmt.Errorf("opening DB: %w", err)
+	}
+
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil {
+			glog.Errorf("Error closing DB: %v", closeErr)
+		}
+	}()
+
+	return reassignOwnedObjectsInDB(ctx, db, userName, newOwner)
+}
+
+func reassignOwnedObjectsInDB(ctx context.Context, db *sql.DB, userName, newOwner string) error {
+	_, err := db.ExecContext(ctx, "REASSIGN OWNED BY "+userName+" TO "+newOwner)
+	if err != nil {
+		return fmt.Errorf("reassigning objects of %s to %s: %w", userName, newOwner, err)
+	}
+
+	_, err = db.ExecContext(ctx, "DROP OWNED BY "+userName)
+	if err != nil {
+		return fmt.Errorf("revoking privileges of %s: %w", userName, err)
+	}
+
+	return nil
+}
+
+func grantRole(ctx context.Context, db *sql.DB, role, userName string) error {
+	_, err := db.ExecContext(ctx, "GRANT "+role+" TO "+userName)
+	if err != nil {
+		return fmt.Errorf("granting %s role to %s: %w", role, userName, err)
+	}
+
+	return nil
+}
+
 func initializeCentralDBUser(ctx context.Context, db *sql.DB, userName, userPassword string) error {
 	err := createNonPrivilegedUser(ctx, db, userName, userPassword)
 	if err == nil {
@@ -77,6 +77,24 @@ func TestInitializeNonPrivilegedUserAlreadyExists(t *testing.T) {
 	}
 }
 
+func TestGrantRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("opening a stub database connection: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("GRANT rhacs_central TO rhacs_central_migration").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = grantRole(context.TODO(), db, "rhacs_central", "rhacs_central_migration")
+	require.NoError(t, err)
+
+	// we make sure that all expectations were met
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expections: %s", err)
+	}
+}
+
 func TestCreateCentralDB(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -137,3 +155,42 @@ func TestInstallExtensions(t *testing.T) {
 		t.Errorf("there were unfulfilled expections: %s", err)
 	}
 }
+
+func TestUserExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT EXISTS").WithArgs("test_user").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := userExists(context.TODO(), db, "test_user")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListOwnedDatabases(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT datname FROM pg_database").
+		WillReturnRows(sqlmock.NewRows([]string{"datname"}).AddRow("central_active").AddRow("central_previous"))
+
+	databases, err := listOwnedDatabases(context.TODO(), db, "migration_user", "central_user")
+	require.NoError(t, err)
+	require.Equal(t, []string{"central_active", "central_previous"}, databases)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReassignOwnedObjects(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("REASSIGN OWNED BY migration_user TO central_user").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DROP OWNED BY migration_user").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, reassignOwnedObjectsInDB(context.TODO(), db, "migration_user", "central_user"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -9,11 +9,14 @@ var (
 	ErrCentralNotChanged = errors.New("central not changed")
 	// ErrDeletionInProgress returned when central resources are currently deleting
 	ErrDeletionInProgress = errors.New("deletion in progress")
+	// ErrManagedDBNotReady returned when the managed DB of a central is still being provisioned
+	ErrManagedDBNotReady = errors.New("managed DB not ready")
 )
 
 // IsSkippable indicates that the reconciliation was skipped and the status should NOT be reported.
 func IsSkippable(err error) bool {
 	return errors.Is(err, ErrBusy) ||
 		errors.Is(err, ErrCentralNotChanged) ||
-		errors.Is(err, ErrDeletionInProgress)
+		errors.Is(err, ErrDeletionInProgress) ||
+		errors.Is(err, ErrManagedDBNotReady)
 }
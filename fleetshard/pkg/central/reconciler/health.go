@@ -0,0 +1,333 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	openshiftRouteV1 "github.com/openshift/api/route/v1"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Types of the health conditions reported in addition to the Ready condition of a ready Central.
+const (
+	conditionTypeCentralDeployed    = "CentralDeployed"
+	conditionTypeScannerAvailable   = "ScannerAvailable"
+	conditionTypeScannerDBAvailable = "ScannerDBAvailable"
+	conditionTypePodsStable         = "PodsStable"
+	conditionTypeDatabaseAvailable  = "DatabaseAvailable"
+	conditionTypeRoutesAdmitted     = "RoutesAdmitted"
+
+	conditionStatusTrue    = "True"
+	conditionStatusFalse   = "False"
+	conditionStatusUnknown = "Unknown"
+
+	// healthCheckInterval is the minimal interval between two health checks of a ready Central which did not change.
+	healthCheckInterval = time.Minute
+	// healthReportInterval is the interval after which unchanged health conditions are reported again, so that
+	// fleet-manager eventually stores them even if a status report was lost.
+	healthReportInterval = 10 * time.Minute
+	// podRestartWindow is the window in which a restarted container makes a Central unstable.
+	podRestartWindow = 15 * time.Minute
+	dbDialTimeout    = 5 * time.Second
+
+	centralDBPVCName = "central-db"
+)
+
+// dialFunc opens a network connection, see net.Dialer.DialContext.
+type dialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// checkHealth inspects the resources of a Central and returns its health conditions. A check which fails because of
+// an error results in a condition with status Unknown, so that the other checks are still reported.
+func (r *CentralReconciler) checkHealth(ctx context.Context, remoteCentral private.ManagedCentral) []private.DataPlaneClusterUpdateStatusRequestConditions {
+	namespace := remoteCentral.Metadata.Namespace
+	central := &v1alpha1.Central{}
+	centralErr := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: remoteCentral.Metadata.Name}, central)
+
+	conditions := []private.DataPlaneClusterUpdateStatusRequestConditions{
+		centralDeployedCondition(central, centralErr),
+		r.deploymentCondition(ctx, conditionTypeScannerAvailable, namespace, "scanner"),
+		r.deploymentCondition(ctx, conditionTypeScannerDBAvailable, namespace, "scanner-db"),
+		r.podsStableCondition(ctx, namespace),
+	}
+	if r.managedDBEnabled {
+		conditions = append(conditions, r.managedDBCondition(ctx, central, centralErr))
+	} else {
+		conditions = append(conditions, r.pvcCondition(ctx, namespace))
+	}
+	if r.useRoutes {
+		conditions = append(conditions, r.routesAdmittedCondition(ctx, namespace))
+	}
+	return conditions
+}
+
+// healthChanged returns whether the given health conditions differ from the ones last reported, or whether the last
+// report is older than healthReportInterval.
+func (r *CentralReconciler) healthChanged(conditions []private.DataPlaneClusterUpdateStatusRequestConditions) bool {
+	if time.Since(r.lastHealthReport) >= healthReportInterval || len(conditions) != len(r.lastHealthConditions) {
+		return true
+	}
+	for i := range conditions {
+		if conditions[i] != r.lastHealthConditions[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// readyStatusWithHealth returns the ready status of a Central with its health conditions, and remembers them as last
+// reported health conditions.
+func (r *CentralReconciler) readyStatusWithHealth(conditions []private.DataPlaneClusterUpdateStatusRequestConditions) *private.DataPlaneCentralStatus {
+	status := readyStatus()
+	status.Conditions = append(status.Conditions, conditions...)
+	r.lastHealthConditions = conditions
+	r.lastHealthReport = time.Now()
+	return status
+}
+
+// reportHealthIfChanged checks the health of a ready Central which did not change. It returns the ready status with
+// the health conditions if they changed, and ErrCentralNotChanged otherwise.
+func (r *CentralReconciler) reportHealthIfChanged(ctx context.Context, remoteCentral private.ManagedCentral) (*private.DataPlaneCentralStatus, error) {
+	if time.Since(r.lastHealthCheck) < healthCheckInterval {
+		return nil, ErrCentralNotChanged
+	}
+	r.lastHealthCheck = time.Now()
+	conditions := r.checkHealth(ctx, remoteCentral)
+	if !r.healthChanged(conditions) {
+		return nil, ErrCentralNotChanged
+	}
+	return r.readyStatusWithHealth(conditions), nil
+}
+
+func centralDeployedCondition(central *v1alpha1.Central, getErr error) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{Type: conditionTypeCentralDeployed}
+	if getErr != nil {
+		return unknownCondition(condition, fmt.Errorf("retrieving central: %w", getErr))
+	}
+
+	condition.Status = conditionStatusFalse
+	condition.Reason = "NotDeployed"
+	condition.Message = "the operator did not report the central as deployed yet"
+	for _, c := range central.Status.Conditions {
+		failed := c.Type == v1alpha1.ConditionReleaseFailed || c.Type == v1alpha1.ConditionIrreconcilable
+		if failed && c.Status == v1alpha1.StatusTrue {
+			condition.Status = conditionStatusFalse
+			condition.Reason = string(c.Type)
+			condition.Message = c.Message
+			return condition
+		}
+		if c.Type == v1alpha1.ConditionDeployed && c.Status == v1alpha1.StatusTrue {
+			condition.Status = conditionStatusTrue
+			condition.Reason = string(c.Reason)
+			condition.Message = ""
+		}
+	}
+	return condition
+}
+
+func (r *CentralReconciler) deploymentCondition(ctx context.Context, conditionType, namespace, name string) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{Type: conditionType}
+	deployment := &appsv1.Deployment{}
+	if err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: name}, deployment); err != nil {
+		if apiErrors.IsNotFound(err) {
+			condition.Status = conditionStatusFalse
+			condition.Reason = "DeploymentNotFound"
+			condition.Message = fmt.Sprintf("deployment %s not found", name)
+			return condition
+		}
+		return unknownCondition(condition, fmt.Errorf("retrieving deployment %s: %w", name, err))
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if deployment.Status.AvailableReplicas < replicas || deployment.Status.UnavailableReplicas > 0 {
+		condition.Status = conditionStatusFalse
+		condition.Reason = "DeploymentUnavailable"
+		condition.Message = fmt.Sprintf("%d of %d replicas of deployment %s are available", deployment.Status.AvailableReplicas, replicas, name)
+		return condition
+	}
+	condition.Status = conditionStatusTrue
+	condition.Reason = "DeploymentAvailable"
+	return condition
+}
+
+// podsStableCondition checks whether a container of the Central is crash looping or was restarted recently.
+func (r *CentralReconciler) podsStableCondition(ctx context.Context, namespace string) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{Type: conditionTypePodsStable}
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, ctrlClient.InNamespace(namespace)); err != nil {
+		return unknownCondition(condition, fmt.Errorf("listing pods: %w", err))
+	}
+
+	var crashLooping, restarted []string
+	for _, pod := range pods.Items {
+		for _, container := range pod.Status.ContainerStatuses {
+			name := fmt.Sprintf("%s/%s", pod.GetName(), container.Name)
+			if waiting := container.State.Waiting; waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+				crashLooping = append(crashLooping, name)
+				continue
+			}
+			if terminated := container.LastTerminationState.Terminated; terminated != nil &&
+				time.Since(terminated.FinishedAt.Time) < podRestartWindow {
+				restarted = append(restarted, fmt.Sprintf("%s (%d restarts)", name, container.RestartCount))
+			}
+		}
+	}
+
+	switch {
+	case len(crashLooping) > 0:
+		condition.Status = conditionStatusFalse
+		condition.Reason = "CrashLoopBackOff"
+		condition.Message = fmt.Sprintf("containers are crash looping: %s", strings.Join(crashLooping, ", "))
+	case len(restarted) > 0:
+		condition.Status = conditionStatusFalse
+		condition.Reason = "ContainersRestarted"
+		condition.Message = fmt.Sprintf("containers restarted in the last %s: %s", podRestartWindow, strings.Join(restarted, ", "))
+	default:
+		condition.Status = conditionStatusTrue
+		condition.Reason = "NoRecentRestarts"
+	}
+	return condition
+}
+
+// pvcCondition checks whether the persistent volume claim of the Central DB deployed by the operator is bound.
+func (r *CentralReconciler) pvcCondition(ctx context.Context, namespace string) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{Type: conditionTypeDatabaseAvailable}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: centralDBPVCName}, pvc); err != nil {
+		if apiErrors.IsNotFound(err) {
+			condition.Status = conditionStatusFalse
+			condition.Reason = "PVCNotFound"
+			condition.Message = fmt.Sprintf("persistent volume claim %s not found", centralDBPVCName)
+			return condition
+		}
+		return unknownCondition(condition, fmt.Errorf("retrieving persistent volume claim %s: %w", centralDBPVCName, err))
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		condition.Status = conditionStatusFalse
+		condition.Reason = "PVCNotBound"
+		condition.Message = fmt.Sprintf("persistent volume claim %s is %s", centralDBPVCName, pvc.Status.Phase)
+		return condition
+	}
+	condition.Status = conditionStatusTrue
+	condition.Reason = "PVCBound"
+	return condition
+}
+
+// managedDBCondition checks whether the managed DB of the Central accepts connections on the address of its
+// connection string.
+func (r *CentralReconciler) managedDBCondition(ctx context.Context, central *v1alpha1.Central, getErr error) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{Type: conditionTypeDatabaseAvailable}
+	if getErr != nil {
+		return unknownCondition(condition, fmt.Errorf("retrieving central: %w", getErr))
+	}
+	if central.Spec.Central == nil || central.Spec.Central.DB == nil || central.Spec.Central.DB.ConnectionStringOverride == nil {
+		return unknownCondition(condition, fmt.Errorf("central has no DB connection string"))
+	}
+
+	address, err := dbAddress(*central.Spec.Central.DB.ConnectionStringOverride)
+	if err != nil {
+		return unknownCondition(condition, err)
+	}
+	dialCtx, cancel := context.WithTimeout(ctx, dbDialTimeout)
+	defer cancel()
+	conn, err := r.dialDB(dialCtx, "tcp", address)
+	if err != nil {
+		condition.Status = conditionStatusFalse
+		condition.Reason = "DBUnreachable"
+		condition.Message = fmt.Sprintf("connecting to DB: %v", err)
+		return condition
+	}
+	_ = conn.Close()
+	condition.Status = conditionStatusTrue
+	condition.Reason = "DBReachable"
+	return condition
+}
+
+// dbAddress returns the host:port address of a PostgreSQL connection string in key=value format.
+func dbAddress(connectionString string) (string, error) {
+	var host, port string
+	for _, field := range strings.Fields(connectionString) {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "host":
+			host = value
+		case "port":
+			port = value
+		}
+	}
+	if host == "" || port == "" {
+		return "", fmt.Errorf("DB connection string has no host or port")
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// routesAdmittedCondition checks whether the routes of the Central are admitted by a router.
+func (r *CentralReconciler) routesAdmittedCondition(ctx context.Context, namespace string) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition := private.DataPlaneClusterUpdateStatusRequestConditions{Type: conditionTypeRoutesAdmitted}
+	var routes []*openshiftRouteV1.Route
+	for _, find := range []func(context.Context, string) (*openshiftRouteV1.Route, error){
+		r.routeService.FindReencryptRoute,
+		r.routeService.FindPassthroughRoute,
+	} {
+		route, err := find(ctx, namespace)
+		if err != nil {
+			if apiErrors.IsNotFound(err) {
+				condition.Status = conditionStatusFalse
+				condition.Reason = "RouteNotFound"
+				condition.Message = err.Error()
+				return condition
+			}
+			return unknownCondition(condition, err)
+		}
+		routes = append(routes, route)
+	}
+
+	for _, route := range routes {
+		if admitted, message := isRouteAdmitted(route); !admitted {
+			condition.Status = conditionStatusFalse
+			condition.Reason = "RouteNotAdmitted"
+			condition.Message = fmt.Sprintf("route %s is not admitted: %s", route.GetName(), message)
+			return condition
+		}
+	}
+	condition.Status = conditionStatusTrue
+	condition.Reason = "RoutesAdmitted"
+	return condition
+}
+
+// isRouteAdmitted returns whether any router admitted the route, and the message of the rejection otherwise.
+func isRouteAdmitted(route *openshiftRouteV1.Route) (bool, string) {
+	message := "no router reported the route"
+	for _, ingress := range route.Status.Ingress {
+		for _, c := range ingress.Conditions {
+			if c.Type != openshiftRouteV1.RouteAdmitted {
+				continue
+			}
+			if c.Status == corev1.ConditionTrue {
+				return true, ""
+			}
+			message = fmt.Sprintf("router %s: %s", ingress.RouterName, c.Message)
+		}
+	}
+	return false, message
+}
+
+func unknownCondition(condition private.DataPlaneClusterUpdateStatusRequestConditions, err error) private.DataPlaneClusterUpdateStatusRequestConditions {
+	condition.Status = conditionStatusUnknown
+	condition.Reason = "CheckFailed"
+	condition.Message = err.Error()
+	return condition
+}
@@ -0,0 +1,274 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	openshiftRouteV1 "github.com/openshift/api/route/v1"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func healthCentral(conditions ...v1alpha1.StackRoxCondition) *v1alpha1.Central {
+	return &v1alpha1.Central{
+		ObjectMeta: metav1.ObjectMeta{Name: centralName, Namespace: centralNamespace},
+		Spec: v1alpha1.CentralSpec{
+			Central: &v1alpha1.CentralComponentSpec{
+				DB: &v1alpha1.CentralDBSpec{
+					ConnectionStringOverride: pointer.String("host=db.example.com port=5432 user=rhacs_central dbname=postgres sslmode=verify-full"),
+				},
+			},
+		},
+		Status: v1alpha1.CentralStatus{Conditions: conditions},
+	}
+}
+
+func healthDeployment(name string, availableReplicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: centralNamespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(1)},
+		Status:     appsv1.DeploymentStatus{AvailableReplicas: availableReplicas},
+	}
+}
+
+func healthPod(containerStatus corev1.ContainerStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "central-1", Namespace: centralNamespace},
+		Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{containerStatus}},
+	}
+}
+
+func healthPVC(phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: centralDBPVCName, Namespace: centralNamespace},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: phase},
+	}
+}
+
+func healthRoute(name string, admitted corev1.ConditionStatus) *openshiftRouteV1.Route {
+	return &openshiftRouteV1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: centralNamespace},
+		Status: openshiftRouteV1.RouteStatus{
+			Ingress: []openshiftRouteV1.RouteIngress{{
+				RouterName: "default",
+				Conditions: []openshiftRouteV1.RouteIngressCondition{{
+					Type:    openshiftRouteV1.RouteAdmitted,
+					Status:  admitted,
+					Message: "host already claimed",
+				}},
+			}},
+		},
+	}
+}
+
+var deployedCondition = v1alpha1.StackRoxCondition{
+	Type:   v1alpha1.ConditionDeployed,
+	Status: v1alpha1.StatusTrue,
+	Reason: v1alpha1.ReasonInstallSuccessful,
+}
+
+func TestCheckHealth(t *testing.T) {
+	tests := []struct {
+		name           string
+		objects        []client.Object
+		opts           CentralReconcilerOptions
+		dialErr        error
+		wantConditions map[string]private.DataPlaneClusterUpdateStatusRequestConditions
+	}{
+		{
+			name: "should report a healthy central",
+			objects: []client.Object{
+				healthCentral(deployedCondition),
+				healthDeployment("scanner", 1),
+				healthDeployment("scanner-db", 1),
+				healthPod(corev1.ContainerStatus{Name: "central"}),
+				healthPVC(corev1.ClaimBound),
+			},
+			wantConditions: map[string]private.DataPlaneClusterUpdateStatusRequestConditions{
+				conditionTypeCentralDeployed:    {Status: "True", Reason: "InstallSuccessful"},
+				conditionTypeScannerAvailable:   {Status: "True", Reason: "DeploymentAvailable"},
+				conditionTypeScannerDBAvailable: {Status: "True", Reason: "DeploymentAvailable"},
+				conditionTypePodsStable:         {Status: "True", Reason: "NoRecentRestarts"},
+				conditionTypeDatabaseAvailable:  {Status: "True", Reason: "PVCBound"},
+			},
+		},
+		{
+			name: "should report a degraded central",
+			objects: []client.Object{
+				healthCentral(deployedCondition, v1alpha1.StackRoxCondition{
+					Type:    v1alpha1.ConditionReleaseFailed,
+					Status:  v1alpha1.StatusTrue,
+					Message: "upgrade failed",
+				}),
+				healthDeployment("scanner", 0),
+				healthPod(corev1.ContainerStatus{
+					Name:         "central",
+					RestartCount: 3,
+					State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				}),
+				healthPVC(corev1.ClaimPending),
+			},
+			wantConditions: map[string]private.DataPlaneClusterUpdateStatusRequestConditions{
+				conditionTypeCentralDeployed: {Status: "False", Reason: "ReleaseFailed", Message: "upgrade failed"},
+				conditionTypeScannerAvailable: {
+					Status:  "False",
+					Reason:  "DeploymentUnavailable",
+					Message: "0 of 1 replicas of deployment scanner are available",
+				},
+				conditionTypeScannerDBAvailable: {Status: "False", Reason: "DeploymentNotFound", Message: "deployment scanner-db not found"},
+				conditionTypePodsStable: {
+					Status:  "False",
+					Reason:  "CrashLoopBackOff",
+					Message: "containers are crash looping: central-1/central",
+				},
+				conditionTypeDatabaseAvailable: {Status: "False", Reason: "PVCNotBound", Message: "persistent volume claim central-db is Pending"},
+			},
+		},
+		{
+			name: "should report recently restarted containers",
+			objects: []client.Object{
+				healthCentral(deployedCondition),
+				healthPod(corev1.ContainerStatus{
+					Name:                 "central",
+					RestartCount:         1,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.Now()}},
+				}),
+			},
+			wantConditions: map[string]private.DataPlaneClusterUpdateStatusRequestConditions{
+				conditionTypePodsStable: {
+					Status:  "False",
+					Reason:  "ContainersRestarted",
+					Message: "containers restarted in the last 15m0s: central-1/central (1 restarts)",
+				},
+			},
+		},
+		{
+			name: "should ignore containers restarted a long time ago",
+			objects: []client.Object{
+				healthCentral(deployedCondition),
+				healthPod(corev1.ContainerStatus{
+					Name:                 "central",
+					RestartCount:         1,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(time.Now().Add(-time.Hour))}},
+				}),
+			},
+			wantConditions: map[string]private.DataPlaneClusterUpdateStatusRequestConditions{
+				conditionTypePodsStable: {Status: "True", Reason: "NoRecentRestarts"},
+			},
+		},
+		{
+			name:    "should report a reachable managed DB",
+			objects: []client.Object{healthCentral(deployedCondition)},
+			opts:    CentralReconcilerOptions{ManagedDBEnabled: true},
+			wantConditions: map[string]private.DataPlaneClusterUpdateStatusRequestConditions{
+				conditionTypeDatabaseAvailable: {Status: "True", Reason: "DBReachable"},
+			},
+		},
+		{
+			name:    "should report an unreachable managed DB",
+			objects: []client.Object{healthCentral(deployedCondition)},
+			opts:    CentralReconcilerOptions{ManagedDBEnabled: true},
+			dialErr: errors.New("connection refused"),
+			wantConditions: map[string]private.DataPlaneClusterUpdateStatusRequestConditions{
+				conditionTypeDatabaseAvailable: {Status: "False", Reason: "DBUnreachable", Message: "connecting to DB: connection refused"},
+			},
+		},
+		{
+			name: "should report routes which are not admitted",
+			objects: []client.Object{
+				healthCentral(deployedCondition),
+				healthRoute("managed-central-reencrypt", corev1.ConditionTrue),
+				healthRoute("managed-central-passthrough", corev1.ConditionFalse),
+			},
+			opts: CentralReconcilerOptions{UseRoutes: true},
+			wantConditions: map[string]private.DataPlaneClusterUpdateStatusRequestConditions{
+				conditionTypeRoutesAdmitted: {
+					Status:  "False",
+					Reason:  "RouteNotAdmitted",
+					Message: "route managed-central-passthrough is not admitted: router default: host already claimed",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := testutils.NewFakeClientBuilder(t, tt.objects...).Build()
+			r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, tt.opts)
+			var dialedAddress string
+			r.dialDB = func(ctx context.Context, network, address string) (net.Conn, error) {
+				dialedAddress = address
+				if tt.dialErr != nil {
+					return nil, tt.dialErr
+				}
+				conn, _ := net.Pipe()
+				return conn, nil
+			}
+
+			conditions := r.checkHealth(context.Background(), simpleManagedCentral)
+
+			for conditionType, want := range tt.wantConditions {
+				got, found := conditionForType(conditions, conditionType)
+				require.True(t, found, "condition %s not found", conditionType)
+				want.Type = conditionType
+				assert.Equal(t, want, *got)
+			}
+			if tt.opts.ManagedDBEnabled {
+				assert.Equal(t, "db.example.com:5432", dialedAddress)
+			}
+		})
+	}
+}
+
+func TestReconcileReportsHealthOfReadyCentralIfChanged(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t,
+		healthCentral(deployedCondition),
+		healthDeployment("scanner", 1),
+		centralDeploymentObject(),
+	).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, CentralReconcilerOptions{})
+	managedCentral := simpleManagedCentral
+	managedCentral.RequestStatus = centralConstants.CentralRequestStatusReady.String()
+
+	status, err := r.Reconcile(context.Background(), managedCentral)
+	require.NoError(t, err)
+	condition, found := conditionForType(status.Conditions, conditionTypeScannerAvailable)
+	require.True(t, found)
+	assert.Equal(t, "True", condition.Status)
+
+	// the health is not checked again within the health check interval
+	_, err = r.Reconcile(context.Background(), managedCentral)
+	require.ErrorIs(t, err, ErrCentralNotChanged)
+
+	// the unchanged health is not reported again
+	r.lastHealthCheck = time.Time{}
+	_, err = r.Reconcile(context.Background(), managedCentral)
+	require.ErrorIs(t, err, ErrCentralNotChanged)
+
+	scanner := &appsv1.Deployment{}
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKey{Namespace: centralNamespace, Name: "scanner"}, scanner))
+	scanner.Status.AvailableReplicas = 0
+	require.NoError(t, fakeClient.Update(context.Background(), scanner))
+	r.lastHealthCheck = time.Time{}
+
+	status, err = r.Reconcile(context.Background(), managedCentral)
+	require.NoError(t, err)
+	readyCondition, found := conditionForType(status.Conditions, conditionTypeReady)
+	require.True(t, found)
+	assert.Equal(t, "True", readyCondition.Status)
+	condition, found = conditionForType(status.Conditions, conditionTypeScannerAvailable)
+	require.True(t, found)
+	assert.Equal(t, "False", condition.Status)
+}
@@ -97,6 +97,28 @@ func isCentralDeploymentReady(ctx context.Context, client ctrlClient.Client, cen
 	return false, nil
 }
 
+// isCentralDeploymentRolledOut returns true if all replicas of the Central deployment run its current pod template.
+func isCentralDeploymentRolledOut(ctx context.Context, client ctrlClient.Client, central private.ManagedCentral) (bool, error) {
+	deployment := &appsv1.Deployment{}
+	err := client.Get(ctx,
+		ctrlClient.ObjectKey{Name: "central", Namespace: central.Metadata.Namespace},
+		deployment)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "retrieving central deployment resource from Kubernetes")
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.Replicas == replicas &&
+		deployment.Status.UnavailableReplicas == 0, nil
+}
+
 func existsRHSSOAuthProvider(ctx context.Context, central private.ManagedCentral, client ctrlClient.Client) (bool, error) {
 	ready, err := isCentralDeploymentReady(ctx, client, central)
 	if !ready || err != nil {
@@ -5,7 +5,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
+	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/glog"
 	openshiftRouteV1 "github.com/openshift/api/route/v1"
@@ -13,6 +16,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/charts"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/operator"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/util"
@@ -23,10 +27,12 @@ import (
 	"github.com/stackrox/rox/pkg/random"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chartutil"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/pointer"
 	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -40,23 +46,43 @@ const (
 
 	managedServicesAnnotation = "platform.stackrox.io/managed-services"
 	pauseReconcileAnnotation  = "stackrox.io/pause-reconcile"
+	// pendingDeletionAnnotation marks a Central that was scaled down because its deletion was requested, so that its
+	// reconciliation is resumed when it is restored.
+	pendingDeletionAnnotation = "rhacs.redhat.com/pending-deletion"
 	envAnnotationKey          = "rhacs.redhat.com/environment"
 	clusterNameAnnotationKey  = "rhacs.redhat.com/cluster-name"
 	orgNameAnnotationKey      = "rhacs.redhat.com/org-name"
 	instanceTypeLabelKey      = "rhacs.redhat.com/instance-type"
 	orgIDLabelKey             = "rhacs.redhat.com/org-id"
 	tenantIDLabelKey          = "rhacs.redhat.com/tenant"
-	operatorVersionKey        = "stackrox.io/operator-version"
-	defaultOperatorVersion    = "rhacs-operator.v3.74.0"
+	// userLabelAnnotationPrefix prefixes the user-defined labels of a Central when they are propagated as
+	// annotations of the tenant namespace and the Central resource, e.g. for cost attribution.
+	userLabelAnnotationPrefix = "labels.rhacs.redhat.com/"
+	// defaultOperatorVersion is the ACS operator version of Centrals for which fleet-manager does not send a version.
+	defaultOperatorVersion = "3.74.0"
 
 	dbUserTypeAnnotation = "platform.stackrox.io/user-type"
 	dbUserTypeMaster     = "master"
 	dbUserTypeCentral    = "central"
+	dbUserTypeMigration  = "migration"
 	dbCentralUserName    = "rhacs_central"
+	// dbMigrationUserName is the DB user of a Central migrated to this cluster until the cutover.
+	dbMigrationUserName = "rhacs_central_migration"
 
 	centralDbSecretName = "central-db-password" // pragma: allowlist secret
 )
 
+// chartResourceGVKs are the kinds of the objects rendered by the resources chart. Objects of these kinds which are
+// managed by fleetshard, but no longer rendered by the chart, are deleted.
+var chartResourceGVKs = []schema.GroupVersionKind{
+	{Version: "v1", Kind: "ConfigMap"},
+	{Version: "v1", Kind: "Service"},
+	{Version: "v1", Kind: "ResourceQuota"},
+	{Version: "v1", Kind: "LimitRange"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "NetworkPolicy"},
+}
+
 // CentralReconcilerOptions are the static options for creating a reconciler.
 type CentralReconcilerOptions struct {
 	UseRoutes                         bool
@@ -67,6 +93,8 @@ type CentralReconcilerOptions struct {
 	ClusterName                       string
 	Environment                       string
 	FeatureFlagUpgradeOperatorEnabled bool
+	// FleetshardNamespace is the namespace of fleetshard-sync, which must be able to reach Central.
+	FleetshardNamespace string
 }
 
 // CentralReconciler is a reconciler tied to a one Central instance. It installs, updates and deletes Central instances
@@ -89,8 +117,20 @@ type CentralReconciler struct {
 	managedDBEnabled            bool
 	managedDBProvisioningClient cloudprovider.DBClient
 	managedDBInitFunc           postgres.CentralDBInitFunc
+	// managedDBMigrationUserInitFunc initializes the DB user of a Central migrated to this cluster until the cutover.
+	managedDBMigrationUserInitFunc postgres.CentralDBInitFunc
+	// managedDBDropMigrationUserFunc removes the DB user of a Central migrated to this cluster after the cutover.
+	managedDBDropMigrationUserFunc postgres.DropDBUserFunc
 
 	featureFlagUpgradeOperatorEnabled bool
+	fleetshardNamespace               string
+
+	// lastHealthCheck is the time of the last health check, lastHealthConditions are the health conditions
+	// last reported at lastHealthReport.
+	lastHealthCheck      time.Time
+	lastHealthReport     time.Time
+	lastHealthConditions []private.DataPlaneClusterUpdateStatusRequestConditions
+	dialDB               dialFunc
 
 	resourcesChart *chart.Chart
 }
@@ -112,7 +152,7 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 	}
 
 	if !changed && r.shouldSkipReadyCentral(remoteCentral) {
-		return nil, ErrCentralNotChanged
+		return r.reportHealthIfChanged(ctx, remoteCentral)
 	}
 
 	glog.Infof("Start reconcile central %s/%s", remoteCentral.Metadata.Namespace, remoteCentral.Metadata.Name)
@@ -208,10 +248,14 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		},
 	}
 
+	setUserLabelAnnotations(central.ObjectMeta.Annotations, remoteCentral.Metadata.Labels)
+
 	if r.featureFlagUpgradeOperatorEnabled {
-		labels := central.ObjectMeta.Labels
-		labels[operatorVersionKey] = defaultOperatorVersion
-		central.ObjectMeta.Labels = labels
+		operatorVersion := remoteCentral.Spec.Versions.OperatorVersion
+		if operatorVersion == "" {
+			operatorVersion = defaultOperatorVersion
+		}
+		central.ObjectMeta.Labels[operator.CentralOperatorVersionLabelKey] = operator.CentralOperatorVersionLabelValue(operatorVersion)
 	}
 
 	// Check whether auth provider is actually created and this reconciler just is not aware of that.
@@ -243,6 +287,19 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		return nil, ErrDeletionInProgress
 	}
 
+	if isRemoteCentralPendingDeletion(remoteCentral) {
+		if !changed {
+			return nil, ErrCentralNotChanged
+		}
+		if err := r.ensureCentralScaledDown(ctx, central); err != nil {
+			return nil, errors.Wrapf(err, "scale down central %s/%s", remoteCentralNamespace, remoteCentralName)
+		}
+		if err := r.setLastCentralHash(remoteCentral); err != nil {
+			return nil, errors.Wrapf(err, "setting central reconcilation cache")
+		}
+		return nil, nil
+	}
+
 	namespaceLabels := map[string]string{
 		orgIDLabelKey:    remoteCentral.Spec.Auth.OwnerOrgId,
 		tenantIDLabelKey: remoteCentral.Id,
@@ -250,6 +307,7 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 	namespaceAnnotations := map[string]string{
 		orgNameAnnotationKey: remoteCentral.Spec.Auth.OwnerOrgName,
 	}
+	setUserLabelAnnotations(namespaceAnnotations, remoteCentral.Metadata.Labels)
 	if err := r.ensureNamespaceExists(remoteCentralNamespace, namespaceLabels, namespaceAnnotations); err != nil {
 		return nil, errors.Wrapf(err, "unable to ensure that namespace %s exists", remoteCentralNamespace)
 	}
@@ -258,6 +316,17 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		return nil, errors.Wrapf(err, "unable to install chart resource for central %s/%s", central.GetNamespace(), central.GetName())
 	}
 
+	// A Central migrated to another cluster must no longer use the master credentials of its managed DB, which the
+	// migration target takes over. It is moved to the Central DB user below, and only reported as prepared in a later
+	// reconciliation, once it was rolled out with the Central DB user.
+	migrationSourcePrepared := false
+	if isRemoteCentralMigrationSourcePreparing(remoteCentral) {
+		migrationSourcePrepared, err = r.isMigrationSourcePrepared(ctx, remoteCentral)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if r.managedDBEnabled {
 		centralDBConnectionString, err := r.getCentralDBConnectionString(ctx, remoteCentral)
 		if err != nil {
@@ -285,6 +354,14 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 				},
 			}
 		}
+	} else {
+		// The resources of the Central DB are set explicitly, because they are part of the ResourceQuota of the
+		// tenant namespace.
+		central.Spec.Central.DB = &v1alpha1.CentralDBSpec{
+			DeploymentSpec: v1alpha1.DeploymentSpec{
+				Resources: centralDBResources.DeepCopy(),
+			},
+		}
 	}
 
 	centralExists := true
@@ -313,6 +390,23 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 	} else {
 		glog.Infof("Update central %s/%s", central.GetNamespace(), central.GetName())
 		existingCentral.Spec = central.Spec
+		if existingCentral.Annotations == nil {
+			existingCentral.Annotations = map[string]string{}
+		}
+		setUserLabelAnnotations(existingCentral.Annotations, remoteCentral.Metadata.Labels)
+		if r.featureFlagUpgradeOperatorEnabled {
+			if existingCentral.Labels == nil {
+				existingCentral.Labels = map[string]string{}
+			}
+			// changing the label hands the Central over to the ACS operator of the desired version
+			existingCentral.Labels[operator.CentralOperatorVersionLabelKey] = central.Labels[operator.CentralOperatorVersionLabelKey]
+		}
+		if existingCentral.Annotations[pendingDeletionAnnotation] == "true" {
+			// the Central was restored, the operator scales it up again
+			glog.Infof("Resuming reconciliation of restored central %s/%s", central.GetNamespace(), central.GetName())
+			delete(existingCentral.Annotations, pendingDeletionAnnotation)
+			existingCentral.Annotations[pauseReconcileAnnotation] = "false"
+		}
 
 		if err := util.IncrementCentralRevision(&existingCentral); err != nil {
 			return nil, errors.Wrap(err, "incrementing central's revision")
@@ -359,11 +453,19 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		r.hasAuthProvider = true
 	}
 
-	status := readyStatus()
+	r.lastHealthCheck = time.Now()
+	status := r.readyStatusWithHealth(r.checkHealth(ctx, remoteCentral))
+	if migrationSourcePrepared {
+		status.Conditions = append(status.Conditions, private.DataPlaneClusterUpdateStatusRequestConditions{
+			Type:   centralConstants.CentralMigrationSourcePreparedCondition,
+			Status: "True",
+		})
+	}
 	// Do not report routes statuses if:
 	// 1. Routes are not used on the cluster
-	// 2. Central request is in status "Ready" - assuming that routes are already reported and saved
-	if r.useRoutes && !isRemoteCentralReady(remoteCentral) {
+	// 2. Central request is in status "Ready" - assuming that routes are already reported and saved,
+	//    unless the Central is migrated to this cluster and fleet-manager waits for the routes of this cluster
+	if r.useRoutes && (!isRemoteCentralReady(remoteCentral) || isRemoteCentralMigrationTarget(remoteCentral)) {
 		status.Routes, err = r.getRoutesStatuses(ctx, remoteCentralNamespace)
 		if err != nil {
 			return nil, err
@@ -387,6 +489,41 @@ func isRemoteCentralReady(remoteCentral private.ManagedCentral) bool {
 	return remoteCentral.RequestStatus == centralConstants.CentralRequestStatusReady.String()
 }
 
+func isRemoteCentralPendingDeletion(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.RequestStatus == centralConstants.CentralRequestStatusPendingDeletion.String()
+}
+
+func isRemoteCentralMigrationSource(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.Spec.Migration.Role == centralConstants.CentralMigrationRoleSource
+}
+
+func isRemoteCentralMigrationTarget(remoteCentral private.ManagedCentral) bool {
+	return remoteCentral.Spec.Migration.Role == centralConstants.CentralMigrationRoleTarget
+}
+
+// isRemoteCentralMigrationSourcePreparing returns true if the Central is migrated away from this cluster, and must be
+// moved off the master credentials of its managed DB before the migration target takes them over.
+func isRemoteCentralMigrationSourcePreparing(remoteCentral private.ManagedCentral) bool {
+	return isRemoteCentralMigrationSource(remoteCentral) &&
+		remoteCentral.Spec.Migration.Status == centralConstants.CentralMigrationStatusSourcePreparing.String()
+}
+
+// isMigrationSourcePrepared returns true if the Central runs with the Central DB user, so that the master password of
+// its managed DB can be reset by the migration target.
+func (r *CentralReconciler) isMigrationSourcePrepared(ctx context.Context, remoteCentral private.ManagedCentral) (bool, error) {
+	if !r.managedDBEnabled {
+		return true, nil
+	}
+	dbUserType, err := r.centralDBUserType(ctx, remoteCentral.Metadata.Namespace)
+	if err != nil {
+		return false, err
+	}
+	if dbUserType != dbUserTypeCentral {
+		return false, nil
+	}
+	return isCentralDeploymentRolledOut(ctx, r.client, remoteCentral)
+}
+
 func (r *CentralReconciler) getRoutesStatuses(ctx context.Context, namespace string) ([]private.DataPlaneCentralStatusRoutes, error) {
 	reencryptIngress, err := r.routeService.FindReencryptIngress(ctx, namespace)
 	if err != nil {
@@ -431,9 +568,14 @@ func (r *CentralReconciler) ensureCentralDeleted(ctx context.Context, remoteCent
 	globalDeleted = globalDeleted && centralDeleted
 
 	if r.managedDBEnabled {
-		err = r.managedDBProvisioningClient.EnsureDBDeprovisioned(remoteCentral.Id)
-		if err != nil {
-			return false, fmt.Errorf("deprovisioning DB: %v", err)
+		// The managed DB of a Central migrated to another cluster is still in use by the migration target.
+		if isRemoteCentralMigrationSource(remoteCentral) {
+			glog.Infof("Keeping DB of central %s migrated to another cluster", remoteCentral.Id)
+		} else {
+			err = r.managedDBProvisioningClient.EnsureDBDeprovisioned(remoteCentral.Id)
+			if err != nil {
+				return false, fmt.Errorf("deprovisioning DB: %v", err)
+			}
 		}
 
 		secretDeleted, err := r.ensureCentralDBSecretDeleted(ctx, central.GetNamespace())
@@ -509,9 +651,48 @@ func (r *CentralReconciler) ensureNamespaceExists(name string, labels map[string
 		}
 		return fmt.Errorf("getting namespace %s: %w", name, err)
 	}
+
+	// The user-defined labels of the Central can change after the namespace was created.
+	if namespace.Annotations == nil {
+		namespace.Annotations = map[string]string{}
+	}
+	userLabels := map[string]string{}
+	for key, value := range annotations {
+		if strings.HasPrefix(key, userLabelAnnotationPrefix) {
+			userLabels[strings.TrimPrefix(key, userLabelAnnotationPrefix)] = value
+		}
+	}
+	if !setUserLabelAnnotations(namespace.Annotations, userLabels) {
+		return nil
+	}
+	if err := r.client.Update(context.Background(), namespace); err != nil {
+		return fmt.Errorf("updating annotations of namespace %q: %w", name, err)
+	}
 	return nil
 }
 
+// setUserLabelAnnotations replaces the annotations of the user-defined labels of a Central in annotations with the
+// given labels. It returns whether the annotations were changed.
+func setUserLabelAnnotations(annotations map[string]string, labels map[string]string) bool {
+	changed := false
+	for key := range annotations {
+		if !strings.HasPrefix(key, userLabelAnnotationPrefix) {
+			continue
+		}
+		if _, ok := labels[strings.TrimPrefix(key, userLabelAnnotationPrefix)]; !ok {
+			delete(annotations, key)
+			changed = true
+		}
+	}
+	for key, value := range labels {
+		if current, ok := annotations[userLabelAnnotationPrefix+key]; !ok || current != value {
+			annotations[userLabelAnnotationPrefix+key] = value
+			changed = true
+		}
+	}
+	return changed
+}
+
 func (r *CentralReconciler) ensureNamespaceDeleted(ctx context.Context, name string) (bool, error) {
 	namespace, err := r.getNamespace(name)
 	if err != nil {
@@ -531,25 +712,44 @@ func (r *CentralReconciler) ensureNamespaceDeleted(ctx context.Context, name str
 }
 
 func (r *CentralReconciler) getCentralDBConnectionString(ctx context.Context, remoteCentral private.ManagedCentral) (string, error) {
-	centralDBUserExists, err := r.centralDBUserExists(ctx, remoteCentral.Metadata.Namespace)
+	dbUserType, err := r.centralDBUserType(ctx, remoteCentral.Metadata.Namespace)
 	if err != nil {
 		return "", err
 	}
 
-	// If a Central DB user already exists, it means the managed DB was already
-	// provisioned and successfully created (access to a running Postgres instance is a
-	// precondition to create this user)
-	if !centralDBUserExists {
-		if err := r.ensureManagedCentralDBInitialized(ctx, remoteCentral); err != nil {
+	// If a DB user already exists, it means the managed DB was already provisioned and successfully
+	// initialized (access to a running Postgres instance is a precondition to create this user). The
+	// migration user of a Central migrated to this cluster is replaced by the Central DB user after cutover.
+	wantDBUserType := dbUserTypeCentral
+	if isRemoteCentralMigrationTargetBeforeCutover(remoteCentral) {
+		wantDBUserType = dbUserTypeMigration
+	}
+	if dbUserType != dbUserTypeCentral && dbUserType != wantDBUserType {
+		if err := r.ensureManagedCentralDBInitialized(ctx, remoteCentral, wantDBUserType); err != nil {
 			return "", fmt.Errorf("initializing managed DB: %w", err)
 		}
+		dbUserType = wantDBUserType
 	}
 
 	dbConnection, err := r.managedDBProvisioningClient.GetDBConnection(remoteCentral.Id)
 	if err != nil {
 		return "", fmt.Errorf("getting RDS DB connection data: %w", err)
 	}
-	return dbConnection.GetConnectionForUser(dbCentralUserName).WithSSLRootCert(postgres.DatabaseCACertificatePathCentral).AsConnectionString(), nil
+	return dbConnection.GetConnectionForUser(dbUserName(dbUserType)).WithSSLRootCert(postgres.DatabaseCACertificatePathCentral).AsConnectionString(), nil
+}
+
+// isRemoteCentralMigrationTargetBeforeCutover returns true if the Central is migrated to this cluster, but still
+// served from the migration source cluster.
+func isRemoteCentralMigrationTargetBeforeCutover(remoteCentral private.ManagedCentral) bool {
+	return isRemoteCentralMigrationTarget(remoteCentral) &&
+		remoteCentral.Spec.Migration.Status != centralConstants.CentralMigrationStatusSourceTeardown.String()
+}
+
+func dbUserName(dbUserType string) string {
+	if dbUserType == dbUserTypeMigration {
+		return dbMigrationUserName
+	}
+	return dbCentralUserName
 }
 
 func generateDBPassword() (string, error) {
@@ -561,19 +761,34 @@ func generateDBPassword() (string, error) {
 	return password, nil
 }
 
-func (r *CentralReconciler) ensureManagedCentralDBInitialized(ctx context.Context, remoteCentral private.ManagedCentral) error {
+// ensureManagedCentralDBInitialized provisions the managed DB of the Central and initializes the DB user of the given
+// type. The password of the DB user replaces the master password in the Central DB secret.
+func (r *CentralReconciler) ensureManagedCentralDBInitialized(ctx context.Context, remoteCentral private.ManagedCentral, dbUserType string) error {
 	remoteCentralNamespace := remoteCentral.Metadata.Namespace
 
-	centralDBSecretExists, err := r.centralDBSecretExists(ctx, remoteCentralNamespace)
+	currentDBUserType, err := r.centralDBUserType(ctx, remoteCentralNamespace)
 	if err != nil {
 		return err
 	}
 
-	if !centralDBSecretExists {
+	// The master password is unknown if the secret does not exist yet or if it already holds the password of a
+	// DB user, e.g. the migration user of a Central migrated to this cluster.
+	if currentDBUserType != dbUserTypeMaster {
 		dbMasterPassword, err := generateDBPassword()
 		if err != nil {
 			return fmt.Errorf("generating Central DB master password: %w", err)
 		}
+		// The DB of a Central migrated to this cluster already exists, but its credentials are only known to the
+		// source cluster. The migration source moved its Central off the master credentials before the migration
+		// target was provisioned, so they can be taken over. The same applies after the cutover, when the master
+		// password was dropped after the migration user was created. The password is reset only once, before it
+		// is stored in the secret.
+		if isRemoteCentralMigrationTarget(remoteCentral) || currentDBUserType == dbUserTypeMigration {
+			err = r.managedDBProvisioningClient.ResetDBMasterPassword(remoteCentral.Id, dbMasterPassword)
+			if err != nil {
+				return fmt.Errorf("resetting RDS DB master password: %w", err)
+			}
+		}
 		if err := r.ensureCentralDBSecretExists(ctx, remoteCentralNamespace, dbUserTypeMaster, dbMasterPassword); err != nil {
 			return fmt.Errorf("ensuring that DB secret exists: %w", err)
 		}
@@ -584,30 +799,47 @@ func (r *CentralReconciler) ensureManagedCentralDBInitialized(ctx context.Contex
 		return fmt.Errorf("getting DB password from secret: %w", err)
 	}
 
-	err = r.managedDBProvisioningClient.EnsureDBProvisioned(ctx, remoteCentral.Id, dbMasterPassword)
+	dbReady, err := r.managedDBProvisioningClient.EnsureDBProvisioned(ctx, remoteCentral.Id, dbMasterPassword)
 	if err != nil {
 		return fmt.Errorf("provisioning RDS DB: %w", err)
 	}
+	if !dbReady {
+		return ErrManagedDBNotReady
+	}
 
 	dbConnection, err := r.managedDBProvisioningClient.GetDBConnection(remoteCentral.Id)
 	if err != nil {
 		return fmt.Errorf("getting RDS DB connection data: %w", err)
 	}
 
-	dbCentralPassword, err := generateDBPassword()
+	dbUserPassword, err := generateDBPassword()
 	if err != nil {
 		return fmt.Errorf("generating Central DB password: %w", err)
 	}
-	err = r.managedDBInitFunc(ctx, dbConnection.WithPassword(dbMasterPassword).WithSSLRootCert(postgres.DatabaseCACertificatePathFleetshard),
-		dbCentralUserName, dbCentralPassword)
+	// Until the cutover, the Central on the migration source cluster still uses the Central DB user. Its password
+	// must therefore only be changed after the cutover, until then the migration target uses a separate user.
+	initFunc := r.managedDBInitFunc
+	if dbUserType == dbUserTypeMigration {
+		initFunc = r.managedDBMigrationUserInitFunc
+	}
+	masterDBConnection := dbConnection.WithPassword(dbMasterPassword).WithSSLRootCert(postgres.DatabaseCACertificatePathFleetshard)
+	err = initFunc(ctx, masterDBConnection, dbUserName(dbUserType), dbUserPassword)
 	if err != nil {
 		return fmt.Errorf("initializing managed DB: %w", err)
 	}
 
+	// After the cutover, the migration user is not needed anymore. It is dropped before the secret is replaced, so
+	// that a failure is retried with the master password on the next reconciliation.
+	if dbUserType == dbUserTypeCentral && (isRemoteCentralMigrationTarget(remoteCentral) || currentDBUserType == dbUserTypeMigration) {
+		if err := r.managedDBDropMigrationUserFunc(ctx, masterDBConnection, dbMigrationUserName); err != nil {
+			return fmt.Errorf("dropping DB migration user: %w", err)
+		}
+	}
+
 	// Replace the password stored in the secret. This replaces the master password (the password of the
-	// rds_superuser account) with the password of the Central user. Note that we don't store
+	// rds_superuser account) with the password of the DB user. Note that we don't store
 	// the master password anywhere from this point on.
-	err = r.ensureCentralDBSecretExists(ctx, remoteCentralNamespace, dbUserTypeCentral, dbCentralPassword)
+	err = r.ensureCentralDBSecretExists(ctx, remoteCentralNamespace, dbUserType, dbUserPassword)
 	if err != nil {
 		return err
 	}
@@ -660,43 +892,26 @@ func (r *CentralReconciler) ensureCentralDBSecretExists(ctx context.Context, rem
 	return nil
 }
 
-func (r *CentralReconciler) centralDBSecretExists(ctx context.Context, remoteCentralNamespace string) (bool, error) {
-	secret := &corev1.Secret{}
-	err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: remoteCentralNamespace, Name: centralDbSecretName}, secret)
-	if err != nil {
-		if apiErrors.IsNotFound(err) {
-			return false, nil
-		}
-
-		return false, fmt.Errorf("getting central DB secret: %w", err)
-	}
-
-	return true, nil
-}
-
-func (r *CentralReconciler) centralDBUserExists(ctx context.Context, remoteCentralNamespace string) (bool, error) {
+// centralDBUserType returns the type of the DB user whose password is stored in the Central DB secret, or an empty
+// string if the secret does not exist.
+func (r *CentralReconciler) centralDBUserType(ctx context.Context, remoteCentralNamespace string) (string, error) {
 	secret := &corev1.Secret{}
 	err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: remoteCentralNamespace, Name: centralDbSecretName}, secret)
 	if err != nil {
 		if apiErrors.IsNotFound(err) {
-			return false, nil
+			return "", nil
 		}
 
-		return false, fmt.Errorf("getting central DB secret: %w", err)
-	}
-
-	if secret.Annotations == nil {
-		// if the annotation section is missing, assume it's the master password
-		return false, nil
+		return "", fmt.Errorf("getting central DB secret: %w", err)
 	}
 
 	dbUserType, exists := secret.Annotations[dbUserTypeAnnotation]
 	if !exists {
 		// legacy Centrals use the master password and do not have this annotation
-		return false, nil
+		return dbUserTypeMaster, nil
 	}
 
-	return dbUserType == dbUserTypeCentral, nil
+	return dbUserType, nil
 }
 
 func (r *CentralReconciler) ensureCentralDBSecretDeleted(ctx context.Context, remoteCentralNamespace string) (bool, error) {
@@ -759,6 +974,53 @@ func (r *CentralReconciler) ensureCentralCRDeleted(ctx context.Context, central
 	return false, nil
 }
 
+// scaledDownDeployments are the deployments of a Central that are scaled down while it is pending deletion. The
+// horizontal pod autoscaler of Scanner does not scale up a deployment with 0 replicas.
+var scaledDownDeployments = []string{"central", "scanner", "scanner-db"}
+
+// ensureCentralScaledDown pauses the reconciliation of the Central by the operator and scales its deployments down,
+// keeping the namespace, the Central resource and the database of the Central so that it can be restored.
+func (r *CentralReconciler) ensureCentralScaledDown(ctx context.Context, central *v1alpha1.Central) error {
+	existingCentral := &v1alpha1.Central{}
+	err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: central.GetNamespace(), Name: central.GetName()}, existingCentral)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "getting central %s/%s", central.GetNamespace(), central.GetName())
+	}
+
+	if existingCentral.Annotations[pendingDeletionAnnotation] != "true" || existingCentral.Annotations[pauseReconcileAnnotation] != "true" {
+		if existingCentral.Annotations == nil {
+			existingCentral.Annotations = map[string]string{}
+		}
+		existingCentral.Annotations[pendingDeletionAnnotation] = "true"
+		existingCentral.Annotations[pauseReconcileAnnotation] = "true"
+		if err := r.client.Update(ctx, existingCentral); err != nil {
+			return errors.Wrapf(err, "pausing reconciliation of central %s/%s", central.GetNamespace(), central.GetName())
+		}
+	}
+
+	for _, name := range scaledDownDeployments {
+		deployment := &appsv1.Deployment{}
+		if err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: central.GetNamespace(), Name: name}, deployment); err != nil {
+			if apiErrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "getting deployment %s/%s", central.GetNamespace(), name)
+		}
+		if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+			continue
+		}
+		deployment.Spec.Replicas = pointer.Int32(0)
+		if err := r.client.Update(ctx, deployment); err != nil {
+			return errors.Wrapf(err, "scaling down deployment %s/%s", central.GetNamespace(), name)
+		}
+	}
+	glog.Infof("Central %s/%s is scaled down pending deletion", central.GetNamespace(), central.GetName())
+	return nil
+}
+
 func (r *CentralReconciler) disablePauseReconcileIfPresent(ctx context.Context, central *v1alpha1.Central) error {
 	if central.Annotations == nil {
 		return nil
@@ -787,10 +1049,12 @@ func (r *CentralReconciler) ensureChartResourcesExist(ctx context.Context, remot
 	if err != nil {
 		return fmt.Errorf("rendering resources chart: %w", err)
 	}
+	rendered := make(map[string]bool, len(objs))
 	for _, obj := range objs {
 		if obj.GetNamespace() == "" {
 			obj.SetNamespace(remoteCentral.Metadata.Namespace)
 		}
+		rendered[chartObjectID(obj)] = true
 		key := ctrlClient.ObjectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}
 		var out unstructured.Unstructured
 		out.SetGroupVersionKind(obj.GroupVersionKind())
@@ -815,9 +1079,39 @@ func (r *CentralReconciler) ensureChartResourcesExist(ctx context.Context, remot
 		}
 	}
 
+	return r.ensureStaleChartResourcesDeleted(ctx, remoteCentral.Metadata.Namespace, rendered)
+}
+
+// ensureStaleChartResourcesDeleted deletes the objects of the resources chart which are no longer rendered, e.g. the
+// ResourceQuota once fleet-manager no longer requests resources for the Central.
+func (r *CentralReconciler) ensureStaleChartResourcesDeleted(ctx context.Context, namespace string, rendered map[string]bool) error {
+	for _, gvk := range chartResourceGVKs {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		err := r.client.List(ctx, list, ctrlClient.InNamespace(namespace),
+			ctrlClient.MatchingLabels{k8s.ManagedByLabelKey: k8s.ManagedByFleetshardValue})
+		if err != nil {
+			return fmt.Errorf("listing objects of type %v: %w", gvk, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			obj.SetGroupVersionKind(gvk)
+			if rendered[chartObjectID(obj)] || obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			glog.V(10).Infof("Deleting object %s/%s", obj.GetNamespace(), obj.GetName())
+			if err := r.client.Delete(ctx, obj); err != nil && !apiErrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete object %s/%s of type %v: %w", obj.GetNamespace(), obj.GetName(), gvk, err)
+			}
+		}
+	}
 	return nil
 }
 
+func chartObjectID(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName())
+}
+
 func (r *CentralReconciler) ensureChartResourcesDeleted(ctx context.Context, remoteCentral private.ManagedCentral) (bool, error) {
 	vals, err := r.chartValues(remoteCentral)
 	if err != nil {
@@ -946,13 +1240,27 @@ func (r *CentralReconciler) chartValues(remoteCentral private.ManagedCentral) (c
 		vals = chartutil.CoalesceTables(vals, override)
 	}
 
+	networkPolicyVals := map[string]interface{}{
+		"managedDB": r.managedDBEnabled,
+	}
+	if r.fleetshardNamespace != "" {
+		networkPolicyVals["fleetshardNamespace"] = r.fleetshardNamespace
+	}
+	vals["networkPolicies"] = networkPolicyVals
+	tenantResourceVals, err := r.tenantResourceValues(remoteCentral)
+	if err != nil {
+		return nil, err
+	}
+	vals = chartutil.CoalesceTables(vals, tenantResourceVals)
+
 	return vals, nil
 }
 
 func (r *CentralReconciler) shouldSkipReadyCentral(remoteCentral private.ManagedCentral) bool {
 	return r.wantsAuthProvider == r.hasAuthProvider &&
 		isRemoteCentralReady(remoteCentral) &&
-		remoteCentral.Spec.Versions.ActualVersion == remoteCentral.Spec.Versions.DesiredVersion
+		remoteCentral.Spec.Versions.ActualVersion == remoteCentral.Spec.Versions.DesiredVersion &&
+		!isRemoteCentralMigrationSourcePreparing(remoteCentral)
 }
 
 var resourcesChart = charts.MustGetChart("tenant-resources")
@@ -975,10 +1283,14 @@ func NewCentralReconciler(k8sClient ctrlClient.Client, central private.ManagedCe
 		environment:       opts.Environment,
 
 		featureFlagUpgradeOperatorEnabled: opts.FeatureFlagUpgradeOperatorEnabled,
-
-		managedDBEnabled:            opts.ManagedDBEnabled,
-		managedDBProvisioningClient: managedDBProvisioningClient,
-		managedDBInitFunc:           managedDBInitFunc,
+		fleetshardNamespace:               opts.FleetshardNamespace,
+
+		managedDBEnabled:               opts.ManagedDBEnabled,
+		managedDBProvisioningClient:    managedDBProvisioningClient,
+		managedDBInitFunc:              managedDBInitFunc,
+		managedDBMigrationUserInitFunc: postgres.MigrationUserInitFunc(dbCentralUserName),
+		managedDBDropMigrationUserFunc: postgres.DropMigrationUserFunc(dbCentralUserName),
+		dialDB:                         (&net.Dialer{}).DialContext,
 
 		resourcesChart: resourcesChart,
 	}
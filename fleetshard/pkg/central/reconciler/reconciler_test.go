@@ -17,6 +17,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/charts"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/awsclient"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/operator"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
@@ -128,8 +129,8 @@ func TestReconcileCreateWithManagedDB(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t).Build()
 
 	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
-	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) error {
-		return nil
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (bool, error) {
+		return true, nil
 	}
 	managedDBProvisioningClient.GetDBConnectionFunc = func(_ string) (postgres.DBConnection, error) {
 		connection, err := postgres.NewDBConnection("localhost", 5432, "rhacs", "postgres")
@@ -187,7 +188,15 @@ func TestReconcileCreateWithLabelOperatorVersion(t *testing.T) {
 	central := &v1alpha1.Central{}
 	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
 	require.NoError(t, err)
-	assert.Equal(t, defaultOperatorVersion, central.ObjectMeta.Labels[operatorVersionKey])
+	assert.Equal(t, "rhacs-operator.v"+defaultOperatorVersion, central.ObjectMeta.Labels[operator.CentralOperatorVersionLabelKey])
+
+	managedCentral := simpleManagedCentral
+	managedCentral.Spec.Versions.OperatorVersion = "4.0.0"
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	require.NoError(t, err)
+	assert.Equal(t, "rhacs-operator.v4.0.0", central.ObjectMeta.Labels[operator.CentralOperatorVersionLabelKey])
 }
 
 func TestReconcileCreateWithManagedDBNoCredentials(t *testing.T) {
@@ -382,8 +391,8 @@ func TestReconcileDeleteWithManagedDB(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t).Build()
 
 	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
-	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) error {
-		return nil
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (bool, error) {
+		return true, nil
 	}
 	managedDBProvisioningClient.EnsureDBDeprovisionedFunc = func(_ string) error {
 		return nil
@@ -410,8 +419,8 @@ func TestReconcileDeleteWithManagedDB(t *testing.T) {
 	deletedCentral.Metadata.DeletionTimestamp = "2006-01-02T15:04:05Z07:00"
 
 	// trigger deletion
-	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) error {
-		return nil
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (bool, error) {
+		return true, nil
 	}
 	statusTrigger, err := r.Reconcile(context.TODO(), deletedCentral)
 	require.Error(t, err, ErrDeletionInProgress)
@@ -443,6 +452,248 @@ func TestReconcileDeleteWithManagedDB(t *testing.T) {
 	assert.True(t, k8sErrors.IsNotFound(err))
 }
 
+func TestReconcileCreateWithManagedDBNotReady(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+
+	dbReady := false
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (bool, error) {
+		return dbReady, nil
+	}
+	managedDBProvisioningClient.GetDBConnectionFunc = func(_ string) (postgres.DBConnection, error) {
+		return postgres.NewDBConnection("localhost", 5432, "rhacs", "postgres")
+	}
+
+	initCalls := 0
+	initFunc := func(_ context.Context, _ postgres.DBConnection, _, _ string) error {
+		initCalls++
+		return nil
+	}
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient, initFunc,
+		CentralReconcilerOptions{
+			UseRoutes:        true,
+			ManagedDBEnabled: true,
+		})
+
+	_, err := r.Reconcile(context.TODO(), simpleManagedCentral)
+	require.ErrorIs(t, err, ErrManagedDBNotReady)
+	assert.True(t, IsSkippable(err))
+	assert.Zero(t, initCalls)
+
+	central := &v1alpha1.Central{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	assert.True(t, k8sErrors.IsNotFound(err))
+
+	dbReady = true
+	_, err = r.Reconcile(context.TODO(), simpleManagedCentral)
+	require.NoError(t, err)
+	assert.Equal(t, 1, initCalls)
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+}
+
+func TestReconcileCreateWithManagedDBAsMigrationTarget(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (bool, error) {
+		return true, nil
+	}
+	managedDBProvisioningClient.ResetDBMasterPasswordFunc = func(_ string, _ string) error {
+		return nil
+	}
+	managedDBProvisioningClient.GetDBConnectionFunc = func(_ string) (postgres.DBConnection, error) {
+		connection, err := postgres.NewDBConnection("localhost", 5432, "rhacs", "postgres")
+		if err != nil {
+			return postgres.DBConnection{}, err
+		}
+		return connection, nil
+	}
+
+	var initializedUsers []string
+	initFunc := func(_ context.Context, _ postgres.DBConnection, userName, _ string) error {
+		initializedUsers = append(initializedUsers, userName)
+		return nil
+	}
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient, initFunc,
+		CentralReconcilerOptions{
+			UseRoutes:        true,
+			ManagedDBEnabled: true,
+		})
+	r.managedDBMigrationUserInitFunc = initFunc
+	var droppedUsers []string
+	r.managedDBDropMigrationUserFunc = func(_ context.Context, _ postgres.DBConnection, userName string) error {
+		droppedUsers = append(droppedUsers, userName)
+		return nil
+	}
+
+	migratedCentral := simpleManagedCentral
+	migratedCentral.Spec.Migration = private.ManagedCentralAllOfSpecMigration{
+		Role:   centralConstants.CentralMigrationRoleTarget,
+		Status: centralConstants.CentralMigrationStatusTargetProvisioning.String(),
+	}
+
+	status, err := r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	require.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 1)
+	assert.Equal(t, centralID, managedDBProvisioningClient.ResetDBMasterPasswordCalls()[0].DatabaseID)
+
+	assert.NotEmpty(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls()[0].MasterPassword)
+
+	// routes are reported even when the central is ready to switch the CNAME records to this cluster
+	assert.NotEmpty(t, status.Routes)
+
+	// the password of the Central DB user is still used by the migration source and must not be changed
+	assert.Equal(t, []string{dbMigrationUserName}, initializedUsers)
+	assertCentralDBUser(t, fakeClient, dbUserTypeMigration, dbMigrationUserName)
+
+	// the credentials are not changed again before the cutover
+	migratedCentral.Spec.Migration.Status = centralConstants.CentralMigrationStatusSwitchingRoutes.String()
+	_, err = r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	assert.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 1)
+	assert.Equal(t, []string{dbMigrationUserName}, initializedUsers)
+	assert.Empty(t, droppedUsers)
+
+	// the Central DB user takes over once the Central is served from this cluster
+	migratedCentral.Spec.Migration.Status = centralConstants.CentralMigrationStatusSourceTeardown.String()
+	_, err = r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	assert.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 2)
+	assert.Equal(t, []string{dbMigrationUserName, dbCentralUserName}, initializedUsers)
+	assertCentralDBUser(t, fakeClient, dbUserTypeCentral, dbCentralUserName)
+	assert.Equal(t, []string{dbMigrationUserName}, droppedUsers)
+
+	// the migration user is dropped only once
+	_, err = r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	assert.Len(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls(), 2)
+	assert.Equal(t, []string{dbMigrationUserName}, droppedUsers)
+}
+
+func TestReconcileLegacyManagedDBAsMigrationSource(t *testing.T) {
+	// the DB secret of a Central created before DB users were introduced holds the master password
+	legacySecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      centralDbSecretName,
+			Namespace: centralNamespace,
+		},
+		Data: map[string][]byte{"password": []byte("master-password")},
+	}
+	existingCentral := &v1alpha1.Central{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      centralName,
+			Namespace: centralNamespace,
+		},
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t, existingCentral, legacySecret, centralDeploymentObject()).Build()
+
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (bool, error) {
+		return true, nil
+	}
+	managedDBProvisioningClient.GetDBConnectionFunc = func(_ string) (postgres.DBConnection, error) {
+		return postgres.NewDBConnection("localhost", 5432, "rhacs", "postgres")
+	}
+
+	var initializedUsers []string
+	initFunc := func(_ context.Context, _ postgres.DBConnection, userName, _ string) error {
+		initializedUsers = append(initializedUsers, userName)
+		return nil
+	}
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient, initFunc,
+		CentralReconcilerOptions{
+			ManagedDBEnabled: true,
+		})
+
+	migratedCentral := simpleManagedCentral
+	migratedCentral.Spec.Migration = private.ManagedCentralAllOfSpecMigration{
+		Role:   centralConstants.CentralMigrationRoleSource,
+		Status: centralConstants.CentralMigrationStatusSourcePreparing.String(),
+	}
+
+	// the Central is moved off the master user before the migration target resets the master password
+	status, err := r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	assert.Empty(t, managedDBProvisioningClient.ResetDBMasterPasswordCalls())
+	assert.Equal(t, []string{dbCentralUserName}, initializedUsers)
+	assertCentralDBUser(t, fakeClient, dbUserTypeCentral, dbCentralUserName)
+
+	// the source is only prepared once the Central was restarted with the new credentials
+	_, ok := conditionForType(status.Conditions, centralConstants.CentralMigrationSourcePreparedCondition)
+	assert.False(t, ok)
+
+	deployment := centralDeploymentObject()
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(deployment), deployment))
+	deployment.Status.Replicas = 1
+	deployment.Status.UpdatedReplicas = 1
+	require.NoError(t, fakeClient.Status().Update(context.TODO(), deployment))
+
+	status, err = r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	preparedCondition, ok := conditionForType(status.Conditions, centralConstants.CentralMigrationSourcePreparedCondition)
+	require.True(t, ok)
+	assert.Equal(t, "True", preparedCondition.Status)
+	assert.Equal(t, []string{dbCentralUserName}, initializedUsers)
+}
+
+func assertCentralDBUser(t *testing.T, fakeClient client.Client, dbUserType, dbUserName string) {
+	secret := &v1.Secret{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralDbSecretName, Namespace: centralNamespace}, secret))
+	assert.Equal(t, dbUserType, secret.Annotations[dbUserTypeAnnotation])
+
+	central := &v1alpha1.Central{}
+	require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central))
+	assert.Contains(t, *central.Spec.Central.DB.ConnectionStringOverride, "user="+dbUserName+" ")
+}
+
+func TestReconcileDeleteWithManagedDBAsMigrationSource(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.EnsureDBProvisionedFunc = func(_ context.Context, _ string, _ string) (bool, error) {
+		return true, nil
+	}
+	managedDBProvisioningClient.EnsureDBDeprovisionedFunc = func(_ string) error {
+		return nil
+	}
+	managedDBProvisioningClient.GetDBConnectionFunc = func(_ string) (postgres.DBConnection, error) {
+		connection, err := postgres.NewDBConnection("localhost", 5432, "rhacs", "postgres")
+		if err != nil {
+			return postgres.DBConnection{}, err
+		}
+		return connection, nil
+	}
+
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, managedDBProvisioningClient, centralDBInitFunc,
+		CentralReconcilerOptions{
+			UseRoutes:        true,
+			ManagedDBEnabled: true,
+		})
+
+	_, err := r.Reconcile(context.TODO(), simpleManagedCentral)
+	require.NoError(t, err)
+
+	migratedCentral := simpleManagedCentral
+	migratedCentral.Metadata.DeletionTimestamp = "2006-01-02T15:04:05Z07:00"
+	migratedCentral.Spec.Migration = private.ManagedCentralAllOfSpecMigration{
+		Role:   centralConstants.CentralMigrationRoleSource,
+		Status: centralConstants.CentralMigrationStatusSourceTeardown.String(),
+	}
+
+	_, err = r.Reconcile(context.TODO(), migratedCentral)
+	require.Error(t, err, ErrDeletionInProgress)
+	statusDeletion, err := r.Reconcile(context.TODO(), migratedCentral)
+	require.NoError(t, err)
+	require.NotNil(t, statusDeletion)
+
+	// the DB is still in use by the central on the migration target cluster
+	assert.Empty(t, managedDBProvisioningClient.EnsureDBDeprovisionedCalls())
+
+	secret := &v1.Secret{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralDbSecretName, Namespace: centralNamespace}, secret)
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
+
 func TestCentralChanged(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -509,6 +760,81 @@ func TestNamespaceLabelsAreSet(t *testing.T) {
 	assert.Equal(t, simpleManagedCentral.Spec.Auth.OwnerOrgId, namespace.GetLabels()[orgIDLabelKey])
 }
 
+func TestUserLabelAnnotationsAreSynced(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, CentralReconcilerOptions{})
+
+	managedCentral := simpleManagedCentral
+	managedCentral.Metadata.Labels = map[string]string{"env": "prod", "team": "secops"}
+	_, err := r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+
+	namespace := &v1.Namespace{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralNamespace}, namespace)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", namespace.GetAnnotations()[userLabelAnnotationPrefix+"env"])
+	assert.Equal(t, "secops", namespace.GetAnnotations()[userLabelAnnotationPrefix+"team"])
+	central := &v1alpha1.Central{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", central.GetAnnotations()[userLabelAnnotationPrefix+"env"])
+	assert.Equal(t, "secops", central.GetAnnotations()[userLabelAnnotationPrefix+"team"])
+
+	managedCentral.Metadata.Labels = map[string]string{"env": "stage"}
+	_, err = r.Reconcile(context.TODO(), managedCentral)
+	require.NoError(t, err)
+
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralNamespace}, namespace)
+	require.NoError(t, err)
+	assert.Equal(t, "stage", namespace.GetAnnotations()[userLabelAnnotationPrefix+"env"])
+	assert.NotContains(t, namespace.GetAnnotations(), userLabelAnnotationPrefix+"team")
+	assert.Equal(t, simpleManagedCentral.Spec.Auth.OwnerOrgName, namespace.GetAnnotations()[orgNameAnnotationKey])
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	require.NoError(t, err)
+	assert.Equal(t, "stage", central.GetAnnotations()[userLabelAnnotationPrefix+"env"])
+	assert.NotContains(t, central.GetAnnotations(), userLabelAnnotationPrefix+"team")
+	assert.Equal(t, "true", central.GetAnnotations()[managedServicesAnnotation])
+}
+
+func TestCentralPendingDeletionIsScaledDownAndRestored(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, CentralReconcilerOptions{})
+
+	_, err := r.Reconcile(context.TODO(), simpleManagedCentral)
+	require.NoError(t, err)
+
+	pendingDeletionCentral := simpleManagedCentral
+	pendingDeletionCentral.RequestStatus = centralConstants.CentralRequestStatusPendingDeletion.String()
+	status, err := r.Reconcile(context.TODO(), pendingDeletionCentral)
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	central := &v1alpha1.Central{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	require.NoError(t, err)
+	assert.Equal(t, "true", central.GetAnnotations()[pauseReconcileAnnotation])
+	assert.Equal(t, "true", central.GetAnnotations()[pendingDeletionAnnotation])
+	deployment := &appsv1.Deployment{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: "central", Namespace: centralNamespace}, deployment)
+	require.NoError(t, err)
+	require.NotNil(t, deployment.Spec.Replicas)
+	assert.Equal(t, int32(0), *deployment.Spec.Replicas)
+	namespace := &v1.Namespace{}
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralNamespace}, namespace)
+	require.NoError(t, err)
+
+	_, err = r.Reconcile(context.TODO(), pendingDeletionCentral)
+	require.ErrorIs(t, err, ErrCentralNotChanged)
+
+	_, err = r.Reconcile(context.TODO(), simpleManagedCentral)
+	require.NoError(t, err)
+
+	err = fakeClient.Get(context.TODO(), client.ObjectKey{Name: centralName, Namespace: centralNamespace}, central)
+	require.NoError(t, err)
+	assert.Equal(t, "false", central.GetAnnotations()[pauseReconcileAnnotation])
+	assert.NotContains(t, central.GetAnnotations(), pendingDeletionAnnotation)
+}
+
 func TestReportRoutesStatuses(t *testing.T) {
 	fakeClient := testutils.NewFakeClientBuilder(t).Build()
 	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, CentralReconcilerOptions{UseRoutes: true})
@@ -0,0 +1,137 @@
+package reconciler
+
+import (
+	"fmt"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
+	"helm.sh/helm/v3/pkg/chartutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// tenantResourceDefaults are the resources of a tenant namespace which do not depend on the resources of Central and
+// Scanner requested by fleet-manager.
+type tenantResourceDefaults struct {
+	// overhead is added to the ResourceQuota for the workloads without resources in the ManagedCentral, e.g. the
+	// egress proxy.
+	overhead corev1.ResourceRequirements
+	// container is the LimitRange default of containers without resources.
+	container corev1.ResourceRequirements
+}
+
+const defaultInstanceType = "standard"
+
+var tenantResourceDefaultsByInstanceType = map[string]tenantResourceDefaults{
+	"eval": {
+		overhead:  resourceRequirements("500m", "512Mi", "1", "1Gi"),
+		container: resourceRequirements("50m", "64Mi", "250m", "256Mi"),
+	},
+	"standard": {
+		overhead:  resourceRequirements("1", "1Gi", "2", "2Gi"),
+		container: resourceRequirements("100m", "128Mi", "500m", "512Mi"),
+	},
+}
+
+// centralDBResources are the resources of the Central DB deployed by the ACS operator if managed DBs are disabled.
+// They are set in the Central CR and match the defaults of the operator, see central.db.resources in
+// image/templates/helm/stackrox-central/internal/defaults.yaml.htpl of stackrox/stackrox.
+var centralDBResources = resourceRequirements("4", "8Gi", "8", "16Gi")
+
+func resourceRequirements(cpuRequest, memoryRequest, cpuLimit, memoryLimit string) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+			corev1.ResourceMemory: resource.MustParse(memoryRequest),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuLimit),
+			corev1.ResourceMemory: resource.MustParse(memoryLimit),
+		},
+	}
+}
+
+// tenantResourceValues returns the values of the ResourceQuota and the LimitRange of the tenant namespace.
+//
+// The ResourceQuota covers Central, Scanner at its maximal number of replicas and Scanner DB with the resources
+// requested by fleet-manager, with one additional replica each for rolling updates. The Central DB and the overhead of
+// the instance type are added on top. The ResourceQuota is disabled if fleet-manager did not request any resources.
+func (r *CentralReconciler) tenantResourceValues(remoteCentral private.ManagedCentral) (chartutil.Values, error) {
+	defaults, ok := tenantResourceDefaultsByInstanceType[remoteCentral.Spec.Central.InstanceType]
+	if !ok {
+		defaults = tenantResourceDefaultsByInstanceType[defaultInstanceType]
+	}
+
+	centralResources, err := converters.ConvertPrivateResourceRequirementsToCoreV1(&remoteCentral.Spec.Central.Resources)
+	if err != nil {
+		return nil, fmt.Errorf("converting Central resources: %w", err)
+	}
+	scannerResources, err := converters.ConvertPrivateResourceRequirementsToCoreV1(&remoteCentral.Spec.Scanner.Analyzer.Resources)
+	if err != nil {
+		return nil, fmt.Errorf("converting Scanner Analyzer resources: %w", err)
+	}
+	scannerDBResources, err := converters.ConvertPrivateResourceRequirementsToCoreV1(&remoteCentral.Spec.Scanner.Db.Resources)
+	if err != nil {
+		return nil, fmt.Errorf("converting Scanner DB resources: %w", err)
+	}
+
+	scannerReplicas := remoteCentral.Spec.Scanner.Analyzer.Scaling.MaxReplicas
+	if scannerReplicas < remoteCentral.Spec.Scanner.Analyzer.Scaling.Replicas {
+		scannerReplicas = remoteCentral.Spec.Scanner.Analyzer.Scaling.Replicas
+	}
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	for _, component := range []struct {
+		resources corev1.ResourceRequirements
+		replicas  int32
+	}{
+		{resources: centralResources, replicas: 2},
+		{resources: scannerResources, replicas: scannerReplicas + 1},
+		{resources: scannerDBResources, replicas: 2},
+	} {
+		addResources(requests, component.resources.Requests, component.replicas)
+		addResources(limits, component.resources.Limits, component.replicas)
+	}
+
+	quotaEnabled := len(requests) > 0 || len(limits) > 0
+	if quotaEnabled {
+		if !r.managedDBEnabled {
+			addResources(requests, centralDBResources.Requests, 1)
+			addResources(limits, centralDBResources.Limits, 1)
+		}
+		addResources(requests, defaults.overhead.Requests, 1)
+		addResources(limits, defaults.overhead.Limits, 1)
+	}
+
+	return chartutil.Values{
+		"resourceQuota": map[string]interface{}{
+			"enabled":  quotaEnabled,
+			"requests": resourceListValues(requests),
+			"limits":   resourceListValues(limits),
+		},
+		"limitRange": map[string]interface{}{
+			"enabled":        true,
+			"defaultRequest": resourceListValues(defaults.container.Requests),
+			"default":        resourceListValues(defaults.container.Limits),
+		},
+	}, nil
+}
+
+// addResources adds the given resources times the number of replicas to the resource list.
+func addResources(list corev1.ResourceList, resources corev1.ResourceList, replicas int32) {
+	for name, quantity := range resources {
+		total := list[name]
+		for i := int32(0); i < replicas; i++ {
+			total.Add(quantity)
+		}
+		list[name] = total
+	}
+}
+
+func resourceListValues(list corev1.ResourceList) map[string]interface{} {
+	values := make(map[string]interface{}, len(list))
+	for name, quantity := range list {
+		values[name.String()] = quantity.String()
+	}
+	return values
+}
@@ -0,0 +1,305 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/charts"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/rox/operator/apis/platform/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func centralWithResources(instanceType string) private.ManagedCentral {
+	managedCentral := simpleManagedCentral
+	managedCentral.Spec.Central = private.ManagedCentralAllOfSpecCentral{
+		InstanceType: instanceType,
+		Resources: private.ResourceRequirements{
+			Requests: map[string]string{"cpu": "1", "memory": "2Gi"},
+			Limits:   map[string]string{"cpu": "2", "memory": "4Gi"},
+		},
+	}
+	managedCentral.Spec.Scanner.Analyzer.Resources = private.ResourceRequirements{
+		Requests: map[string]string{"cpu": "500m", "memory": "1Gi"},
+		Limits:   map[string]string{"cpu": "1", "memory": "2Gi"},
+	}
+	managedCentral.Spec.Scanner.Analyzer.Scaling.Replicas = 1
+	managedCentral.Spec.Scanner.Analyzer.Scaling.MaxReplicas = 2
+	return managedCentral
+}
+
+func assertResourceList(t *testing.T, want map[string]string, got corev1.ResourceList) {
+	require.Len(t, got, len(want))
+	for name, quantity := range want {
+		actual, ok := got[corev1.ResourceName(name)]
+		require.True(t, ok, "resource %s not found", name)
+		wantQuantity := resource.MustParse(quantity)
+		assert.Zero(t, wantQuantity.Cmp(actual), "expected %s to be %s, got %s", name, quantity, actual.String())
+	}
+}
+
+func TestTenantResourceValues(t *testing.T) {
+	tests := []struct {
+		name               string
+		managedCentral     private.ManagedCentral
+		opts               CentralReconcilerOptions
+		wantQuotaEnabled   bool
+		wantRequests       map[string]string
+		wantLimits         map[string]string
+		wantDefaultRequest map[string]string
+		wantDefault        map[string]string
+	}{
+		{
+			name:               "should disable the quota without resources",
+			managedCentral:     simpleManagedCentral,
+			wantDefaultRequest: map[string]string{"cpu": "100m", "memory": "128Mi"},
+			wantDefault:        map[string]string{"cpu": "500m", "memory": "512Mi"},
+		},
+		{
+			name:               "should add the central DB to the quota",
+			managedCentral:     centralWithResources("standard"),
+			wantQuotaEnabled:   true,
+			wantRequests:       map[string]string{"cpu": "8500m", "memory": "16Gi"},
+			wantLimits:         map[string]string{"cpu": "17", "memory": "32Gi"},
+			wantDefaultRequest: map[string]string{"cpu": "100m", "memory": "128Mi"},
+			wantDefault:        map[string]string{"cpu": "500m", "memory": "512Mi"},
+		},
+		{
+			name:               "should not add the central DB to the quota with managed DBs",
+			managedCentral:     centralWithResources("standard"),
+			opts:               CentralReconcilerOptions{ManagedDBEnabled: true},
+			wantQuotaEnabled:   true,
+			wantRequests:       map[string]string{"cpu": "4500m", "memory": "8Gi"},
+			wantLimits:         map[string]string{"cpu": "9", "memory": "16Gi"},
+			wantDefaultRequest: map[string]string{"cpu": "100m", "memory": "128Mi"},
+			wantDefault:        map[string]string{"cpu": "500m", "memory": "512Mi"},
+		},
+		{
+			name:               "should use the defaults of the eval instance type",
+			managedCentral:     centralWithResources("eval"),
+			opts:               CentralReconcilerOptions{ManagedDBEnabled: true},
+			wantQuotaEnabled:   true,
+			wantRequests:       map[string]string{"cpu": "4", "memory": "7680Mi"},
+			wantLimits:         map[string]string{"cpu": "8", "memory": "15Gi"},
+			wantDefaultRequest: map[string]string{"cpu": "50m", "memory": "64Mi"},
+			wantDefault:        map[string]string{"cpu": "250m", "memory": "256Mi"},
+		},
+		{
+			name:               "should use the defaults of the standard instance type for unknown instance types",
+			managedCentral:     centralWithResources("unknown"),
+			opts:               CentralReconcilerOptions{ManagedDBEnabled: true},
+			wantQuotaEnabled:   true,
+			wantRequests:       map[string]string{"cpu": "4500m", "memory": "8Gi"},
+			wantLimits:         map[string]string{"cpu": "9", "memory": "16Gi"},
+			wantDefaultRequest: map[string]string{"cpu": "100m", "memory": "128Mi"},
+			wantDefault:        map[string]string{"cpu": "500m", "memory": "512Mi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewCentralReconciler(testutils.NewFakeClientBuilder(t).Build(), private.ManagedCentral{}, nil, centralDBInitFunc, tt.opts)
+
+			vals, err := r.tenantResourceValues(tt.managedCentral)
+			require.NoError(t, err)
+
+			quota, err := vals.Table("resourceQuota")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuotaEnabled, quota["enabled"])
+			if tt.wantQuotaEnabled {
+				assertValues(t, tt.wantRequests, quota["requests"])
+				assertValues(t, tt.wantLimits, quota["limits"])
+			}
+
+			limitRange, err := vals.Table("limitRange")
+			require.NoError(t, err)
+			assert.Equal(t, true, limitRange["enabled"])
+			assertValues(t, tt.wantDefaultRequest, limitRange["defaultRequest"])
+			assertValues(t, tt.wantDefault, limitRange["default"])
+		})
+	}
+}
+
+func assertValues(t *testing.T, want map[string]string, got interface{}) {
+	values, ok := got.(map[string]interface{})
+	require.True(t, ok, "expected a map, got %T", got)
+	list := corev1.ResourceList{}
+	for name, quantity := range values {
+		list[corev1.ResourceName(name)] = resource.MustParse(quantity.(string))
+	}
+	assertResourceList(t, want, list)
+}
+
+func TestTenantResourcesAreDeployed(t *testing.T) {
+	tests := []struct {
+		name             string
+		opts             CentralReconcilerOptions
+		wantDBEgressPort bool
+	}{
+		{
+			name: "should not allow egress to a managed DB without managed DBs",
+		},
+		{
+			name:             "should allow egress to the managed DB",
+			opts:             CentralReconcilerOptions{ManagedDBEnabled: true},
+			wantDBEgressPort: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := testutils.NewFakeClientBuilder(t).Build()
+			r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, tt.opts)
+			managedCentral := centralWithResources("standard")
+
+			require.NoError(t, r.ensureChartResourcesExist(context.Background(), managedCentral))
+
+			for _, name := range []string{
+				"default-deny-all",
+				"allow-same-namespace",
+				"allow-dns-egress",
+				"allow-kube-apiserver-egress",
+				"allow-central-ingress",
+				"allow-monitoring-ingress",
+				"allow-egress-proxy-egress",
+			} {
+				policy := &networkingv1.NetworkPolicy{}
+				assert.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: name}, policy), "network policy %s not found", name)
+			}
+
+			dbPolicy := &networkingv1.NetworkPolicy{}
+			err := fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "allow-central-db-egress"}, dbPolicy)
+			if tt.wantDBEgressPort {
+				require.NoError(t, err)
+				require.Len(t, dbPolicy.Spec.Egress, 1)
+				require.Len(t, dbPolicy.Spec.Egress[0].Ports, 1)
+				assert.Equal(t, 5432, dbPolicy.Spec.Egress[0].Ports[0].Port.IntValue())
+			} else {
+				assert.True(t, apiErrors.IsNotFound(err), "expected allow-central-db-egress to not exist, got %v", err)
+			}
+
+			quota := &corev1.ResourceQuota{}
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "tenant-resource-quota"}, quota))
+			wantQuota := map[string]string{
+				"requests.cpu":    "8500m",
+				"requests.memory": "16Gi",
+				"limits.cpu":      "17",
+				"limits.memory":   "32Gi",
+			}
+			if tt.opts.ManagedDBEnabled {
+				wantQuota = map[string]string{
+					"requests.cpu":    "4500m",
+					"requests.memory": "8Gi",
+					"limits.cpu":      "9",
+					"limits.memory":   "16Gi",
+				}
+			}
+			assertResourceList(t, wantQuota, quota.Spec.Hard)
+
+			limitRange := &corev1.LimitRange{}
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "tenant-limit-range"}, limitRange))
+			require.Len(t, limitRange.Spec.Limits, 1)
+			assert.Equal(t, corev1.LimitTypeContainer, limitRange.Spec.Limits[0].Type)
+			assertResourceList(t, map[string]string{"cpu": "100m", "memory": "128Mi"}, limitRange.Spec.Limits[0].DefaultRequest)
+			assertResourceList(t, map[string]string{"cpu": "500m", "memory": "512Mi"}, limitRange.Spec.Limits[0].Default)
+		})
+	}
+}
+
+func TestResourceQuotaIsNotDeployedWithoutResources(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, CentralReconcilerOptions{})
+
+	require.NoError(t, r.ensureChartResourcesExist(context.Background(), simpleManagedCentral))
+
+	err := fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "tenant-resource-quota"}, &corev1.ResourceQuota{})
+	assert.True(t, apiErrors.IsNotFound(err), "expected tenant-resource-quota to not exist, got %v", err)
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "tenant-limit-range"}, &corev1.LimitRange{}))
+}
+
+func TestStaleTenantResourcesAreDeleted(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc,
+		CentralReconcilerOptions{ManagedDBEnabled: true})
+
+	require.NoError(t, r.ensureChartResourcesExist(context.Background(), centralWithResources("standard")))
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "tenant-resource-quota"}, &corev1.ResourceQuota{}))
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "allow-central-db-egress"}, &networkingv1.NetworkPolicy{}))
+
+	// objects which are not managed by fleetshard must be kept
+	unmanaged := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: centralNamespace, Name: "unmanaged"}}
+	require.NoError(t, fakeClient.Create(context.Background(), unmanaged))
+
+	r.managedDBEnabled = false
+	require.NoError(t, r.ensureChartResourcesExist(context.Background(), simpleManagedCentral))
+
+	err := fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "tenant-resource-quota"}, &corev1.ResourceQuota{})
+	assert.True(t, apiErrors.IsNotFound(err), "expected tenant-resource-quota to be deleted, got %v", err)
+	err = fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "allow-central-db-egress"}, &networkingv1.NetworkPolicy{})
+	assert.True(t, apiErrors.IsNotFound(err), "expected allow-central-db-egress to be deleted, got %v", err)
+
+	assert.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "tenant-limit-range"}, &corev1.LimitRange{}))
+	assert.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "default-deny-all"}, &networkingv1.NetworkPolicy{}))
+	assert.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(unmanaged), &corev1.ConfigMap{}))
+}
+
+func TestChartResourceGVKsCoverRenderedObjects(t *testing.T) {
+	r := NewCentralReconciler(nil, private.ManagedCentral{}, nil, centralDBInitFunc,
+		CentralReconcilerOptions{ManagedDBEnabled: true})
+	managedCentral := centralWithResources("standard")
+	vals, err := r.chartValues(managedCentral)
+	require.NoError(t, err)
+
+	objs, err := charts.RenderToObjects(helmReleaseName, managedCentral.Metadata.Namespace, r.resourcesChart, vals)
+	require.NoError(t, err)
+	for _, obj := range objs {
+		assert.Contains(t, chartResourceGVKs, obj.GroupVersionKind(), "object %s is not deleted once it is no longer rendered", obj.GetName())
+	}
+}
+
+func TestFleetshardNamespaceIsAllowedToReachCentral(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc,
+		CentralReconcilerOptions{FleetshardNamespace: "acsms"})
+
+	require.NoError(t, r.ensureChartResourcesExist(context.Background(), simpleManagedCentral))
+
+	policy := &networkingv1.NetworkPolicy{}
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: "allow-central-ingress"}, policy))
+	var namespaces []string
+	for _, rule := range policy.Spec.Ingress {
+		for _, peer := range rule.From {
+			if peer.NamespaceSelector != nil {
+				if ns, ok := peer.NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"]; ok {
+					namespaces = append(namespaces, ns)
+				}
+			}
+		}
+	}
+	assert.Equal(t, []string{"acsms"}, namespaces)
+}
+
+func TestCentralDBResourcesAreSetInCentral(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t).Build()
+	r := NewCentralReconciler(fakeClient, private.ManagedCentral{}, nil, centralDBInitFunc, CentralReconcilerOptions{})
+
+	_, err := r.Reconcile(context.Background(), centralWithResources("standard"))
+	require.NoError(t, err)
+
+	// The ResourceQuota includes the Central DB with the resources set in the Central CR.
+	central := &v1alpha1.Central{}
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: centralNamespace, Name: centralName}, central))
+	require.NotNil(t, central.Spec.Central.DB)
+	require.NotNil(t, central.Spec.Central.DB.Resources)
+	assertResourceList(t, map[string]string{"cpu": "4", "memory": "8Gi"}, central.Spec.Central.DB.Resources.Requests)
+	assertResourceList(t, map[string]string{"cpu": "8", "memory": "16Gi"}, central.Spec.Central.DB.Resources.Limits)
+}
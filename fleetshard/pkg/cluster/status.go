@@ -0,0 +1,326 @@
+// Package cluster provides functionality to inspect the data plane cluster fleetshard-sync is running on.
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReadyConditionType is the condition fleet-manager evaluates to decide whether the cluster is ready.
+	ReadyConditionType = "Ready"
+	// CentralOperatorReadyConditionType signals whether at least one ACS operator is ready on the cluster.
+	CentralOperatorReadyConditionType = "CentralOperatorReady"
+
+	tenantIDLabelKey         = "rhacs.redhat.com/tenant"
+	operatorAppLabelKey      = "app"
+	operatorAppLabelValue    = "rhacs-operator"
+	operatorVersionLabelKey  = "rhacs.redhat.com/operator-version"
+	operatorManagerContainer = "manager"
+	controlPlaneRoleLabelKey = "node-role.kubernetes.io/control-plane"
+	masterRoleLabelKey       = "node-role.kubernetes.io/master"
+	infraRoleLabelKey        = "node-role.kubernetes.io/infra"
+
+	clusterReadyReason       = "ClusterReady"
+	noReadyWorkerNodesReason = "NoReadyWorkerNodes"
+	operatorReadyReason      = "CentralOperatorReady"
+	noOperatorReadyReason    = "CentralOperatorNotReady"
+	conditionStatusTrue      = "True"
+	conditionStatusFalse     = "False"
+)
+
+// StatusCollectorOptions are the static options for creating a StatusCollector.
+type StatusCollectorOptions struct {
+	// DefaultTenantResources is the estimated footprint of a single tenant, used to calculate the remaining
+	// Central capacity while no tenants are running on the cluster.
+	DefaultTenantResources corev1.ResourceList
+}
+
+// StatusCollector gathers the capacity and health of the data plane cluster in the format
+// expected by the fleet-manager private API.
+type StatusCollector struct {
+	client                 ctrlClient.Client
+	defaultTenantResources corev1.ResourceList
+}
+
+// NewStatusCollector creates a new StatusCollector.
+func NewStatusCollector(client ctrlClient.Client, opts StatusCollectorOptions) *StatusCollector {
+	return &StatusCollector{
+		client:                 client,
+		defaultTenantResources: opts.DefaultTenantResources,
+	}
+}
+
+// Collect returns the current status of the data plane cluster.
+func (c *StatusCollector) Collect(ctx context.Context) (*private.DataPlaneClusterUpdateStatusRequest, error) {
+	resources, readyWorkerNodes, err := c.collectResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	operators, err := c.collectOperators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &private.DataPlaneClusterUpdateStatusRequest{
+		Conditions:      conditions(readyWorkerNodes, operators),
+		CentralOperator: operators,
+		Resources:       *resources,
+	}, nil
+}
+
+// collectResources returns the capacity of the cluster and the number of ready worker nodes contributing to it.
+func (c *StatusCollector) collectResources(ctx context.Context) (*private.DataPlaneClusterUpdateStatusRequestResources, int, error) {
+	nodes := &corev1.NodeList{}
+	if err := c.client.List(ctx, nodes); err != nil {
+		return nil, 0, errors.Wrap(err, "listing nodes")
+	}
+	pods := &corev1.PodList{}
+	if err := c.client.List(ctx, pods); err != nil {
+		return nil, 0, errors.Wrap(err, "listing pods")
+	}
+	tenantNamespaces := &corev1.NamespaceList{}
+	if err := c.client.List(ctx, tenantNamespaces, ctrlClient.HasLabels{tenantIDLabelKey}); err != nil {
+		return nil, 0, errors.Wrap(err, "listing tenant namespaces")
+	}
+
+	allocatable := corev1.ResourceList{}
+	workerNodes := make(map[string]struct{}, len(nodes.Items))
+	for _, node := range nodes.Items {
+		if !isSchedulableWorkerNode(node) || !isNodeReady(node) {
+			continue
+		}
+		workerNodes[node.Name] = struct{}{}
+		addResources(allocatable, node.Status.Allocatable)
+	}
+
+	isTenantNamespace := make(map[string]struct{}, len(tenantNamespaces.Items))
+	for _, ns := range tenantNamespaces.Items {
+		isTenantNamespace[ns.Name] = struct{}{}
+	}
+
+	requested := corev1.ResourceList{}
+	tenantRequested := corev1.ResourceList{}
+	tenants := map[string]struct{}{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if _, ok := workerNodes[pod.Spec.NodeName]; !ok {
+			continue
+		}
+		podRequests := podRequests(pod)
+		addResources(requested, podRequests)
+		if _, ok := isTenantNamespace[pod.Namespace]; ok {
+			addResources(tenantRequested, podRequests)
+			tenants[pod.Namespace] = struct{}{}
+		}
+	}
+
+	tenantFootprint := c.defaultTenantResources
+	if len(tenants) > 0 {
+		tenantFootprint = averageResources(tenantRequested, len(tenants))
+	}
+
+	return &private.DataPlaneClusterUpdateStatusRequestResources{
+		Allocatable:              toPrivateResourceList(allocatable),
+		Requested:                toPrivateResourceList(requested),
+		RemainingCentralCapacity: remainingCapacity(allocatable, requested, tenantFootprint),
+	}, len(workerNodes), nil
+}
+
+func (c *StatusCollector) collectOperators(ctx context.Context) ([]private.DataPlaneClusterUpdateStatusRequestCentralOperator, error) {
+	deployments := &appsv1.DeploymentList{}
+	if err := c.client.List(ctx, deployments, ctrlClient.MatchingLabels{operatorAppLabelKey: operatorAppLabelValue}); err != nil {
+		return nil, errors.Wrap(err, "listing ACS operator deployments")
+	}
+
+	var operators []private.DataPlaneClusterUpdateStatusRequestCentralOperator
+	for _, deployment := range deployments.Items {
+		version := operatorVersion(deployment)
+		if version == "" {
+			continue
+		}
+		operators = append(operators, private.DataPlaneClusterUpdateStatusRequestCentralOperator{
+			Ready:   isDeploymentReady(deployment),
+			Version: version,
+			// An ACS operator installs the Central version it has been released with.
+			CentralVersions: []string{version},
+		})
+	}
+	return operators, nil
+}
+
+// conditions returns the cluster conditions. The cluster is only Ready if Centrals can be scheduled on it, i.e. if it
+// has at least one ready and schedulable worker node and at least one ready ACS operator.
+func conditions(readyWorkerNodes int, operators []private.DataPlaneClusterUpdateStatusRequestCentralOperator) []private.DataPlaneClusterUpdateStatusRequestConditions {
+	operatorCondition := private.DataPlaneClusterUpdateStatusRequestConditions{
+		Type:    CentralOperatorReadyConditionType,
+		Status:  conditionStatusFalse,
+		Reason:  noOperatorReadyReason,
+		Message: "no ready ACS operator found on the cluster",
+	}
+	for _, operator := range operators {
+		if operator.Ready {
+			operatorCondition = private.DataPlaneClusterUpdateStatusRequestConditions{
+				Type:   CentralOperatorReadyConditionType,
+				Status: conditionStatusTrue,
+				Reason: operatorReadyReason,
+			}
+			break
+		}
+	}
+
+	readyCondition := private.DataPlaneClusterUpdateStatusRequestConditions{
+		Type:   ReadyConditionType,
+		Status: conditionStatusTrue,
+		Reason: clusterReadyReason,
+	}
+	if readyWorkerNodes == 0 {
+		readyCondition.Status = conditionStatusFalse
+		readyCondition.Reason = noReadyWorkerNodesReason
+		readyCondition.Message = "no ready and schedulable worker nodes found on the cluster"
+	} else if operatorCondition.Status != conditionStatusTrue {
+		readyCondition.Status = conditionStatusFalse
+		readyCondition.Reason = operatorCondition.Reason
+		readyCondition.Message = operatorCondition.Message
+	}
+
+	return []private.DataPlaneClusterUpdateStatusRequestConditions{readyCondition, operatorCondition}
+}
+
+func isSchedulableWorkerNode(node corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, key := range []string{controlPlaneRoleLabelKey, masterRoleLabelKey, infraRoleLabelKey} {
+		if _, ok := node.Labels[key]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+func isNodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func isDeploymentReady(deployment appsv1.Deployment) bool {
+	return deployment.Status.AvailableReplicas > 0 && deployment.Status.UnavailableReplicas == 0
+}
+
+// operatorVersion returns the version the operator was installed with, or derives it from the image tag of the
+// operator manager container for operators installed without a version label.
+func operatorVersion(deployment appsv1.Deployment) string {
+	if version := deployment.Labels[operatorVersionLabelKey]; version != "" {
+		return version
+	}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name != operatorManagerContainer {
+			continue
+		}
+		idx := strings.LastIndex(container.Image, ":")
+		if idx < 0 || strings.Contains(container.Image[idx:], "/") {
+			return ""
+		}
+		return container.Image[idx+1:]
+	}
+	return ""
+}
+
+// podRequests returns the effective resource requests of a pod, which is the maximum of the sum of all
+// app containers and any init container.
+func podRequests(pod corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	addResources(requests, pod.Spec.Overhead)
+	return requests
+}
+
+func addResources(total corev1.ResourceList, add corev1.ResourceList) {
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		quantity, ok := add[name]
+		if !ok {
+			continue
+		}
+		current := total[name]
+		current.Add(quantity)
+		total[name] = current
+	}
+}
+
+func averageResources(total corev1.ResourceList, count int) corev1.ResourceList {
+	cpu := total[corev1.ResourceCPU]
+	memory := total[corev1.ResourceMemory]
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewMilliQuantity(cpu.MilliValue()/int64(count), resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(memory.Value()/int64(count), resource.BinarySI),
+	}
+}
+
+// remainingCapacity estimates how many tenants with the given footprint fit into the unrequested capacity.
+func remainingCapacity(allocatable, requested, tenantFootprint corev1.ResourceList) int32 {
+	var remaining int64 = -1
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		footprint := tenantFootprint[name]
+		if footprint.IsZero() {
+			continue
+		}
+		free := allocatable[name]
+		free.Sub(requested[name])
+		fits := free.MilliValue() / footprint.MilliValue()
+		if fits < 0 {
+			fits = 0
+		}
+		if remaining < 0 || fits < remaining {
+			remaining = fits
+		}
+	}
+	if remaining < 0 {
+		return 0
+	}
+	return int32(remaining)
+}
+
+func toPrivateResourceList(list corev1.ResourceList) map[string]string {
+	res := make(map[string]string, len(list))
+	for name, quantity := range list {
+		res[name.String()] = quantity.String()
+	}
+	return res
+}
+
+// ParseResourceList parses CPU and memory quantities into a resource list.
+func ParseResourceList(cpu, memory string) (corev1.ResourceList, error) {
+	res := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{corev1.ResourceCPU: cpu, corev1.ResourceMemory: memory} {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s quantity %q: %w", name, value, err)
+		}
+		res[name] = quantity
+	}
+	return res, nil
+}
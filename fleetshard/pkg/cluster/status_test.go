@@ -0,0 +1,169 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newNode(name string, cpu, memory string, labels map[string]string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func newPod(namespace, name, node, cpu, memory string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpu),
+						corev1.ResourceMemory: resource.MustParse(memory),
+					},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func newOperatorDeployment(image string, available int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rhacs-operator-controller-manager",
+			Namespace: "stackrox-operator",
+			Labels:    map[string]string{operatorAppLabelKey: operatorAppLabelValue},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "kube-rbac-proxy", Image: "gcr.io/kubebuilder/kube-rbac-proxy:v0.13.0"},
+						{Name: operatorManagerContainer, Image: image},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{AvailableReplicas: available},
+	}
+}
+
+func TestCollect(t *testing.T) {
+	tenantNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "rhacs-tenant", Labels: map[string]string{tenantIDLabelKey: "tenant"}},
+	}
+	objects := []ctrlClient.Object{
+		newNode("worker-1", "4", "16Gi", nil),
+		newNode("worker-2", "4", "16Gi", nil),
+		newNode("master-1", "8", "32Gi", map[string]string{masterRoleLabelKey: ""}),
+		tenantNamespace,
+		newPod(tenantNamespace.Name, "central", "worker-1", "1", "4Gi"),
+		newPod("openshift-monitoring", "prometheus", "worker-2", "1", "4Gi"),
+		newPod("openshift-etcd", "etcd", "master-1", "2", "8Gi"),
+		newOperatorDeployment("quay.io/rhacs-eng/stackrox-operator:3.74.0", 1),
+	}
+	client := testutils.NewFakeClientBuilder(t, objects...).Build()
+	collector := NewStatusCollector(client, StatusCollectorOptions{})
+
+	status, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"cpu": "8", "memory": "32Gi"}, status.Resources.Allocatable)
+	assert.Equal(t, map[string]string{"cpu": "2", "memory": "8Gi"}, status.Resources.Requested)
+	// 6 CPUs and 24Gi memory are left, each tenant requests 1 CPU and 4Gi memory.
+	assert.Equal(t, int32(6), status.Resources.RemainingCentralCapacity)
+
+	require.Len(t, status.CentralOperator, 1)
+	assert.Equal(t, "3.74.0", status.CentralOperator[0].Version)
+	assert.True(t, status.CentralOperator[0].Ready)
+	assert.Equal(t, []string{"3.74.0"}, status.CentralOperator[0].CentralVersions)
+
+	require.Len(t, status.Conditions, 2)
+	assert.Equal(t, ReadyConditionType, status.Conditions[0].Type)
+	assert.Equal(t, "True", status.Conditions[0].Status)
+	assert.Equal(t, CentralOperatorReadyConditionType, status.Conditions[1].Type)
+	assert.Equal(t, "True", status.Conditions[1].Status)
+}
+
+func TestCollectReportsVersionLabelOfOperators(t *testing.T) {
+	deployment := newOperatorDeployment("quay.io/rhacs-eng/stackrox-operator@sha256:0123456789abcdef", 0)
+	deployment.Name = "rhacs-operator-controller-manager-4-0-0"
+	deployment.Labels[operatorVersionLabelKey] = "4.0.0"
+	client := testutils.NewFakeClientBuilder(t, deployment).Build()
+	collector := NewStatusCollector(client, StatusCollectorOptions{})
+
+	status, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, status.CentralOperator, 1)
+	assert.Equal(t, "4.0.0", status.CentralOperator[0].Version)
+	assert.False(t, status.CentralOperator[0].Ready)
+}
+
+func TestCollectWithoutTenantsUsesDefaultTenantResources(t *testing.T) {
+	objects := []ctrlClient.Object{
+		newNode("worker-1", "4", "16Gi", nil),
+		newOperatorDeployment("quay.io/rhacs-eng/stackrox-operator:3.74.0", 0),
+	}
+	client := testutils.NewFakeClientBuilder(t, objects...).Build()
+	defaultTenantResources, err := ParseResourceList("1", "8Gi")
+	require.NoError(t, err)
+	collector := NewStatusCollector(client, StatusCollectorOptions{DefaultTenantResources: defaultTenantResources})
+
+	status, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+
+	// Memory is the limiting resource.
+	assert.Equal(t, int32(2), status.Resources.RemainingCentralCapacity)
+	require.Len(t, status.CentralOperator, 1)
+	assert.False(t, status.CentralOperator[0].Ready)
+	assert.Equal(t, "False", status.Conditions[0].Status)
+	assert.Equal(t, noOperatorReadyReason, status.Conditions[0].Reason)
+	assert.Equal(t, "False", status.Conditions[1].Status)
+}
+
+func TestCollectIsNotReadyWithoutReadyWorkerNodes(t *testing.T) {
+	notReadyNode := newNode("worker-1", "4", "16Gi", nil)
+	notReadyNode.Status.Conditions[0].Status = corev1.ConditionFalse
+	objects := []ctrlClient.Object{
+		notReadyNode,
+		newNode("worker-2", "4", "16Gi", nil),
+		newOperatorDeployment("quay.io/rhacs-eng/stackrox-operator:3.74.0", 1),
+	}
+	objects[1].(*corev1.Node).Spec.Unschedulable = true
+	client := testutils.NewFakeClientBuilder(t, objects...).Build()
+	collector := NewStatusCollector(client, StatusCollectorOptions{})
+
+	status, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+
+	assert.Empty(t, status.Resources.Allocatable)
+	require.Len(t, status.Conditions, 2)
+	assert.Equal(t, "False", status.Conditions[0].Status)
+	assert.Equal(t, noReadyWorkerNodesReason, status.Conditions[0].Reason)
+	assert.Equal(t, "True", status.Conditions[1].Status)
+}
+
+func TestParseResourceListInvalid(t *testing.T) {
+	_, err := ParseResourceList("1", "lots")
+	assert.Error(t, err)
+}
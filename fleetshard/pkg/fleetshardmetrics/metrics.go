@@ -3,6 +3,7 @@ package fleetshardmetrics
 
 import (
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -23,6 +24,10 @@ type Metrics struct {
 	centralReconcilationErrors  prometheus.Counter
 	activeCentralReconcilations prometheus.Gauge
 	totalCentrals               prometheus.Gauge
+	clusterStatusReportErrors   prometheus.Counter
+	remainingCentralCapacity    prometheus.Gauge
+	reconcileQueueDepth         prometheus.Gauge
+	reconcileQueueWait          prometheus.Histogram
 }
 
 // Register registers the metrics with the given prometheus.Registerer
@@ -33,6 +38,10 @@ func (m *Metrics) Register(r prometheus.Registerer) {
 	r.MustRegister(m.centralReconcilationErrors)
 	r.MustRegister(m.activeCentralReconcilations)
 	r.MustRegister(m.totalCentrals)
+	r.MustRegister(m.clusterStatusReportErrors)
+	r.MustRegister(m.remainingCentralCapacity)
+	r.MustRegister(m.reconcileQueueDepth)
+	r.MustRegister(m.reconcileQueueWait)
 }
 
 // IncFleetManagerRequests increments the metric counter for fleet-manager requests
@@ -70,6 +79,26 @@ func (m *Metrics) DecActiveCentralReconcilations() {
 	m.activeCentralReconcilations.Dec()
 }
 
+// IncClusterStatusReportErrors increments the metric counter for failed cluster status reports
+func (m *Metrics) IncClusterStatusReportErrors() {
+	m.clusterStatusReportErrors.Inc()
+}
+
+// SetClusterRemainingCentralCapacity sets the metric for the estimated remaining central capacity of the cluster
+func (m *Metrics) SetClusterRemainingCentralCapacity(v float64) {
+	m.remainingCentralCapacity.Set(v)
+}
+
+// SetCentralReconcileQueueDepth sets the metric for the number of centrals waiting to be reconciled
+func (m *Metrics) SetCentralReconcileQueueDepth(v float64) {
+	m.reconcileQueueDepth.Set(v)
+}
+
+// ObserveCentralReconcileQueueWait observes the time a central waited in the queue before it was reconciled
+func (m *Metrics) ObserveCentralReconcileQueueWait(d time.Duration) {
+	m.reconcileQueueWait.Observe(d.Seconds())
+}
+
 // MetricsInstance return the global Singleton instance for Metrics
 func MetricsInstance() *Metrics {
 	once.Do(initMetricsInstance)
@@ -106,5 +135,22 @@ func newMetrics() *Metrics {
 			Name: metricsPrefix + "total_centrals",
 			Help: "The total number of centrals monitored by fleetshard-sync",
 		}),
+		clusterStatusReportErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: metricsPrefix + "total_cluster_status_report_errors",
+			Help: "The total number of failed cluster status reports to fleet-manager",
+		}),
+		remainingCentralCapacity: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: metricsPrefix + "remaining_central_capacity",
+			Help: "The estimated number of additional centrals that can be scheduled onto the cluster",
+		}),
+		reconcileQueueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: metricsPrefix + "central_reconcile_queue_depth",
+			Help: "The number of centrals waiting to be reconciled",
+		}),
+		reconcileQueueWait: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    metricsPrefix + "central_reconcile_queue_wait_seconds",
+			Help:    "The time centrals waited in the queue before they were reconciled",
+			Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900},
+		}),
 	}
 }
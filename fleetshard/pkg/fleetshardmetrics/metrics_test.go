@@ -2,6 +2,7 @@ package fleetshardmetrics
 
 import (
 	"testing"
+	"time"
 
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,12 @@ func TestCounterIncrements(t *testing.T) {
 				m.IncCentralReconcilationErrors()
 			},
 		},
+		{
+			metricName: "total_cluster_status_report_errors",
+			callIncrementFunc: func(m *Metrics) {
+				m.IncClusterStatusReportErrors()
+			},
+		},
 	}
 
 	for _, tc := range tt {
@@ -88,6 +95,20 @@ func TestActiveCentralReconcilations(t *testing.T) {
 	assert.Equalf(t, 0.0, *value, "expected metric: %s to have value: %v", metricName, 0.0)
 }
 
+func TestCentralReconcileQueue(t *testing.T) {
+	m := newMetrics()
+
+	m.SetCentralReconcileQueueDepth(3)
+	m.ObserveCentralReconcileQueueWait(2 * time.Second)
+	metrics := serveMetrics(t, m)
+
+	depthMetric := requireMetric(t, metrics, metricsPrefix+"central_reconcile_queue_depth")
+	assert.Equal(t, 3.0, *depthMetric.Metric[0].Gauge.Value)
+	waitMetric := requireMetric(t, metrics, metricsPrefix+"central_reconcile_queue_wait_seconds")
+	assert.Equal(t, uint64(1), *waitMetric.Metric[0].Histogram.SampleCount)
+	assert.Equal(t, 2.0, *waitMetric.Metric[0].Histogram.SampleSum)
+}
+
 func requireMetric(t *testing.T, metrics metricResponse, metricName string) *io_prometheus_client.MetricFamily {
 	targetMetric, hasKey := metrics[metricName]
 	require.Truef(t, hasKey, "expected metrics to contain %s but it did not: %v", metricName, metrics)
@@ -0,0 +1,117 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+)
+
+// centralWatcher keeps the ManagedCentrals of the cluster up to date by watching fleet-manager for changes.
+type centralWatcher struct {
+	client          fleetmanager.PrivateAPI
+	clusterID       string
+	timeout         time.Duration
+	resourceVersion string
+	centrals        map[string]private.ManagedCentral
+}
+
+func newCentralWatcher(client fleetmanager.PrivateAPI, clusterID string, timeout time.Duration) *centralWatcher {
+	return &centralWatcher{
+		client:    client,
+		clusterID: clusterID,
+		timeout:   timeout,
+		centrals:  map[string]private.ManagedCentral{},
+	}
+}
+
+// next waits until the ManagedCentrals of the cluster change or the watch times out, and returns the
+// complete list of ManagedCentrals. If the resource version expired, the watch is restarted right away.
+func (w *centralWatcher) next(ctx context.Context) (private.ManagedCentralList, error) {
+	list, resp, err := w.watch(ctx)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusGone {
+		glog.Infof("Resource version %q of the central watch expired, restarting the watch", w.resourceVersion)
+		w.reset()
+		list, _, err = w.watch(ctx)
+	}
+	if err != nil {
+		w.reset()
+		return private.ManagedCentralList{}, err
+	}
+	return list, nil
+}
+
+func (w *centralWatcher) watch(ctx context.Context) (private.ManagedCentralList, *http.Response, error) {
+	opts := &private.WatchCentralsOpts{
+		TimeoutSeconds: optional.NewInt32(int32(w.timeout.Seconds())),
+	}
+	if w.resourceVersion != "" {
+		opts.ResourceVersion = optional.NewString(w.resourceVersion)
+	}
+	events, resp, err := w.client.WatchCentrals(ctx, w.clusterID, opts)
+	if err != nil {
+		return private.ManagedCentralList{}, resp, errors.Wrap(err, "watching managed centrals")
+	}
+
+	centrals := w.centrals
+	if w.resourceVersion == "" {
+		// Without a resource version, the events contain the complete list of centrals.
+		centrals = map[string]private.ManagedCentral{}
+	}
+	for _, event := range events.Items {
+		if err := applyWatchEvent(centrals, event); err != nil {
+			return private.ManagedCentralList{}, resp, err
+		}
+	}
+	w.centrals = centrals
+	w.resourceVersion = events.ResourceVersion
+
+	list := private.ManagedCentralList{
+		Kind:  "ManagedCentralList",
+		Items: make([]private.ManagedCentral, 0, len(centrals)),
+	}
+	for _, central := range centrals {
+		list.Items = append(list.Items, central)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Id < list.Items[j].Id
+	})
+	return list, resp, nil
+}
+
+// reset drops the watch state, so that the next watch starts over with the complete list of centrals.
+func (w *centralWatcher) reset() {
+	w.resourceVersion = ""
+	w.centrals = map[string]private.ManagedCentral{}
+}
+
+func applyWatchEvent(centrals map[string]private.ManagedCentral, event private.WatchEvent) error {
+	if event.Object == nil {
+		return errors.Errorf("watch event of type %q has no object", event.Type)
+	}
+	data, err := json.Marshal(event.Object)
+	if err != nil {
+		return errors.Wrapf(err, "marshalling object of watch event %q", event.Type)
+	}
+	var central private.ManagedCentral
+	if err := json.Unmarshal(data, &central); err != nil {
+		return errors.Wrapf(err, "unmarshalling managed central of watch event %q", event.Type)
+	}
+
+	switch event.Type {
+	case "ADDED", "MODIFIED":
+		centrals[central.Id] = central
+	case "DELETED":
+		delete(centrals, central.Id)
+	default:
+		return errors.Errorf("unknown watch event type %q", event.Type)
+	}
+	return nil
+}
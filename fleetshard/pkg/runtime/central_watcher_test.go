@@ -0,0 +1,120 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func watchEvent(eventType string, id string, name string) private.WatchEvent {
+	object := map[string]interface{}{
+		"id":   id,
+		"kind": "ManagedCentral",
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+	}
+	return private.WatchEvent{Type: eventType, Object: &object}
+}
+
+func centralNames(list private.ManagedCentralList) []string {
+	names := []string{}
+	for _, central := range list.Items {
+		names = append(names, central.Metadata.Name)
+	}
+	return names
+}
+
+func TestCentralWatcher_Next(t *testing.T) {
+	responses := []private.ManagedCentralWatchEventList{
+		{ResourceVersion: "1", Items: []private.WatchEvent{
+			watchEvent("ADDED", "b", "central-b"),
+			watchEvent("ADDED", "a", "central-a"),
+		}},
+		{ResourceVersion: "2", Items: []private.WatchEvent{
+			watchEvent("MODIFIED", "a", "central-a-modified"),
+			watchEvent("DELETED", "b", ""),
+			watchEvent("ADDED", "c", "central-c"),
+		}},
+		{ResourceVersion: "2", Items: []private.WatchEvent{}},
+	}
+	var resourceVersions []string
+	client := &fleetmanager.PrivateAPIMock{
+		WatchCentralsFunc: func(ctx context.Context, id string, opts *private.WatchCentralsOpts) (private.ManagedCentralWatchEventList, *http.Response, error) {
+			assert.Equal(t, int32(20), opts.TimeoutSeconds.Value())
+			resourceVersions = append(resourceVersions, opts.ResourceVersion.Value())
+			response := responses[0]
+			responses = responses[1:]
+			return response, &http.Response{StatusCode: http.StatusOK}, nil
+		},
+	}
+	watcher := newCentralWatcher(client, "cluster-id", 20*time.Second)
+
+	list, err := watcher.next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"central-a", "central-b"}, centralNames(list))
+
+	list, err = watcher.next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"central-a-modified", "central-c"}, centralNames(list))
+
+	list, err = watcher.next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"central-a-modified", "central-c"}, centralNames(list))
+
+	assert.Equal(t, []string{"", "1", "2"}, resourceVersions)
+}
+
+func TestCentralWatcher_NextRestartsExpiredWatch(t *testing.T) {
+	client := &fleetmanager.PrivateAPIMock{
+		WatchCentralsFunc: func(ctx context.Context, id string, opts *private.WatchCentralsOpts) (private.ManagedCentralWatchEventList, *http.Response, error) {
+			if opts.ResourceVersion.IsSet() {
+				return private.ManagedCentralWatchEventList{}, &http.Response{StatusCode: http.StatusGone}, assert.AnError
+			}
+			return private.ManagedCentralWatchEventList{ResourceVersion: "2", Items: []private.WatchEvent{
+				watchEvent("ADDED", "a", "central-a"),
+			}}, &http.Response{StatusCode: http.StatusOK}, nil
+		},
+	}
+	watcher := newCentralWatcher(client, "cluster-id", 20*time.Second)
+	watcher.resourceVersion = "1"
+	watcher.centrals["b"] = private.ManagedCentral{Id: "b"}
+
+	list, err := watcher.next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"central-a"}, centralNames(list))
+	assert.Equal(t, "2", watcher.resourceVersion)
+	assert.Len(t, client.WatchCentralsCalls(), 2)
+}
+
+func TestRuntime_ListCentralsFallsBackToPolling(t *testing.T) {
+	client := &fleetmanager.PrivateAPIMock{
+		WatchCentralsFunc: func(ctx context.Context, id string, opts *private.WatchCentralsOpts) (private.ManagedCentralWatchEventList, *http.Response, error) {
+			return private.ManagedCentralWatchEventList{}, &http.Response{StatusCode: http.StatusNotFound}, assert.AnError
+		},
+		GetCentralsFunc: func(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error) {
+			return private.ManagedCentralList{Items: []private.ManagedCentral{{Id: "a"}}}, nil, nil
+		},
+	}
+	clientMock := fleetmanager.NewClientMock()
+	clientMock.PrivateAPIMock = client
+	r := &Runtime{
+		config:         &config.Config{RuntimePollPeriod: 5 * time.Second},
+		client:         clientMock.Client(),
+		clusterID:      "cluster-id",
+		centralWatcher: newCentralWatcher(client, "cluster-id", 20*time.Second),
+	}
+
+	list, nextTick, err := r.listCentrals(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, 5*time.Second, nextTick)
+	assert.Len(t, client.GetCentralsCalls(), 1)
+}
@@ -0,0 +1,157 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/fleetshardmetrics"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+)
+
+// reconcileRequest is a pending reconciliation of a Central with its reconciler.
+type reconcileRequest struct {
+	central    private.ManagedCentral
+	reconciler *centralReconciler.CentralReconciler
+	queuedAt   time.Time
+}
+
+type reconcileFunc func(ctx context.Context, req reconcileRequest)
+
+// reconcileQueue reconciles the queued Centrals with a bounded number of workers.
+//
+// At most one request is pending per Central. Queuing a Central which is already pending replaces the pending
+// request, so that the latest state of the Central is reconciled. A Central is never reconciled by two workers
+// at the same time: a request for a Central which is being reconciled waits until the reconciliation finished.
+type reconcileQueue struct {
+	reconcile reconcileFunc
+	timeout   time.Duration
+
+	mutex sync.Mutex
+	cond  *sync.Cond
+	// queue holds the IDs of the pending Centrals which are not being reconciled, in the order they were queued.
+	queue        []string
+	pending      map[string]*reconcileRequest
+	active       map[string]bool
+	shuttingDown bool
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	workers sync.WaitGroup
+}
+
+func newReconcileQueue(parallelism int, timeout time.Duration, reconcile reconcileFunc) *reconcileQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &reconcileQueue{
+		reconcile: reconcile,
+		timeout:   timeout,
+		pending:   make(map[string]*reconcileRequest),
+		active:    make(map[string]bool),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	q.cond = sync.NewCond(&q.mutex)
+	q.workers.Add(parallelism)
+	for i := 0; i < parallelism; i++ {
+		go q.worker()
+	}
+	return q
+}
+
+// add queues the reconciliation of the given Central. Requests are dropped once the queue is shutting down.
+func (q *reconcileQueue) add(central private.ManagedCentral, reconciler *centralReconciler.CentralReconciler) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.shuttingDown {
+		return
+	}
+	if req, ok := q.pending[central.Id]; ok {
+		req.central = central
+		req.reconciler = reconciler
+		return
+	}
+	q.pending[central.Id] = &reconcileRequest{central: central, reconciler: reconciler, queuedAt: time.Now()}
+	if !q.active[central.Id] {
+		q.queue = append(q.queue, central.Id)
+		q.cond.Signal()
+	}
+	fleetshardmetrics.MetricsInstance().SetCentralReconcileQueueDepth(float64(len(q.pending)))
+}
+
+// next blocks until a request can be reconciled and marks its Central as active. It returns false once the
+// queue is shutting down.
+func (q *reconcileQueue) next() (*reconcileRequest, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for len(q.queue) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if q.shuttingDown {
+		return nil, false
+	}
+	id := q.queue[0]
+	q.queue = q.queue[1:]
+	req := q.pending[id]
+	delete(q.pending, id)
+	q.active[id] = true
+	fleetshardmetrics.MetricsInstance().SetCentralReconcileQueueDepth(float64(len(q.pending)))
+	return req, true
+}
+
+// done marks the Central as no longer active and queues the request which was added during the reconciliation.
+func (q *reconcileQueue) done(id string) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	delete(q.active, id)
+	if _, ok := q.pending[id]; ok && !q.shuttingDown {
+		q.queue = append(q.queue, id)
+		q.cond.Signal()
+	}
+}
+
+func (q *reconcileQueue) worker() {
+	defer q.workers.Done()
+	for {
+		req, ok := q.next()
+		if !ok {
+			return
+		}
+		fleetshardmetrics.MetricsInstance().ObserveCentralReconcileQueueWait(time.Since(req.queuedAt))
+		ctx, cancel := context.WithTimeout(q.ctx, q.timeout)
+		q.reconcile(ctx, *req)
+		cancel()
+		q.done(req.central.Id)
+	}
+}
+
+// shutdown drops the pending requests and waits for the in-flight reconciliations to finish. The in-flight
+// reconciliations are cancelled once the given context is done.
+func (q *reconcileQueue) shutdown(ctx context.Context) error {
+	q.mutex.Lock()
+	q.shuttingDown = true
+	q.queue = nil
+	q.pending = make(map[string]*reconcileRequest)
+	q.cond.Broadcast()
+	q.mutex.Unlock()
+	fleetshardmetrics.MetricsInstance().SetCentralReconcileQueueDepth(0)
+
+	drained := make(chan struct{})
+	go func() {
+		q.workers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		return errors.Wrap(ctx.Err(), "waiting for in-flight central reconciliations, cancelled them")
+	}
+}
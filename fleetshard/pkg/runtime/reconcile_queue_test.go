@@ -0,0 +1,126 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func queuedCentral(id string, name string) private.ManagedCentral {
+	return private.ManagedCentral{Id: id, Metadata: private.ManagedCentralAllOfMetadata{Name: name}}
+}
+
+func TestReconcileQueue_BoundsParallelism(t *testing.T) {
+	release := make(chan struct{})
+	var mutex sync.Mutex
+	var running, maxRunning int
+	var reconciled sync.WaitGroup
+	reconciled.Add(5)
+
+	q := newReconcileQueue(2, time.Minute, func(ctx context.Context, req reconcileRequest) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		<-release
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		reconciled.Done()
+	})
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		q.add(queuedCentral(id, "central-"+id), nil)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	reconciled.Wait()
+	require.NoError(t, q.shutdown(context.Background()))
+	assert.Equal(t, 2, maxRunning)
+}
+
+func TestReconcileQueue_DeduplicatesPendingCentrals(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var mutex sync.Mutex
+	var reconciled []string
+
+	q := newReconcileQueue(2, time.Minute, func(ctx context.Context, req reconcileRequest) {
+		mutex.Lock()
+		reconciled = append(reconciled, req.central.Metadata.Name)
+		mutex.Unlock()
+		if req.central.Metadata.Name == "v1" {
+			close(started)
+			<-release
+		}
+	})
+	q.add(queuedCentral("a", "v1"), nil)
+	<-started
+	// The Central is being reconciled, only the latest update waits for the next reconciliation.
+	q.add(queuedCentral("a", "v2"), nil)
+	q.add(queuedCentral("a", "v3"), nil)
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(reconciled) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, q.shutdown(context.Background()))
+	assert.Equal(t, []string{"v1", "v3"}, reconciled)
+}
+
+func TestReconcileQueue_ShutdownDrainsInFlightReconciliations(t *testing.T) {
+	started := make(chan struct{})
+	var reconciled []string
+
+	q := newReconcileQueue(1, time.Minute, func(ctx context.Context, req reconcileRequest) {
+		if req.central.Id == "a" {
+			close(started)
+			time.Sleep(50 * time.Millisecond)
+		}
+		reconciled = append(reconciled, req.central.Id)
+	})
+	q.add(queuedCentral("a", "central-a"), nil)
+	<-started
+	q.add(queuedCentral("b", "central-b"), nil)
+
+	err := q.shutdown(context.Background())
+
+	require.NoError(t, err)
+	// The pending Central is dropped on shutdown.
+	assert.Equal(t, []string{"a"}, reconciled)
+	q.add(queuedCentral("c", "central-c"), nil)
+	assert.Empty(t, q.pending)
+}
+
+func TestReconcileQueue_ShutdownCancelsInFlightReconciliationsAfterDeadline(t *testing.T) {
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+
+	q := newReconcileQueue(1, time.Minute, func(ctx context.Context, req reconcileRequest) {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+	})
+	q.add(queuedCentral("a", "central-a"), nil)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := q.shutdown(ctx)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("in-flight reconciliation was not cancelled")
+	}
+}
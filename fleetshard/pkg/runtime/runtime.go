@@ -4,6 +4,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/operator"
@@ -11,10 +12,13 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/backup"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/awsclient"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/cnpgclient"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/cluster"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/fleetshardmetrics"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
@@ -32,6 +36,10 @@ type reconcilerRegistry map[string]*centralReconciler.CentralReconciler
 
 var reconciledCentralCountCache int32
 
+// centralReconcileTimeout should cover the duration of a Reconcile call. Managed DBs are provisioned without blocking,
+// a Reconcile call only initiates the provisioning and is retried until the DB is available.
+const centralReconcileTimeout = 5 * time.Minute
+
 var backoff = wait.Backoff{
 	Duration: 1 * time.Second,
 	Factor:   1.5,
@@ -50,6 +58,13 @@ type Runtime struct {
 	dbProvisionClient cloudprovider.DBClient
 	statusResponseCh  chan private.DataPlaneCentralStatus
 	operatorManager   *operator.ACSOperatorManager
+	statusCollector   *cluster.StatusCollector
+	centralWatcher    *centralWatcher
+	reconcileQueue    *reconcileQueue
+
+	tickersMutex sync.Mutex
+	tickers      []concurrency.RetryTicker
+	stopped      bool
 }
 
 // NewRuntime creates a new runtime
@@ -79,7 +94,7 @@ func NewRuntime(config *config.Config, k8sClient ctrlClient.Client) (*Runtime, e
 	}
 	var dbProvisionClient cloudprovider.DBClient
 	if config.ManagedDB.Enabled {
-		dbProvisionClient, err = awsclient.NewRDSClient(config, auth)
+		dbProvisionClient, err = newDBProvisionClient(config, auth, k8sClient)
 		if err != nil {
 			return nil, fmt.Errorf("creating managed DB provisioning client: %v", err)
 		}
@@ -87,7 +102,20 @@ func NewRuntime(config *config.Config, k8sClient ctrlClient.Client) (*Runtime, e
 
 	operatorManager := operator.NewACSOperatorManager(k8sClient)
 
-	return &Runtime{
+	defaultTenantResources, err := cluster.ParseResourceList(config.ClusterStatus.TenantCPURequest, config.ClusterStatus.TenantMemoryRequest)
+	if err != nil {
+		return nil, fmt.Errorf("parsing default tenant resources: %w", err)
+	}
+	statusCollector := cluster.NewStatusCollector(k8sClient, cluster.StatusCollectorOptions{
+		DefaultTenantResources: defaultTenantResources,
+	})
+
+	var watcher *centralWatcher
+	if config.RuntimeWatchEnabled {
+		watcher = newCentralWatcher(client.PrivateAPI(), config.ClusterID, config.RuntimeWatchTimeout)
+	}
+
+	r := &Runtime{
 		config:            config,
 		k8sClient:         k8sClient,
 		client:            client,
@@ -95,11 +123,61 @@ func NewRuntime(config *config.Config, k8sClient ctrlClient.Client) (*Runtime, e
 		dbProvisionClient: dbProvisionClient,
 		reconcilers:       make(reconcilerRegistry),
 		operatorManager:   operatorManager,
-	}, nil
+		statusCollector:   statusCollector,
+		centralWatcher:    watcher,
+	}
+	r.reconcileQueue = newReconcileQueue(config.RuntimeReconcileParallelism, centralReconcileTimeout, r.reconcileCentral)
+	return r, nil
+}
+
+// newDBProvisionClient creates the DBClient for the configured managed DB type.
+func newDBProvisionClient(cfg *config.Config, auth fleetmanager.Auth, k8sClient ctrlClient.Client) (cloudprovider.DBClient, error) {
+	switch cfg.ManagedDB.Type {
+	case config.ManagedDBTypeAWSRDS:
+		rdsClient, err := awsclient.NewRDSClient(cfg, auth)
+		if err != nil {
+			return nil, fmt.Errorf("creating RDS client: %w", err)
+		}
+		return rdsClient, nil
+	case config.ManagedDBTypeCNPG:
+		return cnpgclient.NewCNPGClient(cfg, k8sClient), nil
+	default:
+		return nil, fmt.Errorf("unknown managed DB type %q", cfg.ManagedDB.Type)
+	}
 }
 
-// Stop stops the runtime
+// Stop stops the runtime. Pending central reconciliations are dropped, in-flight reconciliations are given
+// the configured shutdown timeout to finish before they are cancelled.
 func (r *Runtime) Stop() {
+	r.tickersMutex.Lock()
+	r.stopped = true
+	for _, ticker := range r.tickers {
+		ticker.Stop()
+	}
+	r.tickersMutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.RuntimeShutdownTimeout)
+	defer cancel()
+	if err := r.reconcileQueue.shutdown(ctx); err != nil {
+		glog.Warningf("Stopping central reconciliations: %v", err)
+	}
+	glog.Info("fleetshard runtime stopped")
+}
+
+// startTicker starts the given ticker unless the runtime has already been stopped. The ticker is stopped
+// together with the runtime.
+func (r *Runtime) startTicker(name string, ticker concurrency.RetryTicker) error {
+	r.tickersMutex.Lock()
+	defer r.tickersMutex.Unlock()
+
+	if r.stopped {
+		return nil
+	}
+	if err := ticker.Start(); err != nil {
+		return fmt.Errorf("starting %s ticker: %w", name, err)
+	}
+	r.tickers = append(r.tickers, ticker)
+	return nil
 }
 
 // Start starts the fleetshard runtime and schedules
@@ -118,26 +196,31 @@ func (r *Runtime) Start() error {
 		ClusterName:                       r.config.ClusterName,
 		Environment:                       r.config.Environment,
 		FeatureFlagUpgradeOperatorEnabled: r.config.FeatureFlagUpgradeOperatorEnabled,
+		FleetshardNamespace:               r.config.Namespace,
 	}
 
 	if r.config.FeatureFlagUpgradeOperatorEnabled {
-		err := r.upgradeOperator()
-		if err != nil {
-			err = errors.Wrapf(err, "Upgrading operator")
-			glog.Error(err)
+		operatorTicker := concurrency.NewRetryTicker(func(ctx context.Context) (timeToNextTick time.Duration, err error) {
+			if err := r.reconcileOperators(ctx); err != nil {
+				glog.Error(err)
+				return 0, err
+			}
+			return r.config.OperatorReconcilePeriod, nil
+		}, 10*time.Minute, backoff)
+
+		if err := r.startTicker("operator", operatorTicker); err != nil {
 			return err
 		}
 	}
 
 	ticker := concurrency.NewRetryTicker(func(ctx context.Context) (timeToNextTick time.Duration, err error) {
-		list, _, err := r.client.PrivateAPI().GetCentrals(ctx, r.clusterID)
+		list, nextTick, err := r.listCentrals(ctx)
 		if err != nil {
-			err = errors.Wrapf(err, "retrieving list of managed centrals")
 			glog.Error(err)
 			return 0, err
 		}
 
-		// Start for each Central its own reconciler which can be triggered by sending a central to the receive channel.
+		// Create a reconciler for each new Central and queue the reconciliation of all Centrals.
 		reconciledCentralCountCache = int32(len(list.Items))
 		logger.InfoChangedInt32(&reconciledCentralCountCache, "Received central count changed: received %d centrals", reconciledCentralCountCache)
 		for _, central := range list.Items {
@@ -145,35 +228,93 @@ func (r *Runtime) Start() error {
 				r.reconcilers[central.Id] = centralReconciler.NewCentralReconciler(r.k8sClient, central,
 					r.dbProvisionClient, postgres.InitializeDatabase, reconcilerOpts)
 			}
-
-			reconciler := r.reconcilers[central.Id]
-			go func(reconciler *centralReconciler.CentralReconciler, central private.ManagedCentral) {
-				fleetshardmetrics.MetricsInstance().IncActiveCentralReconcilations()
-				defer fleetshardmetrics.MetricsInstance().DecActiveCentralReconcilations()
-
-				// a 15 minutes timeout should cover the duration of a Reconcile call, including the provisioning of an RDS database
-				ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
-				defer cancel()
-
-				status, err := reconciler.Reconcile(ctx, central)
-				fleetshardmetrics.MetricsInstance().IncCentralReconcilations()
-				r.handleReconcileResult(central, status, err)
-			}(reconciler, central)
+			r.reconcileQueue.add(central, r.reconcilers[central.Id])
 		}
 		fleetshardmetrics.MetricsInstance().SetTotalCentrals(float64(len(r.reconcilers)))
 
 		r.deleteStaleReconcilers(&list)
-		return r.config.RuntimePollPeriod, nil
+		return nextTick, nil
 	}, 10*time.Minute, backoff)
 
-	err := ticker.Start()
+	if err := r.startTicker("central", ticker); err != nil {
+		return err
+	}
+
+	clusterStatusTicker := concurrency.NewRetryTicker(func(ctx context.Context) (timeToNextTick time.Duration, err error) {
+		if err := r.reportClusterStatus(ctx); err != nil {
+			glog.Error(err)
+			return 0, err
+		}
+		return r.config.ClusterStatus.ReportPeriod, nil
+	}, 10*time.Minute, backoff)
+
+	if err := r.startTicker("cluster status", clusterStatusTicker); err != nil {
+		return err
+	}
+
+	if r.config.ManagedDB.Enabled {
+		backupReconciler := backup.NewReconciler(r.client.PrivateAPI(), r.clusterID, r.dbProvisionClient, r.k8sClient,
+			r.config.Namespace)
+		backupTicker := concurrency.NewRetryTicker(func(ctx context.Context) (timeToNextTick time.Duration, err error) {
+			if err := backupReconciler.Reconcile(ctx); err != nil {
+				glog.Error(err)
+				return 0, err
+			}
+			return r.config.ManagedDB.BackupPollPeriod, nil
+		}, 10*time.Minute, backoff)
+
+		if err := r.startTicker("central backup", backupTicker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listCentrals returns the ManagedCentrals of the cluster and the time until they should be listed again.
+// The watch blocks until the centrals change or the watch times out, so the next call can follow right away.
+// If the watch fails, the centrals are polled instead.
+func (r *Runtime) listCentrals(ctx context.Context) (private.ManagedCentralList, time.Duration, error) {
+	if r.centralWatcher != nil {
+		list, err := r.centralWatcher.next(ctx)
+		if err == nil {
+			return list, 0, nil
+		}
+		glog.Warningf("Falling back to polling managed centrals: %v", err)
+	}
+	list, _, err := r.client.PrivateAPI().GetCentrals(ctx, r.clusterID)
 	if err != nil {
-		return fmt.Errorf("starting ticker: %w", err)
+		return list, 0, errors.Wrapf(err, "retrieving list of managed centrals")
 	}
+	return list, r.config.RuntimePollPeriod, nil
+}
 
+// reportClusterStatus collects the capacity and health of the data plane cluster and reports it to fleet-manager.
+func (r *Runtime) reportClusterStatus(ctx context.Context) error {
+	status, err := r.statusCollector.Collect(ctx)
+	if err != nil {
+		fleetshardmetrics.MetricsInstance().IncClusterStatusReportErrors()
+		return errors.Wrap(err, "collecting cluster status")
+	}
+	_, err = r.client.PrivateAPI().UpdateAgentClusterStatus(ctx, r.clusterID, *status)
+	if err != nil {
+		fleetshardmetrics.MetricsInstance().IncClusterStatusReportErrors()
+		return errors.Wrapf(err, "updating status for cluster %s", r.clusterID)
+	}
+	fleetshardmetrics.MetricsInstance().SetClusterRemainingCentralCapacity(float64(status.Resources.RemainingCentralCapacity))
 	return nil
 }
 
+// reconcileCentral is called by the workers of the reconcile queue.
+func (r *Runtime) reconcileCentral(ctx context.Context, req reconcileRequest) {
+	fleetshardmetrics.MetricsInstance().IncActiveCentralReconcilations()
+	defer fleetshardmetrics.MetricsInstance().DecActiveCentralReconcilations()
+
+	status, err := req.reconciler.Reconcile(ctx, req.central)
+	fleetshardmetrics.MetricsInstance().IncCentralReconcilations()
+	r.handleReconcileResult(req.central, status, err)
+}
+
 func (r *Runtime) handleReconcileResult(central private.ManagedCentral, status *private.DataPlaneCentralStatus, err error) {
 	if err != nil {
 		if centralReconciler.IsSkippable(err) {
@@ -212,12 +353,30 @@ func (r *Runtime) deleteStaleReconcilers(list *private.ManagedCentralList) {
 	}
 }
 
-func (r *Runtime) upgradeOperator() error {
-	glog.Infof("Start Operator upgrade")
-	ctx := context.Background()
-	err := r.operatorManager.InstallOrUpgrade(ctx)
+// reconcileOperators installs the ACS operator versions requested by fleet-manager and removes the versions no
+// longer used by any Central.
+func (r *Runtime) reconcileOperators(ctx context.Context) error {
+	agentConfig, _, err := r.client.PrivateAPI().GetDataPlaneClusterAgentConfig(ctx, r.clusterID)
 	if err != nil {
-		return fmt.Errorf("operator upgrade: %w", err)
+		return errors.Wrapf(err, "retrieving agent config for cluster %s", r.clusterID)
+	}
+	if len(agentConfig.Spec.Operators) == 0 {
+		// Without any version the installed operators are left as they are instead of being removed.
+		glog.Warning("Skipping operator reconciliation, fleet-manager did not request any ACS operator version")
+		return nil
+	}
+
+	images := make([]operator.OperatorImage, 0, len(agentConfig.Spec.Operators))
+	versions := make([]string, 0, len(agentConfig.Spec.Operators))
+	for _, op := range agentConfig.Spec.Operators {
+		images = append(images, operator.OperatorImage{Version: op.Version, Image: op.Image})
+		versions = append(versions, op.Version)
+	}
+	if err := r.operatorManager.InstallOrUpgrade(ctx, images); err != nil {
+		return fmt.Errorf("installing ACS operator versions %v: %w", versions, err)
+	}
+	if err := r.operatorManager.RemoveUnusedVersions(ctx, versions); err != nil {
+		return fmt.Errorf("removing unused ACS operator versions: %w", err)
 	}
 	return nil
 }
@@ -11,6 +11,27 @@ type CentralStatus string
 // CentralOperation type
 type CentralOperation string
 
+// CentralMigrationStatus type
+type CentralMigrationStatus string
+
+// CentralBackupRequestType type
+type CentralBackupRequestType string
+
+// CentralBackupRequestStatus type
+type CentralBackupRequestStatus string
+
+// CentralEventType type
+type CentralEventType string
+
+// WebhookEventType type
+type WebhookEventType string
+
+// CentralVersionRolloutStatus type
+type CentralVersionRolloutStatus string
+
+// CentralHealthStatus type
+type CentralHealthStatus string
+
 // CentralRequestStatusAccepted ...
 const (
 	// CentralRequestStatusAccepted - central request status when accepted by central worker
@@ -23,6 +44,9 @@ const (
 	CentralRequestStatusReady CentralStatus = "ready"
 	// CentralRequestStatusFailed - central request failed
 	CentralRequestStatusFailed CentralStatus = "failed"
+	// CentralRequestStatusPendingDeletion - central request status when the deletion was requested, the central is
+	// scaled down and can be restored until its deletion grace period is over
+	CentralRequestStatusPendingDeletion CentralStatus = "pending_deletion"
 	// CentralRequestStatusDeprovision - central request status when to be deleted by central
 	CentralRequestStatusDeprovision CentralStatus = "deprovision"
 	// CentralRequestStatusDeleting - external resources are being deleted for the central request
@@ -33,6 +57,8 @@ const (
 	CentralOperationDelete CentralOperation = "delete"
 	// CentralOperationDeprovision = Central cluster deprovision operations
 	CentralOperationDeprovision CentralOperation = "deprovision"
+	// CentralOperationRestore = Central cluster restore operations
+	CentralOperationRestore CentralOperation = "restore"
 
 	// ObservabilityCanaryPodLabelKey that will be used by the observability operator to scrap metrics
 	ObservabilityCanaryPodLabelKey = "managed-central-canary"
@@ -49,15 +75,126 @@ const (
 	AcceptedCentralMaxRetryDuration = 5 * time.Minute
 )
 
+// CentralMigrationStatusSourcePreparing ...
+const (
+	// CentralMigrationStatusSourcePreparing - the central is moved to a dedicated DB user on the source cluster, so
+	// that the migration target cluster can take over the master credentials of the managed DB
+	CentralMigrationStatusSourcePreparing CentralMigrationStatus = "source_preparing"
+	// CentralMigrationStatusTargetProvisioning - the central is being installed on the migration target cluster while
+	// it keeps serving from the source cluster
+	CentralMigrationStatusTargetProvisioning CentralMigrationStatus = "target_provisioning"
+	// CentralMigrationStatusSwitchingRoutes - the central is ready on the target cluster and its CNAME records are
+	// being switched to the routers of the target cluster
+	CentralMigrationStatusSwitchingRoutes CentralMigrationStatus = "switching_routes"
+	// CentralMigrationStatusSourceTeardown - the central is served from the target cluster and its resources are
+	// being removed from the source cluster
+	CentralMigrationStatusSourceTeardown CentralMigrationStatus = "source_teardown"
+
+	// CentralMigrationRoleSource - role of the data-plane cluster the central is migrated away from
+	CentralMigrationRoleSource = "source"
+	// CentralMigrationRoleTarget - role of the data-plane cluster the central is migrated to
+	CentralMigrationRoleTarget = "target"
+
+	// CentralMigrationSourcePreparedCondition - status condition reported by the migration source cluster once the
+	// central no longer uses the master credentials of its managed DB
+	CentralMigrationSourcePreparedCondition = "MigrationSourcePrepared"
+)
+
+// CentralBackupRequestTypeBackup ...
+const (
+	// CentralBackupRequestTypeBackup - on-demand snapshot of the database of a central
+	CentralBackupRequestTypeBackup CentralBackupRequestType = "backup"
+	// CentralBackupRequestTypeRestore - restore of the database of a central to a new database, either from a
+	// snapshot or to a point in time
+	CentralBackupRequestTypeRestore CentralBackupRequestType = "restore"
+
+	// CentralBackupRequestStatusAccepted - the backup request is waiting to be picked up by fleetshard-sync
+	CentralBackupRequestStatusAccepted CentralBackupRequestStatus = "accepted"
+	// CentralBackupRequestStatusInProgress - fleetshard-sync initiated the backup or restore
+	CentralBackupRequestStatusInProgress CentralBackupRequestStatus = "in_progress"
+	// CentralBackupRequestStatusSucceeded - the snapshot or the restored database is available
+	CentralBackupRequestStatusSucceeded CentralBackupRequestStatus = "succeeded"
+	// CentralBackupRequestStatusFailed - the backup or restore failed
+	CentralBackupRequestStatusFailed CentralBackupRequestStatus = "failed"
+)
+
+// CentralEventTypeCreated ...
+const (
+	// CentralEventTypeCreated - the central was requested. Recorded by the central_requests trigger.
+	CentralEventTypeCreated CentralEventType = "created"
+	// CentralEventTypeStatusChanged - the status of the central changed. Recorded by the central_requests trigger.
+	CentralEventTypeStatusChanged CentralEventType = "status_changed"
+	// CentralEventTypeVersionChanged - a desired, rolled out or actual version of the central changed. Recorded by
+	// the central_requests trigger.
+	CentralEventTypeVersionChanged CentralEventType = "version_changed"
+	// CentralEventTypePlaced - the central was placed on a data-plane cluster. Recorded by the central_requests trigger.
+	CentralEventTypePlaced CentralEventType = "placed"
+	// CentralEventTypeMigrationChanged - the migration phase of the central changed. Recorded by the
+	// central_requests trigger.
+	CentralEventTypeMigrationChanged CentralEventType = "migration_changed"
+	// CentralEventTypeDeleted - the central was removed from the database. Recorded by the central_requests trigger.
+	CentralEventTypeDeleted CentralEventType = "deleted"
+	// CentralEventTypeUserAction - the owner or an organisation admin of the central changed or deleted it
+	CentralEventTypeUserAction CentralEventType = "user_action"
+	// CentralEventTypeAdminAction - an admin changed the central through the admin API
+	CentralEventTypeAdminAction CentralEventType = "admin_action"
+	// CentralEventTypeStatusReport - fleetshard-sync reported a new status of the central
+	CentralEventTypeStatusReport CentralEventType = "status_report"
+)
+
+// WebhookEventTypeCentralReady ...
+const (
+	// WebhookEventTypeCentralReady - the central became ready
+	WebhookEventTypeCentralReady WebhookEventType = "central.ready"
+	// WebhookEventTypeCentralFailed - the central failed
+	WebhookEventTypeCentralFailed WebhookEventType = "central.failed"
+	// WebhookEventTypeCentralDeprovisioning - the deletion of the central was requested
+	WebhookEventTypeCentralDeprovisioning WebhookEventType = "central.deprovisioning"
+	// WebhookEventTypeCentralDeleted - the central was deprovisioned and removed
+	WebhookEventTypeCentralDeleted WebhookEventType = "central.deleted"
+)
+
+// CentralVersionRolloutStatusInProgress ...
+const (
+	// CentralVersionRolloutStatusInProgress - the rollout upgrades the selected centrals wave by wave
+	CentralVersionRolloutStatusInProgress CentralVersionRolloutStatus = "in_progress"
+	// CentralVersionRolloutStatusPaused - the rollout was paused by an admin or because too many centrals of a wave
+	// failed
+	CentralVersionRolloutStatusPaused CentralVersionRolloutStatus = "paused"
+	// CentralVersionRolloutStatusCompleted - all selected centrals were upgraded
+	CentralVersionRolloutStatusCompleted CentralVersionRolloutStatus = "completed"
+	// CentralVersionRolloutStatusCancelled - the rollout was cancelled by an admin
+	CentralVersionRolloutStatusCancelled CentralVersionRolloutStatus = "cancelled"
+)
+
+// CentralHealthStatusHealthy ...
+const (
+	// CentralHealthStatusHealthy - all health conditions reported by fleetshard-sync are met
+	CentralHealthStatusHealthy CentralHealthStatus = "healthy"
+	// CentralHealthStatusDegraded - at least one health condition reported by fleetshard-sync is not met
+	CentralHealthStatusDegraded CentralHealthStatus = "degraded"
+	// CentralHealthStatusUnknown - fleetshard-sync could not determine whether the health conditions are met
+	CentralHealthStatusUnknown CentralHealthStatus = "unknown"
+)
+
+// WebhookEventTypes are all event types that can be subscribed to
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventTypeCentralReady,
+	WebhookEventTypeCentralFailed,
+	WebhookEventTypeCentralDeprovisioning,
+	WebhookEventTypeCentralDeleted,
+}
+
 // ordinals - Used to decide if a status comes after or before a given state
 var ordinals = map[string]int{
-	CentralRequestStatusAccepted.String():     0,
-	CentralRequestStatusPreparing.String():    10,
-	CentralRequestStatusProvisioning.String(): 20,
-	CentralRequestStatusReady.String():        30,
-	CentralRequestStatusDeprovision.String():  40,
-	CentralRequestStatusDeleting.String():     50,
-	CentralRequestStatusFailed.String():       500,
+	CentralRequestStatusAccepted.String():        0,
+	CentralRequestStatusPreparing.String():       10,
+	CentralRequestStatusProvisioning.String():    20,
+	CentralRequestStatusReady.String():           30,
+	CentralRequestStatusPendingDeletion.String(): 35,
+	CentralRequestStatusDeprovision.String():     40,
+	CentralRequestStatusDeleting.String():        50,
+	CentralRequestStatusFailed.String():          500,
 }
 
 // NamespaceLabels contains labels that indicates if a namespace is a managed application services namespace.
@@ -71,6 +208,41 @@ func (k CentralOperation) String() string {
 	return string(k)
 }
 
+// String ...
+func (m CentralMigrationStatus) String() string {
+	return string(m)
+}
+
+// String ...
+func (t CentralBackupRequestType) String() string {
+	return string(t)
+}
+
+// String ...
+func (s CentralBackupRequestStatus) String() string {
+	return string(s)
+}
+
+// String ...
+func (t CentralEventType) String() string {
+	return string(t)
+}
+
+// String ...
+func (t WebhookEventType) String() string {
+	return string(t)
+}
+
+// String ...
+func (s CentralVersionRolloutStatus) String() string {
+	return string(s)
+}
+
+// String ...
+func (s CentralHealthStatus) String() string {
+	return string(s)
+}
+
 // String CentralStatus Methods
 func (k CentralStatus) String() string {
 	return string(k)
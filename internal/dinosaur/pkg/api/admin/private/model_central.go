@@ -48,6 +48,17 @@ type Central struct {
 	RoutesCreated                 bool                 `json:"routes_created,omitempty"`
 	ClusterId                     string               `json:"cluster_id,omitempty"`
 	Namespace                     string               `json:"namespace,omitempty"`
-	Central                       CentralSpec          `json:"central,omitempty"`
-	Scanner                       ScannerSpec          `json:"scanner,omitempty"`
+	// Values: [source_preparing, target_provisioning, switching_routes, source_teardown]. Empty if the Central is not being migrated.
+	MigrationStatus          string             `json:"migration_status,omitempty"`
+	MigrationTargetClusterId string             `json:"migration_target_cluster_id,omitempty"`
+	MaintenanceWindow        *MaintenanceWindow `json:"maintenance_window,omitempty"`
+	// Central version emitted to the data plane. Lags behind desired_central_version until the maintenance window of the Central starts.
+	RolledOutCentralVersion string      `json:"rolled_out_central_version,omitempty"`
+	Central                 CentralSpec `json:"central,omitempty"`
+	Scanner                 ScannerSpec `json:"scanner,omitempty"`
+	// User-defined labels of the Central instance.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Whether the Central instance is protected against deletion.
+	DeletionProtection bool           `json:"deletion_protection,omitempty"`
+	Health             *CentralHealth `json:"health,omitempty"`
 }
@@ -12,9 +12,10 @@ package private
 
 // CentralList struct for CentralList
 type CentralList struct {
-	Kind  string    `json:"kind"`
-	Page  int32     `json:"page"`
-	Size  int32     `json:"size"`
-	Total int32     `json:"total"`
-	Items []Central `json:"items"`
+	Kind          string    `json:"kind"`
+	Page          int32     `json:"page"`
+	Size          int32     `json:"size"`
+	Total         int32     `json:"total"`
+	NextPageToken string    `json:"next_page_token,omitempty"`
+	Items         []Central `json:"items"`
 }
@@ -27,14 +27,15 @@ type CentralRequest struct {
 	CloudAccountId string `json:"cloud_account_id,omitempty"`
 	MultiAz        bool   `json:"multi_az"`
 	// Values will be regions of specific cloud provider. For example: us-east-1 for AWS
-	Region         string    `json:"region,omitempty"`
-	Owner          string    `json:"owner,omitempty"`
-	Name           string    `json:"name,omitempty"`
-	CentralUIURL   string    `json:"centralUIURL,omitempty"`
-	CentralDataURL string    `json:"centralDataURL,omitempty"`
-	CreatedAt      time.Time `json:"created_at,omitempty"`
-	UpdatedAt      time.Time `json:"updated_at,omitempty"`
-	FailedReason   string    `json:"failed_reason,omitempty"`
-	Version        string    `json:"version,omitempty"`
-	InstanceType   string    `json:"instance_type,omitempty"`
+	Region            string             `json:"region,omitempty"`
+	Owner             string             `json:"owner,omitempty"`
+	Name              string             `json:"name,omitempty"`
+	CentralUIURL      string             `json:"centralUIURL,omitempty"`
+	CentralDataURL    string             `json:"centralDataURL,omitempty"`
+	CreatedAt         time.Time          `json:"created_at,omitempty"`
+	UpdatedAt         time.Time          `json:"updated_at,omitempty"`
+	FailedReason      string             `json:"failed_reason,omitempty"`
+	Version           string             `json:"version,omitempty"`
+	InstanceType      string             `json:"instance_type,omitempty"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
 }
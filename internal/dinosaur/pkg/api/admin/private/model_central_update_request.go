@@ -12,8 +12,10 @@ package private
 
 // CentralUpdateRequest struct for CentralUpdateRequest
 type CentralUpdateRequest struct {
-	CentralOperatorVersion string      `json:"central_operator_version,omitempty"`
-	CentralVersion         string      `json:"central_version,omitempty"`
-	Central                CentralSpec `json:"central,omitempty"`
-	Scanner                ScannerSpec `json:"scanner,omitempty"`
+	CentralOperatorVersion string `json:"central_operator_version,omitempty"`
+	CentralVersion         string `json:"central_version,omitempty"`
+	// Roll out central_version immediately instead of waiting for the maintenance window of the Central, e.g. for emergency CVE fixes.
+	SkipMaintenanceWindow bool        `json:"skip_maintenance_window,omitempty"`
+	Central               CentralSpec `json:"central,omitempty"`
+	Scanner               ScannerSpec `json:"scanner,omitempty"`
 }
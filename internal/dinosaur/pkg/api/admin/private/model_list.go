@@ -12,8 +12,9 @@ package private
 
 // List struct for List
 type List struct {
-	Kind  string `json:"kind"`
-	Page  int32  `json:"page"`
-	Size  int32  `json:"size"`
-	Total int32  `json:"total"`
+	Kind          string `json:"kind"`
+	Page          int32  `json:"page"`
+	Size          int32  `json:"size"`
+	Total         int32  `json:"total"`
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
@@ -0,0 +1,30 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// CentralBackupRequest is an on-demand backup of the managed DB of a Central, or a restore of the managed DB of a
+// Central to a new database. It is carried out by fleetshard-sync on the data-plane cluster of the Central.
+type CentralBackupRequest struct {
+	api.Meta
+	CentralID string `json:"central_id" gorm:"index"`
+	ClusterID string `json:"cluster_id" gorm:"index"`
+	// Type values: [backup, restore]
+	Type string `json:"type"`
+	// Status values: [accepted, in_progress, succeeded, failed]
+	Status string `json:"status" gorm:"index"`
+	// SnapshotID is the snapshot created by a backup, or the snapshot a restore starts from.
+	SnapshotID string `json:"snapshot_id"`
+	// RestoreTime is the point in time a restore without snapshot recovers the database to.
+	// The latest restorable time is used if it is not set.
+	RestoreTime *time.Time `json:"restore_time"`
+	// TargetDatabaseID is the ID of the new database created by a restore.
+	TargetDatabaseID string `json:"target_database_id"`
+	FailedReason     string `json:"failed_reason"`
+}
+
+// CentralBackupRequestList ...
+type CentralBackupRequestList []*CentralBackupRequest
@@ -0,0 +1,21 @@
+package dbapi
+
+import (
+	"time"
+)
+
+// CentralEvent is an entry in the append-only lifecycle history of a Central. Changes of the central_requests table
+// are recorded by a database trigger, admin actions and fleetshard-sync status reports by fleet-manager.
+type CentralEvent struct {
+	ID        uint64 `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time
+	CentralID string `json:"central_id" gorm:"index"`
+	// Type values: see constants.CentralEventTypeCreated
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	// Actor is the user, admin or component that caused the event.
+	Actor string `json:"actor"`
+}
+
+// CentralEventList ...
+type CentralEventList []*CentralEvent
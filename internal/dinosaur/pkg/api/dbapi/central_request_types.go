@@ -32,6 +32,9 @@ type CentralRequest struct {
 	MultiAZ bool `json:"multi_az"`
 	// Name of the ACS instance.
 	Name string `json:"name" gorm:"index"`
+	// DisplayName is the human-readable name of the ACS instance. Unlike Name, it can be changed by the owner of the
+	// instance, since Name identifies the Central on the data plane. It is empty if it was never set.
+	DisplayName string `json:"display_name"`
 	// Status is the lifecycle status of the Central request. See constants.CentralRequestStatusAccepted to see
 	// valid statuses.
 	Status string `json:"status" gorm:"index"`
@@ -82,6 +85,9 @@ type CentralRequest struct {
 	RoutesCreationID string `json:"routes_creation_id"`
 	// DeletionTimestamp stores the timestamp of the DELETE api call for the resource.
 	DeletionTimestamp *time.Time `json:"deletionTimestamp"`
+	// PendingDeletionUntil is the end of the deletion grace period of a Central in status pending_deletion. Until
+	// then the Central can be restored, afterwards it is deprovisioned.
+	PendingDeletionUntil *time.Time `json:"pending_deletion_until"`
 
 	// Internal will be set for instances created by internal services, such as the probe service.
 	// If Internal is set to true, telemetry will be disabled for this particular instance.
@@ -89,6 +95,45 @@ type CentralRequest struct {
 	// request (see pkg/handlers/dinosaur.go).
 	Internal bool `json:"internal"`
 
+	// MigrationStatus is the phase of an ongoing migration of the Central to another data-plane cluster.
+	// It is empty if the Central is not being migrated. See constants.CentralMigrationStatusTargetProvisioning
+	// to see valid phases.
+	MigrationStatus string `json:"migration_status"`
+	// MigrationSourceClusterID is the data-plane cluster ID the Central is migrated away from.
+	MigrationSourceClusterID string `json:"migration_source_cluster_id" gorm:"index"`
+	// MigrationTargetClusterID is the data-plane cluster ID the Central is migrated to.
+	MigrationTargetClusterID string `json:"migration_target_cluster_id" gorm:"index"`
+
+	// MaintenanceWindowDay is the day of the week of the weekly maintenance window of the Central, e.g. "sunday".
+	// Upgrades to DesiredCentralVersion and DesiredCentralOperatorVersion are only rolled out within the maintenance
+	// window. It is empty if the Central has no maintenance window, in which case upgrades are rolled out right away.
+	MaintenanceWindowDay string `json:"maintenance_window_day"`
+	// MaintenanceWindowStartTime is the start time of the maintenance window in UTC, formatted as "15:04".
+	MaintenanceWindowStartTime string `json:"maintenance_window_start_time"`
+	// MaintenanceWindowDurationHours is the length of the maintenance window in hours.
+	MaintenanceWindowDurationHours int32 `json:"maintenance_window_duration_hours"`
+	// RolledOutCentralVersion is the Central version rolled out to the data plane for Centrals with a maintenance
+	// window. It is set to DesiredCentralVersion within the maintenance window.
+	RolledOutCentralVersion string `json:"rolled_out_central_version"`
+	// RolledOutCentralOperatorVersion is the ACS operator version rolled out to the data plane for Centrals with a
+	// maintenance window. It is set to DesiredCentralOperatorVersion within the maintenance window.
+	RolledOutCentralOperatorVersion string `json:"rolled_out_central_operator_version"`
+
+	// Version is incremented on every update of the CentralRequest. Updates of a CentralRequest with a version only
+	// succeed if the version in the database is unchanged, so that concurrent updates do not overwrite each other.
+	// It is returned to API clients as ETag.
+	Version int64 `json:"version" gorm:"not null;default:1"`
+
+	// Labels are the user-defined key/value pairs of the Central, e.g. env=prod. They are stored as JSON object,
+	// see GetLabels and SetLabels, and propagated to the data plane as annotations for cost attribution.
+	Labels api.JSON `json:"labels" gorm:"type:jsonb;not null;default:'{}'"`
+	// DeletionProtection prevents the deletion of the Central through the API and its deprovisioning by the
+	// denied owner and expiration reconcilers until it is cleared.
+	DeletionProtection bool `json:"deletion_protection" gorm:"not null;default:false"`
+	// Health is the health of the Central as last reported by fleetshard-sync. It is stored as JSON object, see
+	// GetHealth and SetHealth, and is empty until fleetshard-sync reported the health of the Central.
+	Health api.JSON `json:"health" gorm:"type:jsonb"`
+
 	// All we need to integrate Central with an IdP.
 	AuthConfig
 }
@@ -155,6 +200,77 @@ func (k *CentralRequest) SetRoutes(routes []DataPlaneCentralRoute) error {
 	return nil
 }
 
+// GetDisplayName returns the display name of the Central, which defaults to its name.
+func (k *CentralRequest) GetDisplayName() string {
+	if k.DisplayName != "" {
+		return k.DisplayName
+	}
+	return k.Name
+}
+
+// GetLabels returns the user-defined labels of the Central.
+func (k *CentralRequest) GetLabels() (map[string]string, error) {
+	labels := map[string]string{}
+	if len(k.Labels) == 0 {
+		return labels, nil
+	}
+	if err := json.Unmarshal(k.Labels, &labels); err != nil {
+		return nil, fmt.Errorf("unmarshalling labels from JSON: %w", err)
+	}
+	return labels, nil
+}
+
+// SetLabels replaces the user-defined labels of the Central.
+func (k *CentralRequest) SetLabels(labels map[string]string) error {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	l, err := json.Marshal(labels)
+	if err != nil {
+		return fmt.Errorf("marshalling labels into JSON: %w", err)
+	}
+	k.Labels = l
+	return nil
+}
+
+// CentralHealth is the health of a Central derived from the health conditions reported by fleetshard-sync.
+type CentralHealth struct {
+	// Status is one of constants.CentralHealthStatusHealthy, constants.CentralHealthStatusDegraded and
+	// constants.CentralHealthStatusUnknown.
+	Status     string                   `json:"status"`
+	Conditions []CentralHealthCondition `json:"conditions"`
+}
+
+// CentralHealthCondition is the result of a single health check of a Central, e.g. the availability of Scanner.
+type CentralHealthCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// GetHealth returns the health of the Central, or nil if fleetshard-sync did not report it yet.
+func (k *CentralRequest) GetHealth() (*CentralHealth, error) {
+	if len(k.Health) == 0 {
+		return nil, nil
+	}
+	health := &CentralHealth{}
+	if err := json.Unmarshal(k.Health, health); err != nil {
+		return nil, fmt.Errorf("unmarshalling health from JSON: %w", err)
+	}
+	return health, nil
+}
+
+// SetHealth replaces the health of the Central.
+func (k *CentralRequest) SetHealth(health CentralHealth) error {
+	h, err := json.Marshal(health)
+	if err != nil {
+		return fmt.Errorf("marshalling health into JSON: %w", err)
+	}
+	k.Health = h
+	return nil
+}
+
 // GetUIHost returns host for CLI/GUI/API connections
 func (k *CentralRequest) GetUIHost() string {
 	if k.Host == "" {
@@ -220,3 +336,35 @@ func (k *CentralRequest) SetScannerSpec(scannerSpec *ScannerSpec) error {
 	}
 	return nil
 }
+
+// GetMaintenanceWindow returns the maintenance window of the Central, or nil if it has none.
+func (k *CentralRequest) GetMaintenanceWindow() (*MaintenanceWindow, error) {
+	if k.MaintenanceWindowDay == "" {
+		return nil, nil
+	}
+	return ParseMaintenanceWindow(k.MaintenanceWindowDay, k.MaintenanceWindowStartTime, k.MaintenanceWindowDurationHours)
+}
+
+// GetRolledOutCentralVersion returns the Central version the data plane should run. For Centrals with a
+// maintenance window, this is the version last rolled out within the window.
+func (k *CentralRequest) GetRolledOutCentralVersion() string {
+	if k.MaintenanceWindowDay == "" || k.RolledOutCentralVersion == "" {
+		return k.DesiredCentralVersion
+	}
+	return k.RolledOutCentralVersion
+}
+
+// GetRolledOutCentralOperatorVersion returns the ACS operator version the data plane should use. For Centrals
+// with a maintenance window, this is the version last rolled out within the window.
+func (k *CentralRequest) GetRolledOutCentralOperatorVersion() string {
+	if k.MaintenanceWindowDay == "" || k.RolledOutCentralOperatorVersion == "" {
+		return k.DesiredCentralOperatorVersion
+	}
+	return k.RolledOutCentralOperatorVersion
+}
+
+// IsRolledOut returns true if the desired Central and ACS operator versions are rolled out to the data plane.
+func (k *CentralRequest) IsRolledOut() bool {
+	return k.GetRolledOutCentralVersion() == k.DesiredCentralVersion &&
+		k.GetRolledOutCentralOperatorVersion() == k.DesiredCentralOperatorVersion
+}
@@ -0,0 +1,66 @@
+package dbapi
+
+import (
+	"strings"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// CentralVersionRollout is a progressive rollout of a Central version to the Centrals matching its selector.
+// The rollout sets the desired Central version of one wave of at most BatchSize Centrals at a time and starts the
+// next wave once the Centrals of the current wave run the new version and passed the soak time.
+type CentralVersionRollout struct {
+	api.Meta
+	CentralVersion string `json:"central_version"`
+	// InstanceType, Region, ClusterID and OrganisationIDs select the Centrals of the rollout. Empty values match
+	// all Centrals.
+	InstanceType string `json:"instance_type"`
+	Region       string `json:"region"`
+	ClusterID    string `json:"cluster_id"`
+	// OrganisationIDs is a comma-separated list of organisation IDs.
+	OrganisationIDs string `json:"organisation_ids"`
+	BatchSize       int    `json:"batch_size"`
+	// SoakTime is the time the Centrals of a wave must stay healthy before the next wave starts.
+	SoakTime time.Duration `json:"soak_time"`
+	// WaveTimeout is the time the Centrals of a wave have to become healthy. Centrals which are not healthy
+	// after the wave timeout count as failed.
+	WaveTimeout time.Duration `json:"wave_timeout"`
+	// MaxFailures is the number of failed Centrals of a wave which are tolerated. The rollout is paused once more
+	// Centrals of a wave failed.
+	MaxFailures int `json:"max_failures"`
+	// Status values: [in_progress, paused, completed, cancelled]
+	Status      string `json:"status" gorm:"index"`
+	PauseReason string `json:"pause_reason"`
+	CurrentWave int    `json:"current_wave"`
+	// WaveCentralIDs is a comma-separated list of the IDs of the Centrals of the current wave. It is empty between
+	// two waves.
+	WaveCentralIDs string     `json:"wave_central_ids"`
+	WaveStartedAt  *time.Time `json:"wave_started_at"`
+	// WaveHealthyAt is the time all Centrals of the current wave became healthy, or the wave timed out. The soak
+	// time starts at this time.
+	WaveHealthyAt    *time.Time `json:"wave_healthy_at"`
+	UpgradedCentrals int        `json:"upgraded_centrals"`
+	FailedCentrals   int        `json:"failed_centrals"`
+	CreatedBy        string     `json:"created_by"`
+}
+
+// CentralVersionRolloutList ...
+type CentralVersionRolloutList []*CentralVersionRollout
+
+// GetOrganisationIDs returns the organisation IDs selected by the rollout.
+func (r *CentralVersionRollout) GetOrganisationIDs() []string {
+	return splitIDs(r.OrganisationIDs)
+}
+
+// GetWaveCentralIDs returns the IDs of the Centrals of the current wave.
+func (r *CentralVersionRollout) GetWaveCentralIDs() []string {
+	return splitIDs(r.WaveCentralIDs)
+}
+
+func splitIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
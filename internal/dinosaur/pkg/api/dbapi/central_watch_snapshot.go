@@ -0,0 +1,17 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// CentralWatchSnapshot is the set of Centrals assigned to a data-plane cluster at a watch resource version.
+// Snapshots are shared by all fleet-manager replicas, so that a watch can be resumed on any replica.
+type CentralWatchSnapshot struct {
+	ClusterID       string    `json:"cluster_id" gorm:"primaryKey"`
+	ResourceVersion string    `json:"resource_version" gorm:"primaryKey"`
+	CreatedAt       time.Time `gorm:"index"`
+	// Centrals maps the IDs of the Centrals to their resource versions.
+	Centrals api.JSON `json:"centrals" gorm:"type:jsonb"`
+}
@@ -6,6 +6,8 @@ import "github.com/stackrox/acs-fleet-manager/pkg/api"
 type DataPlaneClusterStatus struct {
 	Conditions                        []DataPlaneClusterStatusCondition
 	AvailableDinosaurOperatorVersions []api.CentralOperatorVersion
+	// Capacity is nil if the data plane did not report its compute capacity
+	Capacity *api.ClusterCapacity
 }
 
 // DataPlaneClusterStatusCondition ...
@@ -24,7 +26,14 @@ type DataPlaneClusterConfigObservability struct {
 	Tag         string
 }
 
+// DataPlaneClusterConfigCentralOperator is an ACS operator version to be installed on the data plane cluster.
+type DataPlaneClusterConfigCentralOperator struct {
+	Version string
+	Image   string
+}
+
 // DataPlaneClusterConfig ...
 type DataPlaneClusterConfig struct {
-	Observability DataPlaneClusterConfigObservability
+	Observability    DataPlaneClusterConfigObservability
+	CentralOperators []DataPlaneClusterConfigCentralOperator
 }
@@ -0,0 +1,26 @@
+package dbapi
+
+import (
+	"time"
+)
+
+// IdempotencyKey stores the response of a request that was sent with an Idempotency-Key header, so that retries
+// of the request return the original response instead of being executed again.
+type IdempotencyKey struct {
+	ID        uint64 `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time
+	// Scope is the organisation, or the user if the user is not part of an organisation, that sent the request.
+	Scope    string `json:"scope" gorm:"uniqueIndex:uix_idempotency_keys_scope_endpoint_key"`
+	Endpoint string `json:"endpoint" gorm:"uniqueIndex:uix_idempotency_keys_scope_endpoint_key"`
+	Key      string `json:"key" gorm:"uniqueIndex:uix_idempotency_keys_scope_endpoint_key"`
+	// RequestHash is the SHA-256 of the request body. A key may only be retried with the same body.
+	RequestHash string `json:"request_hash"`
+	// StatusCode is zero while the request is being processed.
+	StatusCode   int    `json:"status_code"`
+	ResponseBody string `json:"response_body"`
+}
+
+// InProgress returns true if the response of the request was not recorded yet.
+func (k *IdempotencyKey) InProgress() bool {
+	return k.StatusCode == 0
+}
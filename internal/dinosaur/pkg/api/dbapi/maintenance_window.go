@@ -0,0 +1,63 @@
+package dbapi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	maintenanceWindowTimeLayout = "15:04"
+	// MaxMaintenanceWindowDurationHours is the maximum length of a maintenance window.
+	MaxMaintenanceWindowDurationHours = 24
+)
+
+var weekdays = map[string]time.Weekday{}
+
+func init() {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		weekdays[strings.ToLower(day.String())] = day
+	}
+}
+
+// MaintenanceWindow is a weekly time window in UTC in which upgrades of a Central are rolled out.
+type MaintenanceWindow struct {
+	Day      time.Weekday
+	Start    time.Duration // offset from midnight
+	Duration time.Duration
+}
+
+// ParseMaintenanceWindow parses the maintenance window starting at the given day of the week, e.g. "sunday",
+// and time in UTC, e.g. "22:30", and lasting for the given number of hours.
+func ParseMaintenanceWindow(day string, startTime string, durationHours int32) (*MaintenanceWindow, error) {
+	weekday, ok := weekdays[strings.ToLower(day)]
+	if !ok {
+		return nil, fmt.Errorf("invalid maintenance window day %q, expected a day of the week such as %q", day, "sunday")
+	}
+	start, err := time.Parse(maintenanceWindowTimeLayout, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window start time %q, expected the format %q", startTime, "HH:MM")
+	}
+	if durationHours < 1 || durationHours > MaxMaintenanceWindowDurationHours {
+		return nil, fmt.Errorf("invalid maintenance window duration of %d hours, expected between 1 and %d hours",
+			durationHours, MaxMaintenanceWindowDurationHours)
+	}
+	return &MaintenanceWindow{
+		Day:      weekday,
+		Start:    time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		Duration: time.Duration(durationHours) * time.Hour,
+	}, nil
+}
+
+// Contains returns whether the given time is within the maintenance window.
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	daysSinceWindowDay := (int(t.Weekday()) - int(w.Day) + 7) % 7
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	// The most recent start of the window at or before t.
+	start := midnight.AddDate(0, 0, -daysSinceWindowDay).Add(w.Start)
+	if start.After(t) {
+		start = start.AddDate(0, 0, -7)
+	}
+	return t.Sub(start) < w.Duration
+}
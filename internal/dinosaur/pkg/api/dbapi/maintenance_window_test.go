@@ -0,0 +1,82 @@
+package dbapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name          string
+		day           string
+		startTime     string
+		durationHours int32
+		wantErr       bool
+	}{
+		{name: "valid window", day: "sunday", startTime: "22:30", durationHours: 4},
+		{name: "day is case insensitive", day: "Saturday", startTime: "00:00", durationHours: 24},
+		{name: "invalid day", day: "someday", startTime: "22:30", durationHours: 4, wantErr: true},
+		{name: "invalid start time", day: "sunday", startTime: "25:00", durationHours: 4, wantErr: true},
+		{name: "zero duration", day: "sunday", startTime: "22:30", durationHours: 0, wantErr: true},
+		{name: "duration exceeding a day", day: "sunday", startTime: "22:30", durationHours: 25, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseMaintenanceWindow(tc.day, tc.startTime, tc.durationHours)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindow_Contains(t *testing.T) {
+	// Sunday 22:30 UTC until Monday 02:30 UTC.
+	window, err := ParseMaintenanceWindow("sunday", "22:30", 4)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		time string
+		want bool
+	}{
+		{name: "before the window", time: "2023-05-07T22:29:59Z", want: false},
+		{name: "start of the window", time: "2023-05-07T22:30:00Z", want: true},
+		{name: "window spanning midnight", time: "2023-05-08T01:00:00Z", want: true},
+		{name: "end of the window", time: "2023-05-08T02:30:00Z", want: false},
+		{name: "other day of the week", time: "2023-05-10T23:00:00Z", want: false},
+		{name: "window in another time zone", time: "2023-05-08T00:30:00+02:00", want: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			at, err := time.Parse(time.RFC3339, tc.time)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, window.Contains(at))
+		})
+	}
+}
+
+func TestCentralRequest_GetRolledOutVersions(t *testing.T) {
+	central := &CentralRequest{
+		DesiredCentralVersion:         "4.0.0",
+		DesiredCentralOperatorVersion: "4.0.0",
+	}
+	assert.Equal(t, "4.0.0", central.GetRolledOutCentralVersion())
+	assert.Equal(t, "4.0.0", central.GetRolledOutCentralOperatorVersion())
+	assert.True(t, central.IsRolledOut())
+
+	central.MaintenanceWindowDay = "sunday"
+	central.RolledOutCentralVersion = "3.74.0"
+	central.RolledOutCentralOperatorVersion = "3.74.1"
+	assert.Equal(t, "3.74.0", central.GetRolledOutCentralVersion())
+	assert.Equal(t, "3.74.1", central.GetRolledOutCentralOperatorVersion())
+	assert.False(t, central.IsRolledOut())
+
+	central.RolledOutCentralVersion = "4.0.0"
+	assert.False(t, central.IsRolledOut(), "the operator version is still held back")
+}
@@ -0,0 +1,33 @@
+package dbapi
+
+import (
+	"time"
+)
+
+// OrganisationQuotaOverride is the maximum number of standard instances of an organisation, which takes precedence over
+// the quota management list configuration file.
+type OrganisationQuotaOverride struct {
+	OrganisationID      string `json:"organisation_id" gorm:"primaryKey"`
+	MaxAllowedInstances int    `json:"max_allowed_instances"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// OrganisationQuotaOverrideList ...
+type OrganisationQuotaOverrideList []*OrganisationQuotaOverride
+
+// OrganisationQuotaOverrideChange is an entry in the audit history of the quota overrides of an organisation.
+type OrganisationQuotaOverrideChange struct {
+	ID             uint64 `json:"id" gorm:"primarykey"`
+	CreatedAt      time.Time
+	OrganisationID string `json:"organisation_id" gorm:"index"`
+	// Action values: [created, updated, deleted]
+	Action                      string `json:"action"`
+	MaxAllowedInstances         int    `json:"max_allowed_instances"`
+	PreviousMaxAllowedInstances int    `json:"previous_max_allowed_instances"`
+	// ChangedBy is the username of the admin who made the change.
+	ChangedBy string `json:"changed_by"`
+}
+
+// OrganisationQuotaOverrideChangeList ...
+type OrganisationQuotaOverrideChangeList []*OrganisationQuotaOverrideChange
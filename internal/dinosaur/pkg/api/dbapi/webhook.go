@@ -0,0 +1,57 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// WebhookSubscription is an endpoint of an organisation that receives signed notifications about state changes
+// of the Centrals of the organisation.
+type WebhookSubscription struct {
+	api.Meta
+	OrganisationID string `json:"organisation_id" gorm:"index"`
+	Owner          string `json:"owner"`
+	URL            string `json:"url"`
+	// Secret is the key of the HMAC signature of the notifications.
+	Secret string `json:"-"`
+	// EventTypes is a comma-separated list of the subscribed event types. Empty subscribes to all event types.
+	// Values: see constants.WebhookEventTypeCentralReady
+	EventTypes string `json:"event_types"`
+}
+
+// WebhookSubscriptionList ...
+type WebhookSubscriptionList []*WebhookSubscription
+
+// WebhookDelivery is a pending notification of a webhook subscription. Deliveries are created by a trigger on the
+// central_requests table and removed once they were delivered or moved to the dead letters.
+type WebhookDelivery struct {
+	ID             uint64 `json:"id" gorm:"primarykey"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	SubscriptionID string `json:"subscription_id" gorm:"index"`
+	EventType      string `json:"event_type"`
+	CentralID      string `json:"central_id"`
+	// Payload is the JSON body of the notification.
+	Payload       string    `json:"payload"`
+	Attempts      int       `json:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at" gorm:"index"`
+	LastError     string    `json:"last_error"`
+}
+
+// WebhookDeliveryList ...
+type WebhookDeliveryList []*WebhookDelivery
+
+// WebhookDeadLetter is a notification that could not be delivered within the maximum number of attempts.
+type WebhookDeadLetter struct {
+	// ID is the ID of the delivery.
+	ID             uint64 `json:"id" gorm:"primarykey;autoIncrement:false"`
+	CreatedAt      time.Time
+	EnqueuedAt     time.Time `json:"enqueued_at"`
+	SubscriptionID string    `json:"subscription_id" gorm:"index"`
+	EventType      string    `json:"event_type"`
+	CentralID      string    `json:"central_id"`
+	Payload        string    `json:"payload"`
+	Attempts       int       `json:"attempts"`
+	LastError      string    `json:"last_error"`
+}
@@ -15,4 +15,5 @@ type DataPlaneClusterUpdateStatusRequest struct {
 	// The cluster data plane conditions
 	Conditions      []DataPlaneClusterUpdateStatusRequestConditions      `json:"conditions,omitempty"`
 	CentralOperator []DataPlaneClusterUpdateStatusRequestCentralOperator `json:"centralOperator,omitempty"`
+	Resources       DataPlaneClusterUpdateStatusRequestResources         `json:"resources,omitempty"`
 }
@@ -13,4 +13,6 @@ package private
 // DataplaneClusterAgentConfigSpec Data plane cluster agent spec
 type DataplaneClusterAgentConfigSpec struct {
 	Observability DataplaneClusterAgentConfigSpecObservability `json:"observability,omitempty"`
+	// The ACS operator versions to install on the data plane cluster
+	Operators []DataplaneClusterAgentConfigSpecOperators `json:"operators,omitempty"`
 }
@@ -12,9 +12,11 @@ package private
 
 // ManagedCentralAllOfMetadata struct for ManagedCentralAllOfMetadata
 type ManagedCentralAllOfMetadata struct {
-	Name              string                                 `json:"name,omitempty"`
-	Namespace         string                                 `json:"namespace,omitempty"`
-	Internal          bool                                   `json:"internal,omitempty"`
-	Annotations       ManagedCentralAllOfMetadataAnnotations `json:"annotations,omitempty"`
-	DeletionTimestamp string                                 `json:"deletionTimestamp,omitempty"`
+	Name        string                                 `json:"name,omitempty"`
+	Namespace   string                                 `json:"namespace,omitempty"`
+	Internal    bool                                   `json:"internal,omitempty"`
+	Annotations ManagedCentralAllOfMetadataAnnotations `json:"annotations,omitempty"`
+	// User-defined labels of the Central, propagated as annotations of the tenant namespace and the Central resource.
+	Labels            map[string]string `json:"labels,omitempty"`
+	DeletionTimestamp string            `json:"deletionTimestamp,omitempty"`
 }
@@ -19,4 +19,5 @@ type ManagedCentralAllOfSpec struct {
 	Versions     ManagedCentralVersions              `json:"versions,omitempty"`
 	Central      ManagedCentralAllOfSpecCentral      `json:"central,omitempty"`
 	Scanner      ManagedCentralAllOfSpecScanner      `json:"scanner,omitempty"`
+	Migration    ManagedCentralAllOfSpecMigration    `json:"migration,omitempty"`
 }
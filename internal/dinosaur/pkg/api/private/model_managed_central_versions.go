@@ -14,4 +14,6 @@ package private
 type ManagedCentralVersions struct {
 	DesiredVersion string `json:"desiredVersion,omitempty"`
 	ActualVersion  string `json:"actualVersion,omitempty"`
+	// The version of the ACS operator which reconciles the Central
+	OperatorVersion string `json:"operatorVersion,omitempty"`
 }
@@ -12,7 +12,9 @@ package private
 
 // WatchEvent struct for WatchEvent
 type WatchEvent struct {
-	Type   string                  `json:"type"`
-	Error  Error                   `json:"error,omitempty"`
-	Object *map[string]interface{} `json:"object,omitempty"`
+	Type  string `json:"type"`
+	Error Error  `json:"error,omitempty"`
+	// The resource version of the object. Empty for DELETED events.
+	ResourceVersion string                  `json:"resource_version,omitempty"`
+	Object          *map[string]interface{} `json:"object,omitempty"`
 }
@@ -19,7 +19,7 @@ type CentralRequest struct {
 	Id   string `json:"id,omitempty"`
 	Kind string `json:"kind,omitempty"`
 	Href string `json:"href,omitempty"`
-	// Values: [accepted, preparing, provisioning, ready, failed, deprovision, deleting]
+	// Values: [accepted, preparing, provisioning, ready, failed, pending_deletion, deprovision, deleting]
 	Status string `json:"status,omitempty"`
 	// Name of Cloud used to deploy. For example AWS
 	CloudProvider string `json:"cloud_provider,omitempty"`
@@ -27,14 +27,24 @@ type CentralRequest struct {
 	CloudAccountId string `json:"cloud_account_id,omitempty"`
 	MultiAz        bool   `json:"multi_az"`
 	// Values will be regions of specific cloud provider. For example: us-east-1 for AWS
-	Region         string    `json:"region,omitempty"`
-	Owner          string    `json:"owner,omitempty"`
-	Name           string    `json:"name,omitempty"`
-	CentralUIURL   string    `json:"centralUIURL,omitempty"`
-	CentralDataURL string    `json:"centralDataURL,omitempty"`
-	CreatedAt      time.Time `json:"created_at,omitempty"`
-	UpdatedAt      time.Time `json:"updated_at,omitempty"`
-	FailedReason   string    `json:"failed_reason,omitempty"`
-	Version        string    `json:"version,omitempty"`
-	InstanceType   string    `json:"instance_type,omitempty"`
+	Region string `json:"region,omitempty"`
+	Owner  string `json:"owner,omitempty"`
+	Name   string `json:"name,omitempty"`
+	// Human-readable name of the Central instance. Defaults to the name of the Central instance.
+	DisplayName       string             `json:"display_name,omitempty"`
+	CentralUIURL      string             `json:"centralUIURL,omitempty"`
+	CentralDataURL    string             `json:"centralDataURL,omitempty"`
+	CreatedAt         time.Time          `json:"created_at,omitempty"`
+	UpdatedAt         time.Time          `json:"updated_at,omitempty"`
+	FailedReason      string             `json:"failed_reason,omitempty"`
+	Version           string             `json:"version,omitempty"`
+	InstanceType      string             `json:"instance_type,omitempty"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
+	// User-defined labels of the Central instance.
+	Labels map[string]string `json:"labels,omitempty"`
+	// End of the deletion grace period of a Central pending deletion, until which it can be restored.
+	PendingDeletionUntil *time.Time `json:"pending_deletion_until,omitempty"`
+	// Whether the Central instance is protected against deletion.
+	DeletionProtection bool           `json:"deletion_protection,omitempty"`
+	Health             *CentralHealth `json:"health,omitempty"`
 }
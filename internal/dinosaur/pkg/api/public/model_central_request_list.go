@@ -12,9 +12,10 @@ package public
 
 // CentralRequestList struct for CentralRequestList
 type CentralRequestList struct {
-	Kind  string           `json:"kind"`
-	Page  int32            `json:"page"`
-	Size  int32            `json:"size"`
-	Total int32            `json:"total"`
-	Items []CentralRequest `json:"items"`
+	Kind          string           `json:"kind"`
+	Page          int32            `json:"page"`
+	Size          int32            `json:"size"`
+	Total         int32            `json:"total"`
+	NextPageToken string           `json:"next_page_token,omitempty"`
+	Items         []CentralRequest `json:"items"`
 }
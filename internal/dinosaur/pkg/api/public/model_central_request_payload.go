@@ -24,4 +24,9 @@ type CentralRequestPayload struct {
 	Region  string      `json:"region,omitempty"`
 	Central CentralSpec `json:"central,omitempty"`
 	Scanner ScannerSpec `json:"scanner,omitempty"`
+	// User-defined labels of the Central instance, e.g. `env: prod`. Label keys and values must be valid Kubernetes label names and values, and label keys must not have a prefix. At most 50 labels can be set.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Protects the Central instance against deletion until it is disabled.
+	DeletionProtection bool               `json:"deletion_protection,omitempty"`
+	MaintenanceWindow  *MaintenanceWindow `json:"maintenance_window,omitempty"`
 }
@@ -12,9 +12,10 @@ package public
 
 // CloudProviderList struct for CloudProviderList
 type CloudProviderList struct {
-	Kind  string          `json:"kind"`
-	Page  int32           `json:"page"`
-	Size  int32           `json:"size"`
-	Total int32           `json:"total"`
-	Items []CloudProvider `json:"items"`
+	Kind          string          `json:"kind"`
+	Page          int32           `json:"page"`
+	Size          int32           `json:"size"`
+	Total         int32           `json:"total"`
+	NextPageToken string          `json:"next_page_token,omitempty"`
+	Items         []CloudProvider `json:"items"`
 }
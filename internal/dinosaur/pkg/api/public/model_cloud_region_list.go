@@ -12,9 +12,10 @@ package public
 
 // CloudRegionList struct for CloudRegionList
 type CloudRegionList struct {
-	Kind  string        `json:"kind"`
-	Page  int32         `json:"page"`
-	Size  int32         `json:"size"`
-	Total int32         `json:"total"`
-	Items []CloudRegion `json:"items"`
+	Kind          string        `json:"kind"`
+	Page          int32         `json:"page"`
+	Size          int32         `json:"size"`
+	Total         int32         `json:"total"`
+	NextPageToken string        `json:"next_page_token,omitempty"`
+	Items         []CloudRegion `json:"items"`
 }
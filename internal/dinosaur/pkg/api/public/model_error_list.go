@@ -12,9 +12,10 @@ package public
 
 // ErrorList struct for ErrorList
 type ErrorList struct {
-	Kind  string  `json:"kind"`
-	Page  int32   `json:"page"`
-	Size  int32   `json:"size"`
-	Total int32   `json:"total"`
-	Items []Error `json:"items"`
+	Kind          string  `json:"kind"`
+	Page          int32   `json:"page"`
+	Size          int32   `json:"size"`
+	Total         int32   `json:"total"`
+	NextPageToken string  `json:"next_page_token,omitempty"`
+	Items         []Error `json:"items"`
 }
@@ -26,6 +26,9 @@ func NewClusterCommand(env *environments.Env) *cobra.Command {
 	cmd.AddCommand(
 		NewCreateCommand(env),
 		NewScaleCommand(env),
+		NewCordonCommand(env),
+		NewUncordonCommand(env),
+		NewDrainCommand(env),
 	)
 
 	return cmd
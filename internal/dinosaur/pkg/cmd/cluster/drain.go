@@ -0,0 +1,87 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/environments"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/flags"
+)
+
+// NewCordonCommand creates a new command for cordoning a data-plane cluster
+func NewCordonCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cordon",
+		Short: "Cordon a data-plane cluster",
+		Long:  "Stop placing new Centrals on a data-plane cluster.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runDrainAction(env, cmd, "cordon")
+		},
+	}
+	cmd.Flags().String(FlagClusterID, "", "Cluster ID")
+	return cmd
+}
+
+// NewUncordonCommand creates a new command for uncordoning a data-plane cluster
+func NewUncordonCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uncordon",
+		Short: "Uncordon a data-plane cluster",
+		Long:  "Allow placing new Centrals on a data-plane cluster again and stop draining it.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runDrainAction(env, cmd, "uncordon")
+		},
+	}
+	cmd.Flags().String(FlagClusterID, "", "Cluster ID")
+	return cmd
+}
+
+// NewDrainCommand creates a new command for draining a data-plane cluster
+func NewDrainCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Drain a data-plane cluster",
+		Long:  "Cordon a data-plane cluster and migrate all of its Centrals to other clusters in the same region.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runDrainAction(env, cmd, "drain")
+		},
+	}
+	cmd.Flags().String(FlagClusterID, "", "Cluster ID")
+	return cmd
+}
+
+func runDrainAction(env *environments.Env, cmd *cobra.Command, action string) {
+	clusterID := flags.MustGetDefinedString(FlagClusterID, cmd.Flags())
+	var drainService services.ClusterDrainService
+	env.MustResolveAll(&drainService)
+
+	status, err := drainCluster(drainService, clusterID, action)
+	if err != nil {
+		glog.Fatalf("Unable to %s cluster: %s", action, err.Error())
+	}
+
+	// print the output
+	if indentedStatus, err := json.Marshal(presenters.PresentClusterDrainStatus(status)); err != nil {
+		glog.Fatalf("Unable to marshal cluster drain status: %s", err.Error())
+	} else {
+		glog.Infof("%s", string(indentedStatus))
+	}
+}
+
+func drainCluster(drainService services.ClusterDrainService, clusterID string, action string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+	switch action {
+	case "cordon":
+		return drainService.Cordon(context.Background(), clusterID)
+	case "uncordon":
+		return drainService.Uncordon(context.Background(), clusterID)
+	case "drain":
+		return drainService.Drain(context.Background(), clusterID)
+	default:
+		return nil, errors.GeneralError("unknown cluster drain action %q", action)
+	}
+}
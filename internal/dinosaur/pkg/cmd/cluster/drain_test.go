@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainCluster(t *testing.T) {
+	var called string
+	drainStatus := func(action string, clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+		called = action
+		return &services.ClusterDrainStatus{Cluster: &api.Cluster{ClusterID: clusterID}}, nil
+	}
+	drainService := &services.ClusterDrainServiceMock{
+		CordonFunc: func(ctx context.Context, clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return drainStatus("cordon", clusterID)
+		},
+		UncordonFunc: func(ctx context.Context, clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return drainStatus("uncordon", clusterID)
+		},
+		DrainFunc: func(ctx context.Context, clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return drainStatus("drain", clusterID)
+		},
+	}
+
+	for _, action := range []string{"cordon", "uncordon", "drain"} {
+		t.Run(action, func(t *testing.T) {
+			status, err := drainCluster(drainService, "cluster-id", action)
+			require.Nil(t, err)
+			assert.Equal(t, action, called)
+			assert.Equal(t, "cluster-id", status.Cluster.ClusterID)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := drainCluster(drainService, "cluster-id", "unknown")
+		assert.NotNil(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
@@ -20,6 +21,10 @@ type CentralConfig struct {
 	// non empty value, fleet-manager will store it as the new default value in its database on start up.
 	CentralDefaultVersion string `json:"central_default_version"`
 
+	// CentralDeletionGracePeriod is the time a deleted Central is kept scaled down in status pending_deletion,
+	// during which it can be restored by its owner. The Central is deprovisioned right away if it is 0.
+	CentralDeletionGracePeriod time.Duration `json:"central_deletion_grace_period"`
+
 	CentralLifespan *CentralLifespanConfig `json:"central_lifespan"`
 	Quota           *CentralQuotaConfig    `json:"central_quota"`
 
@@ -51,6 +56,7 @@ func (c *CentralConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.EnableCentralExternalCertificate, "enable-central-external-certificate", c.EnableCentralExternalCertificate, "Enable custom certificate for Central TLS")
 	fs.BoolVar(&c.CentralLifespan.EnableDeletionOfExpiredCentral, "enable-deletion-of-expired-central", c.CentralLifespan.EnableDeletionOfExpiredCentral, "Enable the deletion of centrals when its life span has expired")
 	fs.IntVar(&c.CentralLifespan.CentralLifespanInHours, "central-lifespan", c.CentralLifespan.CentralLifespanInHours, "The desired lifespan of a Central instance")
+	fs.DurationVar(&c.CentralDeletionGracePeriod, "central-deletion-grace-period", c.CentralDeletionGracePeriod, "The time a deleted Central can be restored before it is deprovisioned, 0 deprovisions deleted Centrals right away")
 	fs.StringVar(&c.CentralDomainName, "central-domain-name", c.CentralDomainName, "The domain name to use for Central instances")
 	fs.StringVar(&c.CentralDefaultVersion, "central-default-version", c.CentralDefaultVersion, "The default version for Central instances")
 	fs.StringVar(&c.Quota.Type, "quota-type", c.Quota.Type, "The type of the quota service to be used. The available options are: 'ams' for AMS backed implementation and 'quota-management-list' for quota list backed implementation (default).")
@@ -10,13 +10,19 @@ import (
 type CentralRequestConfig struct {
 	ExpirationTimeout  time.Duration `json:"expiration_timeout"`
 	InternalUserAgents []string      `json:"internal_user_agents"`
+	// MaxScannerAnalyzerReplicas is the upper bound for the Scanner Analyzer replicas users may configure for their Centrals.
+	MaxScannerAnalyzerReplicas int32 `json:"max_scanner_analyzer_replicas"`
+	// IdempotencyKeyTTL is the time in which a creation request can be retried with the same Idempotency-Key.
+	IdempotencyKeyTTL time.Duration `json:"idempotency_key_ttl"`
 }
 
 // NewCentralRequestConfig creates a new CentralRequestConfig with default values.
 func NewCentralRequestConfig() *CentralRequestConfig {
 	return &CentralRequestConfig{
-		ExpirationTimeout:  60 * time.Minute,
-		InternalUserAgents: []string{"fleet-manager-probe-service"},
+		ExpirationTimeout:          60 * time.Minute,
+		InternalUserAgents:         []string{"fleet-manager-probe-service"},
+		MaxScannerAnalyzerReplicas: 5,
+		IdempotencyKeyTTL:          24 * time.Hour,
 	}
 }
 
@@ -27,6 +33,10 @@ func (c *CentralRequestConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&c.InternalUserAgents, "central-request-internal-user-agents",
 		c.InternalUserAgents,
 		"HTTP User-Agents for central requests coming from internal services such as the probe service")
+	fs.Int32Var(&c.MaxScannerAnalyzerReplicas, "central-request-max-scanner-analyzer-replicas",
+		c.MaxScannerAnalyzerReplicas, "Maximum number of Scanner Analyzer replicas users may configure for a central")
+	fs.DurationVar(&c.IdempotencyKeyTTL, "central-request-idempotency-key-ttl", c.IdempotencyKeyTTL,
+		"Time in which a central request can be retried with the same Idempotency-Key header")
 }
 
 // ReadFiles will read any files specified via flags.
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// CentralWatchConfig holds the configuration of the data-plane watch on the Centrals assigned to a cluster.
+type CentralWatchConfig struct {
+	// MaxTimeout caps the time a watch request waits for changes. It must stay below the timeout of the
+	// routers in front of fleet-manager.
+	MaxTimeout time.Duration `json:"max_timeout"`
+	// ResyncPeriod is the interval in which waiting watch requests list the Centrals again, even without
+	// a change notification from the database.
+	ResyncPeriod time.Duration `json:"resync_period"`
+	// HistorySize is the number of resource versions per cluster that watch requests can resume from.
+	HistorySize int `json:"history_size"`
+	// DebouncePeriod is the time change notifications from the database are collected before waking up the
+	// watch requests of the changed clusters, so that a burst of writes results in a single list.
+	DebouncePeriod time.Duration `json:"debounce_period"`
+}
+
+// NewCentralWatchConfig creates a new CentralWatchConfig with default values.
+func NewCentralWatchConfig() *CentralWatchConfig {
+	return &CentralWatchConfig{
+		MaxTimeout:     25 * time.Second,
+		ResyncPeriod:   10 * time.Second,
+		HistorySize:    20,
+		DebouncePeriod: time.Second,
+	}
+}
+
+// AddFlags adds flags for all configuration settings within CentralWatchConfig to the flag set.
+func (c *CentralWatchConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.MaxTimeout, "central-watch-max-timeout", c.MaxTimeout,
+		"Maximum time a data-plane watch request on centrals waits for changes")
+	fs.DurationVar(&c.ResyncPeriod, "central-watch-resync-period", c.ResyncPeriod,
+		"Interval in which waiting data-plane watch requests list the centrals again without a change notification")
+	fs.IntVar(&c.HistorySize, "central-watch-history-size", c.HistorySize,
+		"Number of resource versions per data-plane cluster that watch requests can resume from")
+	fs.DurationVar(&c.DebouncePeriod, "central-watch-debounce-period", c.DebouncePeriod,
+		"Time change notifications are collected before waking up the data-plane watch requests of the changed clusters")
+}
+
+// ReadFiles will read any files specified via flags.
+// Note: this is required to satisfy the environment.ConfigModule interface and will be a no-op for this struct.
+func (c *CentralWatchConfig) ReadFiles() error {
+	return nil
+}
@@ -40,6 +40,18 @@ type DataplaneClusterConfig struct {
 	RawKubernetesConfig                   *clientcmdapi.Config
 	CentralOperatorOLMConfig              OperatorInstallationConfig `json:"dinosaur_operator_olm_config"`
 	FleetshardOperatorOLMConfig           OperatorInstallationConfig `json:"fleetshard_operator_olm_config"`
+	// Possible values are:
+	// 'first-ready' to place Centrals on the first ready cluster,
+	// 'least-loaded' to place Centrals on the cluster with the fewest instances,
+	// 'weighted-capacity' to distribute Centrals according to the capacity reported by fleetshard-sync,
+	// 'org-affinity' to co-locate Centrals of an organisation and
+	// 'org-anti-affinity' to spread Centrals of an organisation across clusters
+	ClusterPlacementStrategy string `json:"cluster_placement_strategy"`
+	// ClusterDrainBatchSize is the maximum number of Centrals migrated away from a draining cluster at the same time.
+	ClusterDrainBatchSize int `json:"cluster_drain_batch_size"`
+	// CentralOperatorImages are the images of the ACS operator versions fleetshard-sync installs on every data plane
+	// cluster, keyed by version, in addition to the versions still used by Centrals on the cluster.
+	CentralOperatorImages map[string]string `json:"central_operator_images"`
 }
 
 // OperatorInstallationConfig ...
@@ -61,6 +73,28 @@ const (
 	NoScaling string = "none"
 )
 
+// FirstReadyPlacement ...
+const (
+	// FirstReadyPlacement places Centrals on the first ready and schedulable cluster
+	FirstReadyPlacement string = "first-ready"
+	// LeastLoadedPlacement places Centrals on the cluster with the lowest number of Central instances
+	LeastLoadedPlacement string = "least-loaded"
+	// WeightedCapacityPlacement places Centrals randomly, weighted by the remaining capacity reported by fleetshard-sync
+	WeightedCapacityPlacement string = "weighted-capacity"
+	// OrgAffinityPlacement places Centrals on the cluster already hosting most Centrals of the same organisation
+	OrgAffinityPlacement string = "org-affinity"
+	// OrgAntiAffinityPlacement places Centrals on the cluster hosting the fewest Centrals of the same organisation
+	OrgAntiAffinityPlacement string = "org-anti-affinity"
+)
+
+var clusterPlacementStrategies = []string{
+	FirstReadyPlacement,
+	LeastLoadedPlacement,
+	WeightedCapacityPlacement,
+	OrgAffinityPlacement,
+	OrgAntiAffinityPlacement,
+}
+
 func getDefaultKubeconfig() string {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -79,6 +113,9 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 		DataPlaneClusterConfigFile:            "config/dataplane-cluster-configuration.yaml",
 		ReadOnlyUserListFile:                  "config/read-only-user-list.yaml",
 		DataPlaneClusterScalingType:           ManualScaling,
+		ClusterPlacementStrategy:              FirstReadyPlacement,
+		ClusterDrainBatchSize:                 5,
+		CentralOperatorImages:                 map[string]string{"3.74.0": "quay.io/rhacs-eng/stackrox-operator:3.74.0"},
 		ClusterConfig:                         &ClusterConfig{},
 		EnableReadyDataPlaneClustersReconcile: true,
 		Kubeconfig:                            getDefaultKubeconfig(),
@@ -203,6 +240,29 @@ func (conf *ClusterConfig) IsClusterSchedulable(clusterID string) bool {
 	return true
 }
 
+// HasAvailableCentralOperatorVersions returns true if the configuration of the given cluster
+// lists its available Central operator versions.
+func (conf *ClusterConfig) HasAvailableCentralOperatorVersions(clusterID string) bool {
+	if conf == nil {
+		return false
+	}
+	manualCluster, exist := conf.clusterConfigMap[clusterID]
+	return exist && len(manualCluster.AvailableCentralOperatorVersions) > 0
+}
+
+// GetCentralOperatorImage returns the image of the given Central operator version configured for the given cluster.
+func (conf *ClusterConfig) GetCentralOperatorImage(clusterID, version string) (string, bool) {
+	if conf == nil {
+		return "", false
+	}
+	for _, operatorVersion := range conf.clusterConfigMap[clusterID].AvailableCentralOperatorVersions {
+		if operatorVersion.Version == version && operatorVersion.Image != "" {
+			return operatorVersion.Image, true
+		}
+	}
+	return "", false
+}
+
 // GetClusterSupportedInstanceType ...
 func (conf *ClusterConfig) GetClusterSupportedInstanceType(clusterID string) (string, bool) {
 	manualCluster, exist := conf.clusterConfigMap[clusterID]
@@ -267,6 +327,15 @@ func (c *DataplaneClusterConfig) IsReadyDataPlaneClustersReconcileEnabled() bool
 	return c.EnableReadyDataPlaneClustersReconcile
 }
 
+func isValidClusterPlacementStrategy(strategy string) bool {
+	for _, s := range clusterPlacementStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
 type stringValue string
 
 func (s *stringValue) Set(val string) error {
@@ -304,6 +373,8 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.ImagePullDockerConfigFile, "image-pull-docker-config-file", c.ImagePullDockerConfigFile, "The file that contains the docker config content for pulling MK operator images on clusters")
 	fs.StringVar(&c.DataPlaneClusterConfigFile, "dataplane-cluster-config-file", c.DataPlaneClusterConfigFile, "File contains properties for manually configuring OSD cluster.")
 	fs.StringVar(&c.DataPlaneClusterScalingType, "dataplane-cluster-scaling-type", c.DataPlaneClusterScalingType, "Set to use cluster configuration to configure clusters. Its value should be either 'none' for no scaling, 'manual' or 'auto'.")
+	fs.StringVar(&c.ClusterPlacementStrategy, "dataplane-cluster-placement-strategy", c.ClusterPlacementStrategy, fmt.Sprintf("The strategy used to place Centrals on data plane clusters. Its value should be one of %v.", clusterPlacementStrategies))
+	fs.IntVar(&c.ClusterDrainBatchSize, "cluster-drain-batch-size", c.ClusterDrainBatchSize, "The maximum number of Centrals migrated away from a draining data plane cluster at the same time")
 	fs.StringVar(&c.ReadOnlyUserListFile, "read-only-user-list-file", c.ReadOnlyUserListFile, "File contains a list of users with read-only permissions to data plane clusters")
 	fs.BoolVar(&c.EnableReadyDataPlaneClustersReconcile, "enable-ready-dataplane-clusters-reconcile", c.EnableReadyDataPlaneClustersReconcile, "Enables reconciliation for data plane clusters in the 'Ready' state")
 	c.addKubeconfigFlag(fs)
@@ -317,10 +388,28 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.FleetshardOperatorOLMConfig.Namespace, "fleetshard-operator-namespace", c.FleetshardOperatorOLMConfig.Namespace, "fleetshard operator namespace")
 	fs.StringVar(&c.FleetshardOperatorOLMConfig.Package, "fleetshard-operator-package", c.FleetshardOperatorOLMConfig.Package, "fleetshard operator package")
 	fs.StringVar(&c.FleetshardOperatorOLMConfig.SubscriptionChannel, "fleetshard-operator-sub-channel", c.FleetshardOperatorOLMConfig.SubscriptionChannel, "fleetshard operator subscription channel")
+	fs.StringToStringVar(&c.CentralOperatorImages, "central-operator-images", c.CentralOperatorImages, "The images of the ACS operator versions installed on the data plane clusters, as version=image pairs")
+}
+
+// CentralOperatorImage returns the image of the given ACS operator version on the given cluster. Images configured
+// for the cluster take precedence over CentralOperatorImages. Returns false if no image is configured for the version.
+func (c *DataplaneClusterConfig) CentralOperatorImage(clusterID, version string) (string, bool) {
+	if image, ok := c.ClusterConfig.GetCentralOperatorImage(clusterID, version); ok {
+		return image, true
+	}
+	image, ok := c.CentralOperatorImages[version]
+	return image, ok && image != ""
 }
 
 // ReadFiles ...
 func (c *DataplaneClusterConfig) ReadFiles() error {
+	if !isValidClusterPlacementStrategy(c.ClusterPlacementStrategy) {
+		return errors.Errorf("invalid cluster placement strategy %q, must be one of %v", c.ClusterPlacementStrategy, clusterPlacementStrategies)
+	}
+	if c.ClusterDrainBatchSize < 1 {
+		return errors.Errorf("invalid cluster drain batch size %d, must be at least 1", c.ClusterDrainBatchSize)
+	}
+
 	if c.ImagePullDockerConfigContent == "" && c.ImagePullDockerConfigFile != "" {
 		err := shared.ReadFileValueString(c.ImagePullDockerConfigFile, &c.ImagePullDockerConfigContent)
 		if err != nil {
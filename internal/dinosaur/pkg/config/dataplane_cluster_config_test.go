@@ -22,6 +22,7 @@ supported_instance_type: "eval,standard"
 cluster_dns: cluster.local
 available_central_operator_versions:
   - version: "0.1.0"
+    image: "quay.io/rhacs-eng/stackrox-operator:3.74.0"
     ready: true
     central_versions:
       - version: "0.1.0"
@@ -55,3 +56,24 @@ available_central_operator_versions:
 		t.Fatalf("Expected first central version to be: %s, got: %s\n", want, got)
 	}
 }
+
+func TestDataplaneClusterConfig_CentralOperatorImage(t *testing.T) {
+	c := NewDataplaneClusterConfig()
+	c.CentralOperatorImages = map[string]string{"3.74.0": "quay.io/rhacs-eng/stackrox-operator:3.74.0"}
+	c.ClusterConfig = NewClusterConfig(ClusterList{{
+		ClusterID: "cluster-id",
+		AvailableCentralOperatorVersions: []api.CentralOperatorVersion{
+			{Version: "0.1.0", Image: "quay.io/rhacs-eng/stackrox-operator:3.74.0-dev"},
+		},
+	}})
+
+	if image, ok := c.CentralOperatorImage("cluster-id", "0.1.0"); !ok || image != "quay.io/rhacs-eng/stackrox-operator:3.74.0-dev" {
+		t.Fatalf("Expected the image configured for the cluster, got: %q\n", image)
+	}
+	if image, ok := c.CentralOperatorImage("cluster-id", "3.74.0"); !ok || image != "quay.io/rhacs-eng/stackrox-operator:3.74.0" {
+		t.Fatalf("Expected the globally configured image, got: %q\n", image)
+	}
+	if _, ok := c.CentralOperatorImage("other-cluster-id", "0.1.0"); ok {
+		t.Fatal("Expected no image for a version which is not configured")
+	}
+}
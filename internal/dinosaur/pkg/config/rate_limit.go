@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+	"gopkg.in/yaml.v2"
+)
+
+// Route classes of the API that are rate limited per organisation.
+const (
+	// CentralCreateRateLimitClass covers the creation of Central instances.
+	CentralCreateRateLimitClass = "central-create"
+	// MetricsRateLimitClass covers the endpoints proxying the metrics of Central instances.
+	MetricsRateLimitClass = "metrics"
+)
+
+// RateLimit is the limit of the requests of an organisation to a route class.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests. Zero means that the rate is not limited.
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	// Burst is the number of requests that can be sent at once on top of the sustained rate.
+	Burst int `yaml:"burst"`
+	// MaxConcurrent is the maximum number of requests processed at the same time. Zero means no limit.
+	MaxConcurrent int `yaml:"max_concurrent"`
+}
+
+// OrganisationRateLimits overrides the limits of route classes for an organisation.
+type OrganisationRateLimits struct {
+	OrganisationID string               `yaml:"organisation_id"`
+	Classes        map[string]RateLimit `yaml:"classes"`
+}
+
+// RateLimitConfiguration is the content of the rate limit configuration file.
+type RateLimitConfiguration struct {
+	// Classes are the default limits of the route classes. Classes without limits are not rate limited.
+	Classes       map[string]RateLimit     `yaml:"classes"`
+	Organisations []OrganisationRateLimits `yaml:"organisations"`
+}
+
+// RateLimitConfig holds the configuration of the per organisation rate limits of the API.
+type RateLimitConfig struct {
+	Enabled    bool
+	ConfigFile string
+	RateLimitConfiguration
+}
+
+// NewRateLimitConfig creates a new RateLimitConfig with default values.
+func NewRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		ConfigFile: "config/rate-limit-configuration.yaml",
+	}
+}
+
+// AddFlags adds flags for all configuration settings within RateLimitConfig to the flag set.
+func (c *RateLimitConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "enable-rate-limiting", c.Enabled, "Enable per organisation rate limiting of the API")
+	fs.StringVar(&c.ConfigFile, "rate-limit-config-file", c.ConfigFile, "Rate limit configuration file")
+}
+
+// ReadFiles reads the rate limit configuration file if rate limiting is enabled.
+func (c *RateLimitConfig) ReadFiles() error {
+	if !c.Enabled {
+		return nil
+	}
+	fileContents, err := shared.ReadFile(c.ConfigFile)
+	if err != nil {
+		return fmt.Errorf("reading rate limit configuration file: %w", err)
+	}
+	var configuration RateLimitConfiguration
+	if err := yaml.UnmarshalStrict([]byte(fileContents), &configuration); err != nil {
+		return fmt.Errorf("unmarshalling file %q: %w", c.ConfigFile, err)
+	}
+	if err := configuration.validate(); err != nil {
+		return fmt.Errorf("validating file %q: %w", c.ConfigFile, err)
+	}
+	c.RateLimitConfiguration = configuration
+	return nil
+}
+
+// GetRateLimit returns the limit of the requests of an organisation to a route class. The second return value is
+// false if the requests are not limited.
+func (c *RateLimitConfig) GetRateLimit(class, organisationID string) (RateLimit, bool) {
+	if !c.Enabled {
+		return RateLimit{}, false
+	}
+	for _, organisation := range c.Organisations {
+		if organisation.OrganisationID != organisationID {
+			continue
+		}
+		if limit, ok := organisation.Classes[class]; ok {
+			return limit, limit.isLimited()
+		}
+	}
+	limit, ok := c.Classes[class]
+	return limit, ok && limit.isLimited()
+}
+
+func (l RateLimit) isLimited() bool {
+	return l.RequestsPerSecond > 0 || l.MaxConcurrent > 0
+}
+
+func (c RateLimitConfiguration) validate() error {
+	validateClasses := func(classes map[string]RateLimit) error {
+		for class, limit := range classes {
+			if class != CentralCreateRateLimitClass && class != MetricsRateLimitClass {
+				return fmt.Errorf("unknown route class %q", class)
+			}
+			if limit.RequestsPerSecond < 0 || limit.Burst < 0 || limit.MaxConcurrent < 0 {
+				return fmt.Errorf("limits of route class %q must not be negative", class)
+			}
+			if limit.RequestsPerSecond > 0 && limit.Burst < 1 {
+				return fmt.Errorf("burst of route class %q must be at least 1", class)
+			}
+		}
+		return nil
+	}
+	if err := validateClasses(c.Classes); err != nil {
+		return err
+	}
+	for _, organisation := range c.Organisations {
+		if organisation.OrganisationID == "" {
+			return fmt.Errorf("organisation_id of rate limit override must not be empty")
+		}
+		if err := validateClasses(organisation.Classes); err != nil {
+			return fmt.Errorf("organisation %q: %w", organisation.OrganisationID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitConfig_ReadFiles(t *testing.T) {
+	rateLimitConfig := NewRateLimitConfig()
+	rateLimitConfig.Enabled = true
+	require.NoError(t, rateLimitConfig.ReadFiles())
+
+	limit, limited := rateLimitConfig.GetRateLimit(CentralCreateRateLimitClass, "org-id")
+	assert.True(t, limited)
+	assert.Equal(t, RateLimit{RequestsPerSecond: 0.1, Burst: 5, MaxConcurrent: 2}, limit)
+}
+
+func TestRateLimitConfig_GetRateLimit(t *testing.T) {
+	rateLimitConfig := &RateLimitConfig{
+		Enabled: true,
+		RateLimitConfiguration: RateLimitConfiguration{
+			Classes: map[string]RateLimit{
+				CentralCreateRateLimitClass: {RequestsPerSecond: 1, Burst: 1},
+			},
+			Organisations: []OrganisationRateLimits{
+				{
+					OrganisationID: "premium-org",
+					Classes: map[string]RateLimit{
+						CentralCreateRateLimitClass: {RequestsPerSecond: 10, Burst: 10},
+						MetricsRateLimitClass:       {MaxConcurrent: 5},
+					},
+				},
+				{
+					OrganisationID: "unlimited-org",
+					Classes: map[string]RateLimit{
+						CentralCreateRateLimitClass: {},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		class       string
+		orgID       string
+		wantLimit   RateLimit
+		wantLimited bool
+	}{
+		{
+			name:        "should return the default limit of the class",
+			class:       CentralCreateRateLimitClass,
+			orgID:       "org-id",
+			wantLimit:   RateLimit{RequestsPerSecond: 1, Burst: 1},
+			wantLimited: true,
+		},
+		{
+			name:        "should return the override of the organisation",
+			class:       CentralCreateRateLimitClass,
+			orgID:       "premium-org",
+			wantLimit:   RateLimit{RequestsPerSecond: 10, Burst: 10},
+			wantLimited: true,
+		},
+		{
+			name:        "should return the override of a class without default limit",
+			class:       MetricsRateLimitClass,
+			orgID:       "premium-org",
+			wantLimit:   RateLimit{MaxConcurrent: 5},
+			wantLimited: true,
+		},
+		{
+			name:  "should not limit organisations with an empty override",
+			class: CentralCreateRateLimitClass,
+			orgID: "unlimited-org",
+		},
+		{
+			name:  "should not limit classes without limit",
+			class: MetricsRateLimitClass,
+			orgID: "org-id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, limited := rateLimitConfig.GetRateLimit(tt.class, tt.orgID)
+			assert.Equal(t, tt.wantLimited, limited)
+			if tt.wantLimited {
+				assert.Equal(t, tt.wantLimit, limit)
+			}
+		})
+	}
+}
+
+func TestRateLimitConfiguration_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration RateLimitConfiguration
+	}{
+		{
+			name: "should reject unknown classes",
+			configuration: RateLimitConfiguration{
+				Classes: map[string]RateLimit{"unknown": {RequestsPerSecond: 1, Burst: 1}},
+			},
+		},
+		{
+			name: "should reject a rate without burst",
+			configuration: RateLimitConfiguration{
+				Classes: map[string]RateLimit{MetricsRateLimitClass: {RequestsPerSecond: 1}},
+			},
+		},
+		{
+			name: "should reject negative limits of organisations",
+			configuration: RateLimitConfiguration{
+				Organisations: []OrganisationRateLimits{
+					{
+						OrganisationID: "org-id",
+						Classes:        map[string]RateLimit{MetricsRateLimitClass: {MaxConcurrent: -1}},
+					},
+				},
+			},
+		},
+		{
+			name: "should reject overrides without organisation",
+			configuration: RateLimitConfiguration{
+				Organisations: []OrganisationRateLimits{{}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.configuration.validate())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// WebhookConfig holds the configuration of the delivery of webhook notifications.
+type WebhookConfig struct {
+	// MaxAttempts is the number of delivery attempts after which a notification is moved to the dead letters.
+	MaxAttempts int `json:"max_attempts"`
+	// InitialBackoff is the delay before the second attempt. The delay doubles with every further attempt.
+	InitialBackoff time.Duration `json:"initial_backoff"`
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration `json:"max_backoff"`
+	// Timeout is the timeout of a single delivery request.
+	Timeout time.Duration `json:"timeout"`
+	// BatchSize is the maximum number of notifications delivered per reconciliation.
+	BatchSize int `json:"batch_size"`
+	// Concurrency is the maximum number of notifications delivered at the same time.
+	Concurrency int `json:"concurrency"`
+	// AllowInsecureURLs allows subscriptions to http URLs. It should only be enabled for local development.
+	AllowInsecureURLs bool `json:"allow_insecure_urls"`
+	// AllowPrivateNetworks allows subscriptions to loopback, private and link-local addresses. It should only be
+	// enabled for local development.
+	AllowPrivateNetworks bool `json:"allow_private_networks"`
+}
+
+// NewWebhookConfig creates a new WebhookConfig with default values.
+func NewWebhookConfig() *WebhookConfig {
+	return &WebhookConfig{
+		MaxAttempts:    8,
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     time.Hour,
+		Timeout:        10 * time.Second,
+		BatchSize:      100,
+		Concurrency:    10,
+	}
+}
+
+// AddFlags adds flags for all configuration settings within WebhookConfig to the flag set.
+func (c *WebhookConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&c.MaxAttempts, "webhook-max-attempts", c.MaxAttempts,
+		"Number of delivery attempts after which a webhook notification is moved to the dead letters")
+	fs.DurationVar(&c.InitialBackoff, "webhook-initial-backoff", c.InitialBackoff,
+		"Delay before retrying a failed webhook delivery, doubled with every further attempt")
+	fs.DurationVar(&c.MaxBackoff, "webhook-max-backoff", c.MaxBackoff,
+		"Maximum delay between two attempts of a webhook delivery")
+	fs.DurationVar(&c.Timeout, "webhook-timeout", c.Timeout,
+		"Timeout of a single webhook delivery request")
+	fs.IntVar(&c.BatchSize, "webhook-batch-size", c.BatchSize,
+		"Maximum number of webhook notifications delivered per reconciliation")
+	fs.IntVar(&c.Concurrency, "webhook-concurrency", c.Concurrency,
+		"Maximum number of webhook notifications delivered at the same time")
+	fs.BoolVar(&c.AllowInsecureURLs, "webhook-allow-insecure-urls", c.AllowInsecureURLs,
+		"Allow webhook subscriptions to http URLs. Only for local development")
+	fs.BoolVar(&c.AllowPrivateNetworks, "webhook-allow-private-networks", c.AllowPrivateNetworks,
+		"Allow webhook subscriptions to loopback, private and link-local addresses. Only for local development")
+}
+
+// ReadFiles validates the configuration.
+// Note: this is required to satisfy the environment.ConfigModule interface.
+func (c *WebhookConfig) ReadFiles() error {
+	if c.Concurrency < 1 {
+		return errors.Errorf("invalid webhook concurrency %d, must be at least 1", c.Concurrency)
+	}
+	return nil
+}
@@ -34,6 +34,8 @@ func ConvertCluster(cluster *api.Cluster) []map[string]interface{} {
 			"provider_spec":  p,
 			"cluster_spec":   c,
 			"schedulable":    cluster.Schedulable,
+			"cordoned":       cluster.Cordoned,
+			"draining":       cluster.Draining,
 		},
 	}
 }
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type adminCentralVersionRolloutHandler struct {
+	rolloutService services.CentralVersionRolloutService
+}
+
+// NewAdminCentralVersionRolloutHandler ...
+func NewAdminCentralVersionRolloutHandler(rolloutService services.CentralVersionRolloutService) *adminCentralVersionRolloutHandler {
+	return &adminCentralVersionRolloutHandler{
+		rolloutService: rolloutService,
+	}
+}
+
+// List lists all central version rollouts, most recent first.
+func (h adminCentralVersionRolloutHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			rollouts, svcErr := h.rolloutService.List()
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			rolloutList := private.CentralVersionRolloutList{
+				Kind:  "CentralVersionRolloutList",
+				Page:  1,
+				Size:  int32(len(rollouts)),
+				Total: int32(len(rollouts)),
+				Items: []private.CentralVersionRollout{},
+			}
+			for _, rollout := range rollouts {
+				rolloutList.Items = append(rolloutList.Items, presenters.PresentCentralVersionRollout(rollout))
+			}
+			return rolloutList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Create starts a central version rollout.
+func (h adminCentralVersionRolloutHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var rolloutRequest private.CentralVersionRolloutRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &rolloutRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&rolloutRequest.CentralVersion, "central_version", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			rollout, err := presenters.ConvertCentralVersionRolloutRequest(rolloutRequest)
+			if err != nil {
+				return nil, errors.Validation(err.Error())
+			}
+			if svcErr := h.rolloutService.Create(r.Context(), rollout); svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentCentralVersionRollout(rollout), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Get returns a central version rollout with its progress.
+func (h adminCentralVersionRolloutHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			rollout, svcErr := h.rolloutService.Get(id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentCentralVersionRollout(rollout), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Pause pauses a central version rollout in progress.
+func (h adminCentralVersionRolloutHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.rolloutService.Pause)
+}
+
+// Resume resumes a paused central version rollout.
+func (h adminCentralVersionRolloutHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.rolloutService.Resume)
+}
+
+// Cancel cancels a central version rollout in progress or paused.
+func (h adminCentralVersionRolloutHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.rolloutService.Cancel)
+}
+
+func (h adminCentralVersionRolloutHandler) transition(w http.ResponseWriter, r *http.Request,
+	transition func(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *errors.ServiceError)) {
+	id := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			rollout, svcErr := transition(r.Context(), id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentCentralVersionRollout(rollout), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+	"github.com/stackrox/acs-fleet-manager/pkg/services/account"
+)
+
+type adminClusterHandler struct {
+	drainService   services.ClusterDrainService
+	accountService account.AccountService
+}
+
+// NewAdminClusterHandler ...
+func NewAdminClusterHandler(drainService services.ClusterDrainService, accountService account.AccountService) *adminClusterHandler {
+	return &adminClusterHandler{
+		drainService:   drainService,
+		accountService: accountService,
+	}
+}
+
+// ListCentrals ...
+func (h adminClusterHandler) ListCentrals(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			centralRequests, svcErr := h.drainService.ListCentrals(clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			centralRequestList := private.CentralList{
+				Kind:  "CentralList",
+				Page:  1,
+				Size:  int32(len(centralRequests)),
+				Total: int32(len(centralRequests)),
+				Items: []private.Central{},
+			}
+			for _, centralRequest := range centralRequests {
+				converted, err := presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+				if err != nil {
+					return nil, err
+				}
+				if converted != nil {
+					centralRequestList.Items = append(centralRequestList.Items, *converted)
+				}
+			}
+			return centralRequestList, nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Cordon ...
+func (h adminClusterHandler) Cordon(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			status, svcErr := h.drainService.Cordon(r.Context(), clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentClusterDrainStatus(status), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Uncordon ...
+func (h adminClusterHandler) Uncordon(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			status, svcErr := h.drainService.Uncordon(r.Context(), clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentClusterDrainStatus(status), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Drain ...
+func (h adminClusterHandler) Drain(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			status, svcErr := h.drainService.Drain(r.Context(), clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentClusterDrainStatus(status), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// GetDrainStatus ...
+func (h adminClusterHandler) GetDrainStatus(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			status, svcErr := h.drainService.GetDrainStatus(clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentClusterDrainStatus(status), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
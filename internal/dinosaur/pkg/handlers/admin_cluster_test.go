@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDrainServiceMock() *services.ClusterDrainServiceMock {
+	drainStatus := func(clusterID string, cordoned bool, draining bool) (*services.ClusterDrainStatus, *serviceErrors.ServiceError) {
+		if clusterID != "cluster-id" {
+			return nil, serviceErrors.NotFound("cluster %s not found", clusterID)
+		}
+		return &services.ClusterDrainStatus{
+			Cluster:           &api.Cluster{ClusterID: clusterID, Cordoned: cordoned, Draining: draining},
+			RemainingCentrals: 3,
+			MigratingCentrals: 1,
+		}, nil
+	}
+	return &services.ClusterDrainServiceMock{
+		CordonFunc: func(ctx context.Context, clusterID string) (*services.ClusterDrainStatus, *serviceErrors.ServiceError) {
+			return drainStatus(clusterID, true, false)
+		},
+		UncordonFunc: func(ctx context.Context, clusterID string) (*services.ClusterDrainStatus, *serviceErrors.ServiceError) {
+			return drainStatus(clusterID, false, false)
+		},
+		DrainFunc: func(ctx context.Context, clusterID string) (*services.ClusterDrainStatus, *serviceErrors.ServiceError) {
+			return drainStatus(clusterID, true, true)
+		},
+		GetDrainStatusFunc: func(clusterID string) (*services.ClusterDrainStatus, *serviceErrors.ServiceError) {
+			return drainStatus(clusterID, true, true)
+		},
+	}
+}
+
+func TestAdminClusterHandler_DrainActions(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		clusterID    string
+		action       func(h *adminClusterHandler) http.HandlerFunc
+		wantCode     int
+		wantCordoned bool
+		wantDraining bool
+	}{
+		{
+			name:         "should cordon the cluster",
+			method:       http.MethodPost,
+			clusterID:    "cluster-id",
+			action:       func(h *adminClusterHandler) http.HandlerFunc { return h.Cordon },
+			wantCode:     http.StatusOK,
+			wantCordoned: true,
+		},
+		{
+			name:      "should uncordon the cluster",
+			method:    http.MethodPost,
+			clusterID: "cluster-id",
+			action:    func(h *adminClusterHandler) http.HandlerFunc { return h.Uncordon },
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:         "should drain the cluster",
+			method:       http.MethodPost,
+			clusterID:    "cluster-id",
+			action:       func(h *adminClusterHandler) http.HandlerFunc { return h.Drain },
+			wantCode:     http.StatusAccepted,
+			wantCordoned: true,
+			wantDraining: true,
+		},
+		{
+			name:         "should return the drain status",
+			method:       http.MethodGet,
+			clusterID:    "cluster-id",
+			action:       func(h *adminClusterHandler) http.HandlerFunc { return h.GetDrainStatus },
+			wantCode:     http.StatusOK,
+			wantCordoned: true,
+			wantDraining: true,
+		},
+		{
+			name:      "should return not found for unknown clusters",
+			method:    http.MethodPost,
+			clusterID: "unknown",
+			action:    func(h *adminClusterHandler) http.HandlerFunc { return h.Drain },
+			wantCode:  http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewAdminClusterHandler(newDrainServiceMock(), nil)
+			r := httptest.NewRequest(tt.method, "/api/rhacs/v1/admin/clusters/"+tt.clusterID, nil)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.clusterID})
+			w := httptest.NewRecorder()
+
+			tt.action(h)(w, r)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode >= http.StatusBadRequest {
+				return
+			}
+			var status private.ClusterDrainStatus
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+			assert.Equal(t, private.ClusterDrainStatus{
+				ClusterId:         tt.clusterID,
+				Cordoned:          tt.wantCordoned,
+				Draining:          tt.wantDraining,
+				RemainingCentrals: 3,
+				MigratingCentrals: 1,
+			}, status)
+		})
+	}
+}
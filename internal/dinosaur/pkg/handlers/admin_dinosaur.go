@@ -4,12 +4,14 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/services/account"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/public"
@@ -23,12 +25,18 @@ import (
 	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
 )
 
+// overrideDeletionProtectionParam is the query parameter required to force-delete a central with deletion protection.
+const overrideDeletionProtectionParam = "override_deletion_protection"
+
 type adminCentralHandler struct {
 	service                      services.DinosaurService
 	accountService               account.AccountService
 	providerConfig               *config.ProviderConfig
 	telemetry                    *services.Telemetry
 	centralDefaultVersionService services.CentralDefaultVersionService
+	centralMigrationService      services.CentralMigrationService
+	centralBackupService         services.CentralBackupService
+	centralEventService          services.CentralEventService
 }
 
 // NewAdminCentralHandler ...
@@ -37,13 +45,19 @@ func NewAdminCentralHandler(
 	accountService account.AccountService,
 	providerConfig *config.ProviderConfig,
 	telemetry *services.Telemetry,
-	centralDefaultVersionService services.CentralDefaultVersionService) *adminCentralHandler {
+	centralDefaultVersionService services.CentralDefaultVersionService,
+	centralMigrationService services.CentralMigrationService,
+	centralBackupService services.CentralBackupService,
+	centralEventService services.CentralEventService) *adminCentralHandler {
 	return &adminCentralHandler{
 		service:                      service,
 		accountService:               accountService,
 		providerConfig:               providerConfig,
 		telemetry:                    telemetry,
 		centralDefaultVersionService: centralDefaultVersionService,
+		centralMigrationService:      centralMigrationService,
+		centralBackupService:         centralBackupService,
+		centralEventService:          centralEventService,
 	}
 }
 
@@ -105,6 +119,7 @@ func (h adminCentralHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			setCentralETag(w, centralRequest)
 			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
 		},
 	}
@@ -129,11 +144,12 @@ func (h adminCentralHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			centralRequestList := private.CentralList{
-				Kind:  "CentralList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []private.Central{},
+				Kind:          "CentralList",
+				Page:          int32(paging.Page),
+				Size:          int32(paging.Size),
+				Total:         int32(paging.Total),
+				NextPageToken: paging.NextPageToken,
+				Items:         []private.Central{},
 			}
 
 			for _, centralRequest := range centralRequests {
@@ -147,7 +163,7 @@ func (h adminCentralHandler) List(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 
-			return centralRequestList, nil
+			return handlers.ProjectListFields(centralRequestList, listArgs.Fields)
 		},
 	}
 
@@ -165,6 +181,9 @@ func (h adminCentralHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 			err := h.service.RegisterDinosaurDeprovisionJob(ctx, id)
 			h.telemetry.TrackDeletionRequested(ctx, id, true, err.AsError())
+			if err == nil {
+				h.centralEventService.RecordAction(ctx, constants.CentralEventTypeAdminAction, id, "Deletion requested")
+			}
 			return nil, err
 		},
 	}
@@ -183,8 +202,20 @@ func (h adminCentralHandler) DbDelete(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			// the override is recorded in the audit log together with the request URL
+			if centralRequest.DeletionProtection && r.URL.Query().Get(overrideDeletionProtectionParam) != "true" {
+				return nil, errors.DeletionProtected("central %s is protected against deletion, %s=true is required to force-delete it",
+					id, overrideDeletionProtectionParam)
+			}
 
 			err = h.service.Delete(centralRequest, true)
+			if err == nil {
+				message := "Force-deleted from the database"
+				if centralRequest.DeletionProtection {
+					message += ", overriding its deletion protection"
+				}
+				h.centralEventService.RecordAction(ctx, constants.CentralEventTypeAdminAction, id, message)
+			}
 			return nil, err
 		},
 	}
@@ -321,6 +352,11 @@ func updateCentralRequest(request *dbapi.CentralRequest, updateRequest *private.
 	}
 
 	new.DesiredCentralVersion = updateRequest.CentralVersion
+	if updateRequest.SkipMaintenanceWindow {
+		// Emergency rollouts, e.g. of CVE fixes, must not wait for the maintenance window of the Central.
+		new.RolledOutCentralVersion = new.DesiredCentralVersion
+		new.RolledOutCentralOperatorVersion = new.DesiredCentralOperatorVersion
+	}
 
 	*request = new
 	return nil
@@ -341,6 +377,9 @@ func (h adminCentralHandler) Update(w http.ResponseWriter, r *http.Request) {
 			if svcErr != nil {
 				return nil, svcErr
 			}
+			if svcErr := validateIfMatch(r, centralRequest); svcErr != nil {
+				return nil, svcErr
+			}
 
 			err := updateCentralRequest(centralRequest, &centralUpdateReq)
 			if err != nil {
@@ -349,14 +388,151 @@ func (h adminCentralHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 			svcErr = h.service.VerifyAndUpdateDinosaurAdmin(ctx, centralRequest)
 			if svcErr != nil {
-				return nil, svcErr
+				return nil, conditionalUpdateError(r, svcErr)
 			}
+			h.centralEventService.RecordAction(ctx, constants.CentralEventTypeAdminAction, id, "Central updated through the admin API")
+			setCentralETag(w, centralRequest)
 			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
 		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
 
+// Migrate moves a Central instance to another data-plane cluster in the same region.
+func (h adminCentralHandler) Migrate(w http.ResponseWriter, r *http.Request) {
+	var migrationRequest private.CentralMigrationRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &migrationRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&migrationRequest.TargetClusterId, "target_cluster_id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, svcErr := h.centralMigrationService.StartMigration(ctx, id, migrationRequest.TargetClusterId)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			h.centralEventService.RecordAction(ctx, constants.CentralEventTypeAdminAction, id, "Migration to cluster %s requested", migrationRequest.TargetClusterId)
+			return presenters.PresentDinosaurRequestAdminEndpoint(centralRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Backup requests an on-demand snapshot of the managed DB of a Central instance.
+func (h adminCentralHandler) Backup(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			backupRequest, svcErr := h.centralBackupService.RequestBackup(r.Context(), id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			h.centralEventService.RecordAction(r.Context(), constants.CentralEventTypeAdminAction, id, "Backup %s requested", backupRequest.ID)
+			return presenters.PresentCentralBackupRequestAdminEndpoint(backupRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Restore requests a restore of the managed DB of a Central instance to a new database.
+func (h adminCentralHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	var restoreRequest private.CentralRestoreRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &restoreRequest,
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			var restoreTime *time.Time
+			if !restoreRequest.RestoreTime.IsZero() {
+				restoreTime = &restoreRequest.RestoreTime
+			}
+			backupRequest, svcErr := h.centralBackupService.RequestRestore(r.Context(), id, restoreRequest.SnapshotId, restoreTime)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			h.centralEventService.RecordAction(r.Context(), constants.CentralEventTypeAdminAction, id, "Restore %s requested", backupRequest.ID)
+			return presenters.PresentCentralBackupRequestAdminEndpoint(backupRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// ListBackupRequests lists the backup and restore requests of a Central instance.
+func (h adminCentralHandler) ListBackupRequests(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			backupRequests, svcErr := h.centralBackupService.ListByCentralID(id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			backupRequestList := private.CentralBackupRequestList{
+				Kind:  "CentralBackupRequestList",
+				Page:  1,
+				Size:  int32(len(backupRequests)),
+				Total: int32(len(backupRequests)),
+				Items: []private.CentralBackupRequest{},
+			}
+			for _, backupRequest := range backupRequests {
+				backupRequestList.Items = append(backupRequestList.Items, presenters.PresentCentralBackupRequestAdminEndpoint(backupRequest))
+			}
+			return backupRequestList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// ListEvents lists the lifecycle events of a Central instance, most recent first. The events of deleted Central
+// instances are listed as well.
+func (h adminCentralHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			if err := listArgs.Validate(); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list central events: %s", err.Error())
+			}
+
+			events, paging, svcErr := h.centralEventService.ListByCentralID(id, listArgs)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			eventList := private.CentralEventList{
+				Kind:          "CentralEventList",
+				Page:          int32(paging.Page),
+				Size:          int32(paging.Size),
+				Total:         int32(paging.Total),
+				NextPageToken: paging.NextPageToken,
+				Items:         []private.CentralEvent{},
+			}
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentCentralEventAdminEndpoint(event))
+			}
+			return handlers.ProjectListFields(eventList, listArgs.Fields)
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// GetBackupRequest returns a backup or restore request of a Central instance.
+func (h adminCentralHandler) GetBackupRequest(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			backupRequestID := mux.Vars(r)["backup_request_id"]
+			backupRequest, svcErr := h.centralBackupService.Get(id, backupRequestID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentCentralBackupRequestAdminEndpoint(backupRequest), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
 func (h adminCentralHandler) SetCentralDefaultVersion(w http.ResponseWriter, r *http.Request) {
 	centralDefaultVersion := &private.CentralDefaultVersion{}
 	cfg := &handlers.HandlerConfig{
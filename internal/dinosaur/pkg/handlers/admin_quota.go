@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type adminQuotaHandler struct {
+	quotaOverrideService services.QuotaOverrideService
+}
+
+// NewAdminQuotaHandler ...
+func NewAdminQuotaHandler(quotaOverrideService services.QuotaOverrideService) *adminQuotaHandler {
+	return &adminQuotaHandler{
+		quotaOverrideService: quotaOverrideService,
+	}
+}
+
+// List lists the quota overrides of all organisations.
+func (h adminQuotaHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			overrides, svcErr := h.quotaOverrideService.List()
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			quotaList := private.OrganisationQuotaList{
+				Kind:  "OrganisationQuotaList",
+				Page:  1,
+				Size:  int32(len(overrides)),
+				Total: int32(len(overrides)),
+				Items: []private.OrganisationQuota{},
+			}
+			for _, override := range overrides {
+				quotaList.Items = append(quotaList.Items, presenters.PresentOrganisationQuota(override))
+			}
+			return quotaList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Create creates the quota override of an organisation.
+func (h adminQuotaHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var quota private.OrganisationQuota
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &quota,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&quota.OrganisationId, "organisation_id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			override := presenters.ConvertOrganisationQuota(quota)
+			if svcErr := h.quotaOverrideService.Create(r.Context(), override); svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentOrganisationQuota(override), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Get returns the quota override of an organisation.
+func (h adminQuotaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	orgID := mux.Vars(r)["organisation_id"]
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			override, svcErr := h.quotaOverrideService.Get(orgID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentOrganisationQuota(override), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Update changes the quota override of an organisation.
+func (h adminQuotaHandler) Update(w http.ResponseWriter, r *http.Request) {
+	orgID := mux.Vars(r)["organisation_id"]
+	var updateRequest private.OrganisationQuotaUpdateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &updateRequest,
+		Action: func() (interface{}, *errors.ServiceError) {
+			override, svcErr := h.quotaOverrideService.Update(r.Context(), orgID, int(updateRequest.MaxAllowedInstances))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentOrganisationQuota(override), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Delete removes the quota override of an organisation.
+func (h adminQuotaHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	orgID := mux.Vars(r)["organisation_id"]
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.quotaOverrideService.Delete(r.Context(), orgID)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// ListHistory lists the changes of the quota overrides of an organisation.
+func (h adminQuotaHandler) ListHistory(w http.ResponseWriter, r *http.Request) {
+	orgID := mux.Vars(r)["organisation_id"]
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			changes, svcErr := h.quotaOverrideService.ListChanges(orgID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			changeList := private.OrganisationQuotaChangeList{
+				Kind:  "OrganisationQuotaChangeList",
+				Page:  1,
+				Size:  int32(len(changes)),
+				Total: int32(len(changes)),
+				Items: []private.OrganisationQuotaChange{},
+			}
+			for _, change := range changes {
+				changeList.Items = append(changeList.Items, presenters.PresentOrganisationQuotaChange(change))
+			}
+			return changeList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
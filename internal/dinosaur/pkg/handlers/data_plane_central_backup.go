@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type dataPlaneCentralBackupHandler struct {
+	backupService services.CentralBackupService
+}
+
+// NewDataPlaneCentralBackupHandler ...
+func NewDataPlaneCentralBackupHandler(backupService services.CentralBackupService) *dataPlaneCentralBackupHandler {
+	return &dataPlaneCentralBackupHandler{
+		backupService: backupService,
+	}
+}
+
+// GetPending returns the backup and restore requests to be carried out on a data-plane cluster.
+func (h *dataPlaneCentralBackupHandler) GetPending(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			backupRequests, err := h.backupService.ListPendingByClusterID(clusterID)
+			if err != nil {
+				return nil, err
+			}
+
+			backupRequestList := private.ManagedCentralBackupRequestList{
+				Kind:  "ManagedCentralBackupRequestList",
+				Items: []private.ManagedCentralBackupRequest{},
+			}
+			for _, backupRequest := range backupRequests {
+				backupRequestList.Items = append(backupRequestList.Items, presenters.PresentManagedCentralBackupRequest(backupRequest))
+			}
+			return backupRequestList, nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// UpdateStatus updates the status of a backup or restore request as reported by a data-plane cluster.
+func (h *dataPlaneCentralBackupHandler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	backupRequestID := mux.Vars(r)["backup_request_id"]
+	var statusUpdate private.ManagedCentralBackupRequestStatusUpdateRequest
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &statusUpdate,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&statusUpdate.Status, "status", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			status := dinosaurConstants.CentralBackupRequestStatus(statusUpdate.Status)
+			err := h.backupService.UpdateStatus(clusterID, backupRequestID, status, statusUpdate.FailedReason)
+			return nil, err
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type dataPlaneCentralWatchHandler struct {
+	watchService services.DataPlaneCentralWatchService
+	presenter    *presenters.ManagedCentralPresenter
+}
+
+// NewDataPlaneCentralWatchHandler ...
+func NewDataPlaneCentralWatchHandler(watchService services.DataPlaneCentralWatchService, presenter *presenters.ManagedCentralPresenter) *dataPlaneCentralWatchHandler {
+	return &dataPlaneCentralWatchHandler{
+		watchService: watchService,
+		presenter:    presenter,
+	}
+}
+
+// Watch is a long-poll watch on the ManagedCentrals of a data-plane cluster.
+func (h *dataPlaneCentralWatchHandler) Watch(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	query := r.URL.Query()
+	resourceVersion := query.Get("resource_version")
+	var timeout time.Duration
+
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+			func() *errors.ServiceError {
+				value := query.Get("timeout_seconds")
+				if value == "" {
+					return nil
+				}
+				seconds, err := strconv.Atoi(value)
+				if err != nil || seconds < 0 {
+					return errors.BadRequest("timeout_seconds must be a non-negative integer")
+				}
+				timeout = time.Duration(seconds) * time.Second
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			result, svcErr := h.watchService.Watch(r.Context(), clusterID, resourceVersion, timeout, h.presenter.PresentManagedCentral)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			eventList := private.ManagedCentralWatchEventList{
+				Kind:            "ManagedCentralWatchEventList",
+				ResourceVersion: result.ResourceVersion,
+				Items:           []private.WatchEvent{},
+			}
+			for _, event := range result.Events {
+				var object interface{} = private.PrivateObjectReference{Id: event.CentralID, Kind: "ManagedCentral"}
+				if event.Central != nil {
+					object = event.Central
+				}
+				converted, err := toWatchEventObject(object)
+				if err != nil {
+					return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present watch event of central %q", event.CentralID)
+				}
+				eventList.Items = append(eventList.Items, private.WatchEvent{
+					Type:            string(event.Type),
+					ResourceVersion: event.ResourceVersion,
+					Object:          converted,
+				})
+			}
+			return eventList, nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func toWatchEventObject(object interface{}) (*map[string]interface{}, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	converted := map[string]interface{}{}
+	if err := json.Unmarshal(data, &converted); err != nil {
+		return nil, err
+	}
+	return &converted, nil
+}
@@ -65,7 +65,7 @@ func (h *dataPlaneDinosaurHandler) GetAll(w http.ResponseWriter, r *http.Request
 			}
 
 			for i := range centralRequests {
-				converted := h.presenter.PresentManagedCentral(centralRequests[i])
+				converted := h.presenter.PresentManagedCentral(centralRequests[i], clusterID)
 				managedDinosaurList.Items = append(managedDinosaurList.Items, converted)
 			}
 			return managedDinosaurList, nil
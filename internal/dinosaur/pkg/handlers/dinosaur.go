@@ -1,13 +1,17 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/shared/utils/arrays"
 
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
@@ -19,24 +23,32 @@ import (
 	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
 )
 
+var deletionStatuses = []string{
+	constants.CentralRequestStatusPendingDeletion.String(),
+	constants.CentralRequestStatusDeprovision.String(),
+	constants.CentralRequestStatusDeleting.String(),
+}
+
 type dinosaurHandler struct {
 	service              services.DinosaurService
 	providerConfig       *config.ProviderConfig
 	authService          authorization.Authorization
 	telemetry            *services.Telemetry
 	centralRequestConfig *config.CentralRequestConfig
+	centralEventService  services.CentralEventService
 }
 
 // NewDinosaurHandler ...
 func NewDinosaurHandler(service services.DinosaurService, providerConfig *config.ProviderConfig,
 	authService authorization.Authorization, telemetry *services.Telemetry,
-	centralRequestConfig *config.CentralRequestConfig) *dinosaurHandler {
+	centralRequestConfig *config.CentralRequestConfig, centralEventService services.CentralEventService) *dinosaurHandler {
 	return &dinosaurHandler{
 		service:              service,
 		providerConfig:       providerConfig,
 		authService:          authService,
 		telemetry:            telemetry,
 		centralRequestConfig: centralRequestConfig,
+		centralEventService:  centralEventService,
 	}
 }
 
@@ -64,6 +76,12 @@ func validateScannerResourcesUnspecified(dinosaurRequest *public.CentralRequestP
 	}
 }
 
+func validateCentralUpdateResourcesUnspecified(centralUpdatePayload *public.CentralUpdatePayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		return validateScannerResourcesUnspecified(&public.CentralRequestPayload{Scanner: centralUpdatePayload.Scanner})()
+	}
+}
+
 // Create ...
 func (h dinosaurHandler) Create(w http.ResponseWriter, r *http.Request) {
 	var centralRequest public.CentralRequestPayload
@@ -82,6 +100,8 @@ func (h dinosaurHandler) Create(w http.ResponseWriter, r *http.Request) {
 			handlers.ValidateMultiAZEnabled(&centralRequest.MultiAz, "creating central requests"),
 			validateCentralResourcesUnspecified(&centralRequest),
 			validateScannerResourcesUnspecified(&centralRequest),
+			ValidateCentralLabels(&centralRequest.Labels),
+			ValidateCentralMaintenanceWindow(&centralRequest),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			// Set the central request as internal, **iff** the user agent used within the creation request is contained
@@ -116,6 +136,7 @@ func (h dinosaurHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			setCentralETag(w, dinosaurRequest)
 			return presenters.PresentCentralRequest(dinosaurRequest), nil
 		},
 	}
@@ -139,12 +160,179 @@ func (h dinosaurHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			if !centralRequest.Internal {
 				h.telemetry.TrackDeletionRequested(ctx, id, false, err.AsError())
 			}
+			if err == nil {
+				h.centralEventService.RecordAction(ctx, constants.CentralEventTypeUserAction, id, "Deletion requested")
+			}
 			return nil, err
 		},
 	}
 	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
 }
 
+// Restore is the handler for restoring a central request during its deletion grace period
+func (h dinosaurHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, svcErr := h.service.Get(ctx, id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			if svcErr := ValidateCentralOwnership(ctx, centralRequest)(); svcErr != nil {
+				return nil, svcErr
+			}
+			if svcErr := h.service.RestoreDinosaur(centralRequest); svcErr != nil {
+				return nil, svcErr
+			}
+			h.centralEventService.RecordAction(ctx, constants.CentralEventTypeUserAction, id, "Deletion cancelled")
+			setCentralETag(w, centralRequest)
+			return presenters.PresentCentralRequest(centralRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Update is the handler for updating the user modifiable settings of a central request
+func (h dinosaurHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var centralUpdatePayload public.CentralUpdatePayload
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &centralUpdatePayload,
+		Validate: []handlers.Validate{
+			validateCentralUpdateResourcesUnspecified(&centralUpdatePayload),
+			ValidateCentralDisplayName(&centralUpdatePayload.DisplayName),
+			ValidateMaintenanceWindow(&centralUpdatePayload),
+			ValidateCentralLabels(&centralUpdatePayload.Labels),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			centralRequest, svcErr := h.service.Get(ctx, id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			if svcErr := ValidateCentralOwnership(ctx, centralRequest)(); svcErr != nil {
+				return nil, svcErr
+			}
+			if arrays.Contains(deletionStatuses, centralRequest.Status) {
+				return nil, errors.BadRequest("central %s is being deleted and cannot be updated", id)
+			}
+			if svcErr := validateIfMatch(r, centralRequest); svcErr != nil {
+				return nil, svcErr
+			}
+
+			updates := map[string]interface{}{}
+			// The scanner specification is only validated and written if it is part of the request, so that other
+			// settings can still be updated for centrals whose scanner exceeds the current replicas limit.
+			if centralUpdatePayload.Scanner.Analyzer.Scaling != (public.ScannerSpecAnalyzerScaling{}) {
+				if svcErr := h.updateScannerScaling(ctx, centralRequest, centralUpdatePayload.Scanner.Analyzer.Scaling); svcErr != nil {
+					return nil, svcErr
+				}
+				updates["scanner"] = centralRequest.Scanner
+			}
+			if centralUpdatePayload.DisplayName != nil {
+				centralRequest.DisplayName = *centralUpdatePayload.DisplayName
+				updates["display_name"] = centralRequest.DisplayName
+			}
+			if centralUpdatePayload.MaintenanceWindow != nil {
+				updateMaintenanceWindowFromPublicAPI(centralRequest, *centralUpdatePayload.MaintenanceWindow)
+				updates["maintenance_window_day"] = centralRequest.MaintenanceWindowDay
+				updates["maintenance_window_start_time"] = centralRequest.MaintenanceWindowStartTime
+				updates["maintenance_window_duration_hours"] = centralRequest.MaintenanceWindowDurationHours
+				updates["rolled_out_central_version"] = centralRequest.RolledOutCentralVersion
+				updates["rolled_out_central_operator_version"] = centralRequest.RolledOutCentralOperatorVersion
+			}
+			if centralUpdatePayload.Labels != nil {
+				if err := centralRequest.SetLabels(centralUpdatePayload.Labels); err != nil {
+					return nil, errors.NewWithCause(errors.ErrorGeneral, err, "setting labels of central %s", id)
+				}
+				updates["labels"] = centralRequest.Labels
+			}
+			if centralUpdatePayload.DeletionProtection != nil {
+				centralRequest.DeletionProtection = *centralUpdatePayload.DeletionProtection
+				updates["deletion_protection"] = centralRequest.DeletionProtection
+			}
+
+			if len(updates) == 0 {
+				setCentralETag(w, centralRequest)
+				return presenters.PresentCentralRequest(centralRequest), nil
+			}
+
+			if svcErr := h.service.Updates(centralRequest, updates); svcErr != nil {
+				return nil, conditionalUpdateError(r, svcErr)
+			}
+			h.centralEventService.RecordAction(ctx, constants.CentralEventTypeUserAction, id, "Central settings updated")
+			setCentralETag(w, centralRequest)
+			return presenters.PresentCentralRequest(centralRequest), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// updateScannerScaling applies the given scaling settings on top of the current scanner specification of the central,
+// which is then validated the same way as the specification of a newly created central.
+func (h dinosaurHandler) updateScannerScaling(ctx context.Context, centralRequest *dbapi.CentralRequest, scaling public.ScannerSpecAnalyzerScaling) *errors.ServiceError {
+	scannerSpec, err := centralRequest.GetScannerSpec()
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "retrieving ScannerSpec of central %s", centralRequest.ID)
+	}
+	centralRequestPayload := public.CentralRequestPayload{
+		Scanner: public.ScannerSpec{
+			Analyzer: public.ScannerSpecAnalyzer{
+				Resources: converters.ConvertCoreV1ResourceRequirementsToPublic(&scannerSpec.Analyzer.Resources),
+				Scaling:   converters.ConvertScalingToPublic(&scannerSpec.Analyzer.Scaling),
+			},
+			Db: public.ScannerSpecDb{
+				Resources: converters.ConvertCoreV1ResourceRequirementsToPublic(&scannerSpec.Db.Resources),
+			},
+		},
+	}
+	updateScannerAnalyzerScalingFromPublicAPI(&centralRequestPayload.Scanner.Analyzer.Scaling, scaling)
+
+	for _, validate := range []handlers.Validate{
+		ValidateScannerSpec(ctx, &centralRequestPayload, centralRequest),
+		ValidateScannerAnalyzerReplicasLimit(centralRequest, h.centralRequestConfig.MaxScannerAnalyzerReplicas),
+	} {
+		if svcErr := validate(); svcErr != nil {
+			return svcErr
+		}
+	}
+	return nil
+}
+
+// updateMaintenanceWindowFromPublicAPI sets or, if the given window is empty, removes the maintenance window
+// of the central. When a maintenance window is added, the currently rolled out versions are kept until the window starts.
+func updateMaintenanceWindowFromPublicAPI(centralRequest *dbapi.CentralRequest, window public.MaintenanceWindow) {
+	if window == (public.MaintenanceWindow{}) {
+		centralRequest.MaintenanceWindowDay = ""
+		centralRequest.MaintenanceWindowStartTime = ""
+		centralRequest.MaintenanceWindowDurationHours = 0
+		centralRequest.RolledOutCentralVersion = ""
+		centralRequest.RolledOutCentralOperatorVersion = ""
+		return
+	}
+	centralRequest.RolledOutCentralVersion = centralRequest.GetRolledOutCentralVersion()
+	centralRequest.RolledOutCentralOperatorVersion = centralRequest.GetRolledOutCentralOperatorVersion()
+	centralRequest.MaintenanceWindowDay = strings.ToLower(window.DayOfWeek)
+	centralRequest.MaintenanceWindowStartTime = window.StartTime
+	centralRequest.MaintenanceWindowDurationHours = window.DurationHours
+}
+
+func updateScannerAnalyzerScalingFromPublicAPI(s *public.ScannerSpecAnalyzerScaling, apiScaling public.ScannerSpecAnalyzerScaling) {
+	if apiScaling.AutoScaling != "" {
+		s.AutoScaling = apiScaling.AutoScaling
+	}
+	if apiScaling.MaxReplicas > 0 {
+		s.MaxReplicas = apiScaling.MaxReplicas
+	}
+	if apiScaling.MinReplicas > 0 {
+		s.MinReplicas = apiScaling.MinReplicas
+	}
+	if apiScaling.Replicas > 0 {
+		s.Replicas = apiScaling.Replicas
+	}
+}
+
 // List ...
 func (h dinosaurHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
@@ -163,11 +351,12 @@ func (h dinosaurHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			dinosaurRequestList := public.CentralRequestList{
-				Kind:  "CentralRequestList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []public.CentralRequest{},
+				Kind:          "CentralRequestList",
+				Page:          int32(paging.Page),
+				Size:          int32(paging.Size),
+				Total:         int32(paging.Total),
+				NextPageToken: paging.NextPageToken,
+				Items:         []public.CentralRequest{},
 			}
 
 			for _, dinosaurRequest := range dinosaurRequests {
@@ -175,7 +364,46 @@ func (h dinosaurHandler) List(w http.ResponseWriter, r *http.Request) {
 				dinosaurRequestList.Items = append(dinosaurRequestList.Items, converted)
 			}
 
-			return dinosaurRequestList, nil
+			return handlers.ProjectListFields(dinosaurRequestList, listArgs.Fields)
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+// Events lists the lifecycle events of a central request, most recent first.
+func (h dinosaurHandler) Events(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			if err := listArgs.Validate(); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list central events: %s", err.Error())
+			}
+
+			// Get restricts the events to the centrals of the organisation of the user.
+			if _, svcErr := h.service.Get(ctx, id); svcErr != nil {
+				return nil, svcErr
+			}
+			events, paging, svcErr := h.centralEventService.ListByCentralID(id, listArgs)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			eventList := public.CentralEventList{
+				Kind:          "CentralEventList",
+				Page:          int32(paging.Page),
+				Size:          int32(paging.Size),
+				Total:         int32(paging.Total),
+				NextPageToken: paging.NextPageToken,
+				Items:         []public.CentralEvent{},
+			}
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentCentralEvent(event))
+			}
+			return handlers.ProjectListFields(eventList, listArgs.Fields)
 		},
 	}
 
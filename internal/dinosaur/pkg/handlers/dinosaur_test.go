@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateRejectsTooLongDisplayName(t *testing.T) {
+	service := &services.DinosaurServiceMock{}
+	handler := NewDinosaurHandler(service, nil, nil, nil, nil, nil)
+
+	body := fmt.Sprintf(`{"display_name": %q}`, strings.Repeat("a", MaxCentralDisplayNameLength+1))
+	r := httptest.NewRequest(http.MethodPatch, "/api/rhacs/v1/centrals/central-id", strings.NewReader(body))
+	r = mux.SetURLVars(r, map[string]string{"id": "central-id"})
+	w := httptest.NewRecorder()
+
+	handler.Update(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "display_name")
+	assert.Empty(t, service.GetCalls())
+}
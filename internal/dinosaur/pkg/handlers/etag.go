@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+)
+
+const (
+	// ETagHeader is the response header with the version of a central.
+	ETagHeader = "ETag"
+	// IfMatchHeader is the request header with the ETags an update is conditional on.
+	IfMatchHeader = "If-Match"
+)
+
+// centralETag returns the entity tag of the current version of the central.
+func centralETag(centralRequest *dbapi.CentralRequest) string {
+	return strconv.Quote(strconv.FormatInt(centralRequest.Version, 10))
+}
+
+// setCentralETag sets the ETag header of the response to the version of the central.
+func setCentralETag(w http.ResponseWriter, centralRequest *dbapi.CentralRequest) {
+	w.Header().Set(ETagHeader, centralETag(centralRequest))
+}
+
+// validateIfMatch checks that the If-Match header of the request, if set, matches the version of the central.
+// Weak entity tags never match, see RFC 7232 section 3.1.
+func validateIfMatch(r *http.Request, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+	ifMatch := r.Header.Get(IfMatchHeader)
+	if ifMatch == "" || strings.TrimSpace(ifMatch) == "*" {
+		return nil
+	}
+	etag := centralETag(centralRequest)
+	for _, tag := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(tag) == etag {
+			return nil
+		}
+	}
+	return errors.PreconditionFailed("central %s was modified, its current ETag is %s", centralRequest.ID, etag)
+}
+
+// conditionalUpdateError converts the conflict of a concurrent update of a central to a failed precondition if
+// the update was conditional on the version of the central.
+func conditionalUpdateError(r *http.Request, svcErr *errors.ServiceError) *errors.ServiceError {
+	if svcErr.Code == errors.ErrorConflict && r.Header.Get(IfMatchHeader) != "" {
+		return errors.NewWithCause(errors.ErrorPreconditionFailed, svcErr, "central was modified concurrently")
+	}
+	return svcErr
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIfMatch(t *testing.T) {
+	centralRequest := &dbapi.CentralRequest{Meta: api.Meta{ID: "central-id"}, Version: 3}
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		wantErr bool
+	}{
+		{name: "should pass without If-Match header"},
+		{name: "should pass for any version", ifMatch: "*"},
+		{name: "should pass for the current version", ifMatch: `"3"`},
+		{name: "should pass if the current version is in the list", ifMatch: `"1", "3"`},
+		{name: "should fail for weak entity tags", ifMatch: `W/"3"`, wantErr: true},
+		{name: "should fail for a previous version", ifMatch: `"2"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPatch, "/", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set(IfMatchHeader, tt.ifMatch)
+			}
+			svcErr := validateIfMatch(r, centralRequest)
+			if tt.wantErr {
+				require.NotNil(t, svcErr)
+				assert.Equal(t, serviceErrors.ErrorPreconditionFailed, svcErr.Code)
+				assert.Equal(t, http.StatusPreconditionFailed, svcErr.HTTPCode)
+			} else {
+				assert.Nil(t, svcErr)
+			}
+		})
+	}
+}
+
+func TestConditionalUpdateError(t *testing.T) {
+	conflict := serviceErrors.Conflict("central was modified concurrently")
+
+	r := httptest.NewRequest(http.MethodPatch, "/", nil)
+	assert.Equal(t, conflict, conditionalUpdateError(r, conflict))
+
+	r.Header.Set(IfMatchHeader, `"3"`)
+	assert.Equal(t, serviceErrors.ErrorPreconditionFailed, conditionalUpdateError(r, conflict).Code)
+
+	general := serviceErrors.GeneralError("database unavailable")
+	assert.Equal(t, general, conditionalUpdateError(r, general))
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header with the client generated key of a request that may be retried.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses that were recorded for an earlier request with the same key.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// NewIdempotencyMiddleware returns a middleware that executes a request with an Idempotency-Key header at most once.
+// Retries of a successful request with the same key and body return the recorded response, retries with a
+// different body are rejected. Failed requests are not recorded, so that they can be retried with the same key.
+// Requests without the header are passed through unchanged.
+func NewIdempotencyMiddleware(service services.IdempotencyKeyService, endpoint string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				shared.HandleError(r, w, errors.BadRequest("%s header must not be longer than %d characters",
+					IdempotencyKeyHeader, maxIdempotencyKeyLength))
+				return
+			}
+
+			scope, svcErr := idempotencyScope(r)
+			if svcErr != nil {
+				shared.HandleError(r, w, svcErr)
+				return
+			}
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				shared.HandleError(r, w, errors.NewWithCause(errors.ErrorMalformedRequest, err, "unable to read request body"))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := sha256.Sum256(body)
+
+			record, created, svcErr := service.Begin(scope, endpoint, key, hex.EncodeToString(hash[:]))
+			if svcErr != nil {
+				shared.HandleError(r, w, svcErr)
+				return
+			}
+			if !created {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set(IdempotentReplayedHeader, "true")
+				w.WriteHeader(record.StatusCode)
+				_, _ = w.Write([]byte(record.ResponseBody))
+				return
+			}
+
+			recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+
+			if recorder.statusCode >= 200 && recorder.statusCode < 300 {
+				svcErr = service.Complete(record, recorder.statusCode, recorder.body.Bytes())
+			} else {
+				svcErr = service.Release(record)
+			}
+			if svcErr != nil {
+				// The response was already sent. A retry with the same key either fails with a conflict until the
+				// reservation times out, or is executed again.
+				glog.Errorf("recording response of %s request with idempotency key %q: %v", endpoint, key, svcErr)
+			}
+		})
+	}
+}
+
+// idempotencyScope returns the organisation of the user, or the user if it is not part of an organisation.
+// Keys of different scopes never collide.
+func idempotencyScope(r *http.Request) (string, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(r.Context())
+	if err != nil {
+		return "", errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	if orgID, _ := claims.GetOrgID(); orgID != "" {
+		return "org:" + orgID, nil
+	}
+	username, _ := claims.GetUsername()
+	if username == "" {
+		return "", errors.Unauthenticated("user not authenticated")
+	}
+	return "user:" + username, nil
+}
+
+// responseRecorder passes a response through to the client and keeps a copy of its status code and body.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+// WriteHeader ...
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write ...
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b) //nolint:wrapcheck
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIdempotentRequest(key, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/centrals?async=true", strings.NewReader(body))
+	if key != "" {
+		r.Header.Set(IdempotencyKeyHeader, key)
+	}
+	ctx := auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: jwt.MapClaims{"username": "user", "org_id": "org-id"}})
+	return r.WithContext(ctx)
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		key            string
+		handlerStatus  int
+		begin          func(scope, endpoint, key, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceErrors.ServiceError)
+		wantStatus     int
+		wantBody       string
+		wantExecuted   bool
+		wantCompleted  bool
+		wantReleased   bool
+		wantReplayed   bool
+		wantBeginCalls int
+	}{
+		{
+			name:          "should pass through requests without key",
+			handlerStatus: http.StatusAccepted,
+			wantStatus:    http.StatusAccepted,
+			wantBody:      `{"id":"new"}`,
+			wantExecuted:  true,
+		},
+		{
+			name:          "should record the response of the first request",
+			key:           "key",
+			handlerStatus: http.StatusAccepted,
+			begin: func(scope, endpoint, key, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceErrors.ServiceError) {
+				return &dbapi.IdempotencyKey{Scope: scope, Endpoint: endpoint, Key: key, RequestHash: requestHash}, true, nil
+			},
+			wantStatus:     http.StatusAccepted,
+			wantBody:       `{"id":"new"}`,
+			wantExecuted:   true,
+			wantCompleted:  true,
+			wantBeginCalls: 1,
+		},
+		{
+			name:          "should release the key of a failed request",
+			key:           "key",
+			handlerStatus: http.StatusBadRequest,
+			begin: func(scope, endpoint, key, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceErrors.ServiceError) {
+				return &dbapi.IdempotencyKey{Scope: scope, Endpoint: endpoint, Key: key, RequestHash: requestHash}, true, nil
+			},
+			wantStatus:     http.StatusBadRequest,
+			wantBody:       `{"id":"new"}`,
+			wantExecuted:   true,
+			wantReleased:   true,
+			wantBeginCalls: 1,
+		},
+		{
+			name: "should replay the recorded response of a retry",
+			key:  "key",
+			begin: func(scope, endpoint, key, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceErrors.ServiceError) {
+				return &dbapi.IdempotencyKey{StatusCode: http.StatusAccepted, ResponseBody: `{"id":"original"}`}, false, nil
+			},
+			wantStatus:     http.StatusAccepted,
+			wantBody:       `{"id":"original"}`,
+			wantReplayed:   true,
+			wantBeginCalls: 1,
+		},
+		{
+			name: "should reject a key reused with a different body",
+			key:  "key",
+			begin: func(scope, endpoint, key, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceErrors.ServiceError) {
+				return nil, false, serviceErrors.New(serviceErrors.ErrorIdempotencyKeyReused, "reused")
+			},
+			wantStatus:     http.StatusUnprocessableEntity,
+			wantBeginCalls: 1,
+		},
+		{
+			name:       "should reject keys that are too long",
+			key:        strings.Repeat("k", maxIdempotencyKeyLength+1),
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &services.IdempotencyKeyServiceMock{
+				BeginFunc: tt.begin,
+				CompleteFunc: func(record *dbapi.IdempotencyKey, statusCode int, responseBody []byte) *serviceErrors.ServiceError {
+					return nil
+				},
+				ReleaseFunc: func(record *dbapi.IdempotencyKey) *serviceErrors.ServiceError {
+					return nil
+				},
+			}
+			executed := false
+			handler := NewIdempotencyMiddleware(service, "create-central")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				executed = true
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, `{"name":"central"}`, string(body))
+				w.WriteHeader(tt.handlerStatus)
+				_, _ = w.Write([]byte(`{"id":"new"}`))
+			}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newIdempotentRequest(tt.key, `{"name":"central"}`))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			}
+			assert.Equal(t, tt.wantExecuted, executed)
+			assert.Equal(t, tt.wantReplayed, w.Header().Get(IdempotentReplayedHeader) == "true")
+			require.Len(t, service.BeginCalls(), tt.wantBeginCalls)
+			if tt.wantBeginCalls > 0 {
+				assert.Equal(t, "org:org-id", service.BeginCalls()[0].Scope)
+				assert.Equal(t, "create-central", service.BeginCalls()[0].Endpoint)
+			}
+			if tt.wantCompleted {
+				require.Len(t, service.CompleteCalls(), 1)
+				assert.Equal(t, http.StatusAccepted, service.CompleteCalls()[0].StatusCode)
+				assert.Equal(t, `{"id":"new"}`, string(service.CompleteCalls()[0].ResponseBody))
+			} else {
+				assert.Empty(t, service.CompleteCalls())
+			}
+			assert.Equal(t, tt.wantReleased, len(service.ReleaseCalls()) == 1)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+	"golang.org/x/time/rate"
+)
+
+// RetryAfterHeader tells clients of rejected requests how many seconds to wait before retrying.
+const RetryAfterHeader = "Retry-After"
+
+// rateLimitSweepInterval is the minimum time between removals of the limiters of idle organisations.
+const rateLimitSweepInterval = time.Minute
+
+// NewRateLimitMiddleware returns a middleware that limits the rate and the number of concurrent requests of each
+// organisation to the route class, as configured in rateLimitConfig. Requests exceeding a limit are rejected with
+// 429 Too Many Requests and a Retry-After header. Requests of users without an organisation are limited per user,
+// and unauthenticated requests are passed through unchanged.
+func NewRateLimitMiddleware(rateLimitConfig *config.RateLimitConfig, class string) func(http.Handler) http.Handler {
+	limiter := newRateLimiter(rateLimitConfig, class, time.Now)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !rateLimitConfig.Enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+			claims, err := auth.GetClaimsFromContext(r.Context())
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			orgID, _ := claims.GetOrgID()
+			key := "org:" + orgID
+			if orgID == "" {
+				username, _ := claims.GetUsername()
+				key = "user:" + username
+			}
+
+			release, retryAfter, svcErr := limiter.acquire(key, orgID)
+			if svcErr != nil {
+				w.Header().Set(RetryAfterHeader, strconv.Itoa(retryAfter))
+				shared.HandleError(r, w, svcErr)
+				return
+			}
+			defer release()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type rateLimiter struct {
+	config *config.RateLimitConfig
+	class  string
+	now    func() time.Time
+
+	mu        sync.Mutex
+	limiters  map[string]*organisationLimiter
+	lastSweep time.Time
+}
+
+type organisationLimiter struct {
+	limit    config.RateLimit
+	rate     *rate.Limiter
+	inFlight int
+}
+
+func newRateLimiter(rateLimitConfig *config.RateLimitConfig, class string, now func() time.Time) *rateLimiter {
+	return &rateLimiter{
+		config:    rateLimitConfig,
+		class:     class,
+		now:       now,
+		limiters:  map[string]*organisationLimiter{},
+		lastSweep: now(),
+	}
+}
+
+// acquire admits a request of the caller identified by key. It returns a function that must be called once the
+// request was processed, or the number of seconds after which the request should be retried and the error to
+// reject the request with.
+func (l *rateLimiter) acquire(key, orgID string) (func(), int, *errors.ServiceError) {
+	limit, limited := l.config.GetRateLimit(l.class, orgID)
+	if !limited {
+		return func() {}, 0, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.sweep(now)
+
+	limiter, ok := l.limiters[key]
+	if !ok {
+		limiter = &organisationLimiter{limit: limit, rate: rate.NewLimiter(rate.Inf, 0)}
+		if limit.RequestsPerSecond > 0 {
+			limiter.rate = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.Burst)
+		}
+		l.limiters[key] = limiter
+	}
+
+	if limit.MaxConcurrent > 0 && limiter.inFlight >= limit.MaxConcurrent {
+		metrics.IncreaseRateLimitedRequestsTotal(l.class, metrics.RateLimitReasonConcurrency)
+		return nil, 1, errors.New(errors.ErrorRateLimitExceeded,
+			"maximum number of %d concurrent %s requests exceeded", limit.MaxConcurrent, l.class)
+	}
+	reservation := limiter.rate.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); !reservation.OK() || delay > 0 {
+		reservation.CancelAt(now)
+		metrics.IncreaseRateLimitedRequestsTotal(l.class, metrics.RateLimitReasonRate)
+		return nil, int(math.Ceil(delay.Seconds())), errors.New(errors.ErrorRateLimitExceeded,
+			"rate limit of %g %s requests per second exceeded", limit.RequestsPerSecond, l.class)
+	}
+
+	limiter.inFlight++
+	metrics.AddRateLimitConcurrentRequests(l.class, 1)
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		limiter.inFlight--
+		metrics.AddRateLimitConcurrentRequests(l.class, -1)
+	}, 0, nil
+}
+
+// sweep removes the limiters of organisations without requests in flight whose token bucket is full, as they
+// are indistinguishable from new limiters. Must be called with l.mu held.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, limiter := range l.limiters {
+		if limiter.inFlight > 0 {
+			continue
+		}
+		if limiter.limit.RequestsPerSecond == 0 || limiter.rate.TokensAt(now) >= float64(limiter.limit.Burst) {
+			delete(l.limiters, key)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRateLimitConfig() *config.RateLimitConfig {
+	return &config.RateLimitConfig{
+		Enabled: true,
+		RateLimitConfiguration: config.RateLimitConfiguration{
+			Classes: map[string]config.RateLimit{
+				config.CentralCreateRateLimitClass: {RequestsPerSecond: 0.5, Burst: 2},
+				config.MetricsRateLimitClass:       {MaxConcurrent: 1},
+			},
+			Organisations: []config.OrganisationRateLimits{
+				{
+					OrganisationID: "unlimited-org",
+					Classes: map[string]config.RateLimit{
+						config.CentralCreateRateLimitClass: {},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newRateLimitedRequest(orgID string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/centrals?async=true", nil)
+	ctx := auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: jwt.MapClaims{"username": "user", "org_id": orgID}})
+	return r.WithContext(ctx)
+}
+
+func TestRateLimiter_LimitsRatePerOrganisation(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(newRateLimitConfig(), config.CentralCreateRateLimitClass, func() time.Time { return now })
+
+	for i := 0; i < 2; i++ {
+		release, _, svcErr := limiter.acquire("org:org-id", "org-id")
+		require.Nil(t, svcErr)
+		release()
+	}
+	_, retryAfter, svcErr := limiter.acquire("org:org-id", "org-id")
+	require.NotNil(t, svcErr)
+	assert.Equal(t, serviceErrors.ErrorRateLimitExceeded, svcErr.Code)
+	assert.Equal(t, 2, retryAfter)
+
+	_, _, svcErr = limiter.acquire("org:other-org-id", "other-org-id")
+	assert.Nil(t, svcErr, "other organisations should not be limited")
+	for i := 0; i < 5; i++ {
+		_, _, svcErr = limiter.acquire("org:unlimited-org", "unlimited-org")
+		assert.Nil(t, svcErr, "overridden organisations should not be limited")
+	}
+
+	now = now.Add(2 * time.Second)
+	_, _, svcErr = limiter.acquire("org:org-id", "org-id")
+	assert.Nil(t, svcErr, "requests should be admitted once the bucket was refilled")
+}
+
+func TestRateLimiter_LimitsConcurrentRequests(t *testing.T) {
+	limiter := newRateLimiter(newRateLimitConfig(), config.MetricsRateLimitClass, time.Now)
+
+	release, _, svcErr := limiter.acquire("org:org-id", "org-id")
+	require.Nil(t, svcErr)
+	_, retryAfter, svcErr := limiter.acquire("org:org-id", "org-id")
+	require.NotNil(t, svcErr)
+	assert.Equal(t, serviceErrors.ErrorRateLimitExceeded, svcErr.Code)
+	assert.Equal(t, 1, retryAfter)
+
+	release()
+	_, _, svcErr = limiter.acquire("org:org-id", "org-id")
+	assert.Nil(t, svcErr)
+}
+
+func TestRateLimiter_RemovesIdleLimiters(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(newRateLimitConfig(), config.CentralCreateRateLimitClass, func() time.Time { return now })
+
+	release, _, svcErr := limiter.acquire("org:org-id", "org-id")
+	require.Nil(t, svcErr)
+	release()
+	require.Len(t, limiter.limiters, 1)
+
+	now = now.Add(rateLimitSweepInterval)
+	_, _, svcErr = limiter.acquire("org:other-org-id", "other-org-id")
+	require.Nil(t, svcErr)
+	assert.NotContains(t, limiter.limiters, "org:org-id")
+	assert.Contains(t, limiter.limiters, "org:other-org-id")
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	rateLimitConfig := newRateLimitConfig()
+	handler := NewRateLimitMiddleware(rateLimitConfig, config.CentralCreateRateLimitClass)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		}))
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRateLimitedRequest("org-id"))
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newRateLimitedRequest("org-id"))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get(RetryAfterHeader))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/centrals?async=true", nil))
+	assert.Equal(t, http.StatusAccepted, w.Code, "unauthenticated requests should be passed through")
+
+	rateLimitConfig.Enabled = false
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRateLimitedRequest("org-id"))
+	assert.Equal(t, http.StatusAccepted, w.Code, "requests should not be limited if rate limiting is disabled")
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
@@ -15,6 +18,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
 	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
@@ -27,6 +31,12 @@ var (
 	// MaxCentralNameLength ...
 	MaxCentralNameLength = 32
 
+	// MaxCentralDisplayNameLength is the maximum number of characters of the display name of a central.
+	MaxCentralDisplayNameLength = 64
+
+	// MaxCentralLabels is the maximum number of user-defined labels of a central.
+	MaxCentralLabels = 50
+
 	supportedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
 )
 
@@ -104,6 +114,13 @@ func ValidateDinosaurClaims(ctx context.Context, dinosaurRequestPayload *public.
 		dinosaurRequest.CloudProvider = dinosaurRequestPayload.CloudProvider
 		dinosaurRequest.MultiAZ = dinosaurRequestPayload.MultiAz
 		dinosaurRequest.CloudAccountID = dinosaurRequestPayload.CloudAccountId
+		dinosaurRequest.DeletionProtection = dinosaurRequestPayload.DeletionProtection
+		if err := dinosaurRequest.SetLabels(dinosaurRequestPayload.Labels); err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "setting labels of central request")
+		}
+		if dinosaurRequestPayload.MaintenanceWindow != nil {
+			updateMaintenanceWindowFromPublicAPI(dinosaurRequest, *dinosaurRequestPayload.MaintenanceWindow)
+		}
 
 		claims, err := auth.GetClaimsFromContext(ctx)
 		if err != nil {
@@ -285,3 +302,112 @@ func validateCentralVersionString(version string) handlers.Validate {
 	}
 
 }
+
+// ValidateCentralOwnership validates that the authenticated user is allowed to modify the given central. This is the
+// case for the administrator of the owner organisation and for the owner user of the central.
+func ValidateCentralOwnership(ctx context.Context, centralRequest *dbapi.CentralRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		claims, err := auth.GetClaimsFromContext(ctx)
+		if err != nil {
+			return errors.Unauthenticated("user not authenticated")
+		}
+
+		orgID, _ := claims.GetOrgID()
+		if orgID != centralRequest.OrganisationID {
+			return errors.NotFound("CentralResource with id='%s' not found", centralRequest.ID)
+		}
+		if claims.IsOrgAdmin() {
+			return nil
+		}
+		if user, _ := claims.GetUsername(); user != centralRequest.Owner {
+			return errors.Forbidden("only the owner or an organisation administrator may modify central %s", centralRequest.ID)
+		}
+		return nil
+	}
+}
+
+// ValidateScannerAnalyzerReplicasLimit validates that the Scanner Analyzer scaling configuration of the central
+// does not exceed the given maximum number of replicas.
+func ValidateScannerAnalyzerReplicasLimit(centralRequest *dbapi.CentralRequest, maxReplicas int32) handlers.Validate {
+	return func() *errors.ServiceError {
+		scannerSpec, err := centralRequest.GetScannerSpec()
+		if err != nil {
+			return errors.Validation("invalid value as Scanner spec: %v", err)
+		}
+		scaling := scannerSpec.Analyzer.Scaling
+		if err := ValidateScannerAnalyzerScaling(&scaling); err != nil {
+			return errors.Validation("%v", err)
+		}
+		if scaling.MaxReplicas > maxReplicas {
+			return errors.Validation("invalid scaling configuration: MaxReplicas (%v) exceeds the allowed maximum of %v replicas", scaling.MaxReplicas, maxReplicas)
+		}
+		return nil
+	}
+}
+
+// ValidateMaintenanceWindow validates the maintenance window of the central update payload. An empty maintenance
+// window is valid and removes the maintenance window of the central.
+func ValidateMaintenanceWindow(centralUpdatePayload *public.CentralUpdatePayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		return validateMaintenanceWindow(centralUpdatePayload.MaintenanceWindow)
+	}
+}
+
+// ValidateCentralMaintenanceWindow validates the maintenance window of the central request payload, if it is set.
+func ValidateCentralMaintenanceWindow(centralRequestPayload *public.CentralRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		return validateMaintenanceWindow(centralRequestPayload.MaintenanceWindow)
+	}
+}
+
+func validateMaintenanceWindow(window *public.MaintenanceWindow) *errors.ServiceError {
+	if window == nil || *window == (public.MaintenanceWindow{}) {
+		return nil
+	}
+	if _, err := dbapi.ParseMaintenanceWindow(window.DayOfWeek, window.StartTime, window.DurationHours); err != nil {
+		return errors.Validation("%v", err)
+	}
+	return nil
+}
+
+// ValidateCentralDisplayName validates the display name of a central, if it is set. An empty display name is valid
+// and resets the display name to the name of the central. The display name is only read when validating, so that it
+// can point into a payload which is decoded after the validation was set up.
+func ValidateCentralDisplayName(displayName **string) handlers.Validate {
+	return func() *errors.ServiceError {
+		if *displayName == nil {
+			return nil
+		}
+		if utf8.RuneCountInString(**displayName) > MaxCentralDisplayNameLength {
+			return errors.Validation("display_name must not be longer than %d characters", MaxCentralDisplayNameLength)
+		}
+		for _, r := range **displayName {
+			if !unicode.IsPrint(r) {
+				return errors.Validation("display_name must only contain printable characters")
+			}
+		}
+		return nil
+	}
+}
+
+// ValidateCentralLabels validates the user-defined labels of a central. The label keys must not have a prefix, as
+// they are prefixed when propagated to the data plane as annotations.
+func ValidateCentralLabels(labels *map[string]string) handlers.Validate {
+	return func() *errors.ServiceError {
+		if len(*labels) > MaxCentralLabels {
+			return errors.Validation("at most %d labels can be set", MaxCentralLabels)
+		}
+		for key, value := range *labels {
+			if strings.Contains(key, "/") {
+				return errors.Validation("invalid label key %q: must not have a prefix", key)
+			}
+			if msgs := validation.IsQualifiedName(key); len(msgs) > 0 {
+				return errors.Validation("invalid label key %q: %s", key, strings.Join(msgs, "; "))
+			}
+			if msgs := validation.IsValidLabelValue(value); len(msgs) > 0 {
+				return errors.Validation("invalid value of label %q: %s", key, strings.Join(msgs, "; "))
+			}
+		}
+		return nil
+	}
+}
@@ -2,19 +2,25 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/onsi/gomega"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/public"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
 	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
+	"k8s.io/utils/pointer"
 )
 
 func Test_Validation_validateDinosaurClusterNameIsUnique(t *testing.T) {
@@ -335,3 +341,236 @@ func Test_Validation_validateCloudProvider(t *testing.T) {
 		})
 	}
 }
+
+func Test_Validation_ValidateCentralOwnership(t *testing.T) {
+	centralRequest := &dbapi.CentralRequest{
+		Meta:           api.Meta{ID: "central-id"},
+		Owner:          "owner",
+		OrganisationID: "org-id",
+	}
+
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		wantCode int
+	}{
+		{
+			name:   "owner of the central is allowed",
+			claims: jwt.MapClaims{"username": "owner", "org_id": "org-id"},
+		},
+		{
+			name:   "organisation administrator is allowed",
+			claims: jwt.MapClaims{"username": "admin", "org_id": "org-id", "is_org_admin": true},
+		},
+		{
+			name:     "other user of the organisation is forbidden",
+			claims:   jwt.MapClaims{"username": "other", "org_id": "org-id"},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "administrator of another organisation does not find the central",
+			claims:   jwt.MapClaims{"username": "owner", "org_id": "other-org-id", "is_org_admin": true},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			ctx := auth.SetTokenInContext(context.TODO(), &jwt.Token{Claims: tt.claims})
+			err := ValidateCentralOwnership(ctx, centralRequest)()
+			if tt.wantCode == 0 {
+				gomega.Expect(err).To(gomega.BeNil())
+				return
+			}
+			gomega.Expect(err).ToNot(gomega.BeNil())
+			gomega.Expect(err.HTTPCode).To(gomega.Equal(tt.wantCode))
+		})
+	}
+}
+
+func Test_Validation_ValidateScannerAnalyzerReplicasLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		scaling dbapi.ScannerAnalyzerScaling
+		wantErr bool
+	}{
+		{
+			name:    "scaling within the limit is valid",
+			scaling: dbapi.ScannerAnalyzerScaling{AutoScaling: "Enabled", MinReplicas: 1, Replicas: 2, MaxReplicas: 5},
+		},
+		{
+			name:    "scaling exceeding the limit is invalid",
+			scaling: dbapi.ScannerAnalyzerScaling{AutoScaling: "Enabled", MinReplicas: 1, Replicas: 2, MaxReplicas: 6},
+			wantErr: true,
+		},
+		{
+			name:    "inconsistent scaling is invalid",
+			scaling: dbapi.ScannerAnalyzerScaling{AutoScaling: "Enabled", MinReplicas: 3, Replicas: 2, MaxReplicas: 4},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			centralRequest := &dbapi.CentralRequest{}
+			scannerSpec := dbapi.DefaultScannerSpec
+			scannerSpec.Analyzer.Scaling = tt.scaling
+			gomega.Expect(centralRequest.SetScannerSpec(&scannerSpec)).To(gomega.Succeed())
+
+			err := ValidateScannerAnalyzerReplicasLimit(centralRequest, 5)()
+			if tt.wantErr {
+				gomega.Expect(err).ToNot(gomega.BeNil())
+				gomega.Expect(err.Code).To(gomega.Equal(errors.ErrorValidation))
+			} else {
+				gomega.Expect(err).To(gomega.BeNil())
+			}
+		})
+	}
+}
+
+func Test_Validation_ValidateMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  *public.MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name: "no maintenance window is valid",
+		},
+		{
+			name:   "empty maintenance window is valid",
+			window: &public.MaintenanceWindow{},
+		},
+		{
+			name:   "complete maintenance window is valid",
+			window: &public.MaintenanceWindow{DayOfWeek: "sunday", StartTime: "22:00", DurationHours: 4},
+		},
+		{
+			name:    "unknown day is invalid",
+			window:  &public.MaintenanceWindow{DayOfWeek: "someday", StartTime: "22:00", DurationHours: 4},
+			wantErr: true,
+		},
+		{
+			name:    "missing duration is invalid",
+			window:  &public.MaintenanceWindow{DayOfWeek: "sunday", StartTime: "22:00"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			for _, validate := range []handlers.Validate{
+				ValidateMaintenanceWindow(&public.CentralUpdatePayload{MaintenanceWindow: tt.window}),
+				ValidateCentralMaintenanceWindow(&public.CentralRequestPayload{MaintenanceWindow: tt.window}),
+			} {
+				err := validate()
+				if tt.wantErr {
+					gomega.Expect(err).ToNot(gomega.BeNil())
+					gomega.Expect(err.Code).To(gomega.Equal(errors.ErrorValidation))
+				} else {
+					gomega.Expect(err).To(gomega.BeNil())
+				}
+			}
+		})
+	}
+}
+
+func Test_Validation_ValidateCentralDisplayName(t *testing.T) {
+	tests := []struct {
+		name        string
+		displayName *string
+		wantErr     bool
+	}{
+		{
+			name: "no display name is valid",
+		},
+		{
+			name:        "empty display name is valid",
+			displayName: pointer.String(""),
+		},
+		{
+			name:        "display name with spaces and unicode is valid",
+			displayName: pointer.String("Production Central – EMEA"),
+		},
+		{
+			name:        "display name longer than 64 characters is invalid",
+			displayName: pointer.String(strings.Repeat("a", MaxCentralDisplayNameLength+1)),
+			wantErr:     true,
+		},
+		{
+			name:        "display name with control characters is invalid",
+			displayName: pointer.String("prod\ncentral"),
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			err := ValidateCentralDisplayName(&tt.displayName)()
+			if tt.wantErr {
+				gomega.Expect(err).ToNot(gomega.BeNil())
+				gomega.Expect(err.Code).To(gomega.Equal(errors.ErrorValidation))
+			} else {
+				gomega.Expect(err).To(gomega.BeNil())
+			}
+		})
+	}
+}
+
+func Test_Validation_ValidateCentralLabels(t *testing.T) {
+	tooManyLabels := map[string]string{}
+	for i := 0; i <= MaxCentralLabels; i++ {
+		tooManyLabels[fmt.Sprintf("label-%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr bool
+	}{
+		{
+			name: "no labels are valid",
+		},
+		{
+			name:   "kubernetes label names and values are valid",
+			labels: map[string]string{"env": "prod", "cost-center": "", "team.name": "sec_ops"},
+		},
+		{
+			name:    "prefixed key is invalid",
+			labels:  map[string]string{"example.com/env": "prod"},
+			wantErr: true,
+		},
+		{
+			name:    "key with spaces is invalid",
+			labels:  map[string]string{"my env": "prod"},
+			wantErr: true,
+		},
+		{
+			name:    "value longer than 63 characters is invalid",
+			labels:  map[string]string{"env": strings.Repeat("a", 64)},
+			wantErr: true,
+		},
+		{
+			name:    "too many labels are invalid",
+			labels:  tooManyLabels,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			err := ValidateCentralLabels(&tt.labels)()
+			if tt.wantErr {
+				gomega.Expect(err).ToNot(gomega.BeNil())
+				gomega.Expect(err.Code).To(gomega.Equal(errors.ErrorValidation))
+			} else {
+				gomega.Expect(err).To(gomega.BeNil())
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
+)
+
+type webhookHandler struct {
+	service services.WebhookService
+}
+
+// NewWebhookHandler ...
+func NewWebhookHandler(service services.WebhookService) *webhookHandler {
+	return &webhookHandler{service: service}
+}
+
+// Create ...
+func (h webhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request public.WebhookSubscriptionRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&request.Url, "url", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			subscription := presenters.ConvertWebhookSubscriptionRequest(request)
+			if svcErr := h.service.Create(r.Context(), subscription); svcErr != nil {
+				return nil, svcErr
+			}
+			result := presenters.PresentWebhookSubscription(subscription)
+			result.Secret = subscription.Secret
+			return result, nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Get ...
+func (h webhookHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			subscription, svcErr := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentWebhookSubscription(subscription), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// List ...
+func (h webhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			if err := listArgs.Validate(); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list webhook subscriptions: %s", err.Error())
+			}
+			subscriptions, paging, svcErr := h.service.List(r.Context(), listArgs)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			subscriptionList := public.WebhookSubscriptionList{
+				Kind:          "WebhookSubscriptionList",
+				Page:          int32(paging.Page),
+				Size:          int32(paging.Size),
+				Total:         int32(paging.Total),
+				NextPageToken: paging.NextPageToken,
+				Items:         []public.WebhookSubscription{},
+			}
+			for _, subscription := range subscriptions {
+				subscriptionList.Items = append(subscriptionList.Items, presenters.PresentWebhookSubscription(subscription))
+			}
+			return handlers.ProjectListFields(subscriptionList, listArgs.Fields)
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Delete ...
+func (h webhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.service.Delete(r.Context(), mux.Vars(r)["id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+func addMigrationToCentralRequest() *gormigrate.Migration {
+	type CentralRequest struct {
+		db.Model
+		MigrationStatus          string `json:"migration_status"`
+		MigrationSourceClusterID string `json:"migration_source_cluster_id" gorm:"index"`
+		MigrationTargetClusterID string `json:"migration_target_cluster_id" gorm:"index"`
+	}
+	newColumns := []string{"MigrationStatus", "MigrationSourceClusterID", "MigrationTargetClusterID"}
+	newIndexes := []string{"MigrationSourceClusterID", "MigrationTargetClusterID"}
+
+	return &gormigrate.Migration{
+		ID: "202305020000",
+		Migrate: func(tx *gorm.DB) error {
+			for _, col := range newColumns {
+				if tx.Migrator().HasColumn(&CentralRequest{}, col) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&CentralRequest{}, col); err != nil {
+					return fmt.Errorf("adding new column %q: %w", col, err)
+				}
+			}
+			for _, idx := range newIndexes {
+				if tx.Migrator().HasIndex(&CentralRequest{}, idx) {
+					continue
+				}
+				if err := tx.Migrator().CreateIndex(&CentralRequest{}, idx); err != nil {
+					return fmt.Errorf("creating index for %q: %w", idx, err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, col := range newColumns {
+				if !tx.Migrator().HasColumn(&CentralRequest{}, col) {
+					continue
+				}
+				if err := tx.Migrator().DropColumn(&CentralRequest{}, col); err != nil {
+					return fmt.Errorf("removing column %q: %w", col, err)
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+func addCordonAndDrainToClusters() *gormigrate.Migration {
+	type Cluster struct {
+		db.Model
+		Cordoned bool `json:"cordoned"`
+		Draining bool `json:"draining"`
+	}
+	newColumns := []string{"Cordoned", "Draining"}
+
+	return &gormigrate.Migration{
+		ID: "202305030000",
+		Migrate: func(tx *gorm.DB) error {
+			for _, col := range newColumns {
+				if tx.Migrator().HasColumn(&Cluster{}, col) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&Cluster{}, col); err != nil {
+					return fmt.Errorf("adding new column %q: %w", col, err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, col := range newColumns {
+				if !tx.Migrator().HasColumn(&Cluster{}, col) {
+					continue
+				}
+				if err := tx.Migrator().DropColumn(&Cluster{}, col); err != nil {
+					return fmt.Errorf("removing column %q: %w", col, err)
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addCentralRequestsChangeNotification adds a trigger that sends a notification on the
+// central_requests_changed channel whenever central_requests are written. Data-plane watch
+// requests wait for this notification instead of polling the table.
+func addCentralRequestsChangeNotification() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202305040000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Exec(`
+CREATE OR REPLACE FUNCTION notify_central_requests_changed() RETURNS trigger AS $$
+BEGIN
+	PERFORM pg_notify('central_requests_changed', '');
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`).Error; err != nil {
+				return fmt.Errorf("creating function notify_central_requests_changed: %w", err)
+			}
+			if err := tx.Exec(`
+DROP TRIGGER IF EXISTS central_requests_changed ON central_requests;
+CREATE TRIGGER central_requests_changed
+	AFTER INSERT OR UPDATE OR DELETE ON central_requests
+	FOR EACH STATEMENT EXECUTE PROCEDURE notify_central_requests_changed();`).Error; err != nil {
+				return fmt.Errorf("creating trigger central_requests_changed: %w", err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec("DROP TRIGGER IF EXISTS central_requests_changed ON central_requests").Error; err != nil {
+				return fmt.Errorf("dropping trigger central_requests_changed: %w", err)
+			}
+			if err := tx.Exec("DROP FUNCTION IF EXISTS notify_central_requests_changed()").Error; err != nil {
+				return fmt.Errorf("dropping function notify_central_requests_changed: %w", err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+func addMaintenanceWindowToCentralRequest() *gormigrate.Migration {
+	type CentralRequest struct {
+		db.Model
+		MaintenanceWindowDay           string `json:"maintenance_window_day"`
+		MaintenanceWindowStartTime     string `json:"maintenance_window_start_time"`
+		MaintenanceWindowDurationHours int32  `json:"maintenance_window_duration_hours"`
+		RolledOutCentralVersion        string `json:"rolled_out_central_version"`
+	}
+	newColumns := []string{"MaintenanceWindowDay", "MaintenanceWindowStartTime", "MaintenanceWindowDurationHours", "RolledOutCentralVersion"}
+
+	return &gormigrate.Migration{
+		ID: "202305050000",
+		Migrate: func(tx *gorm.DB) error {
+			for _, col := range newColumns {
+				if tx.Migrator().HasColumn(&CentralRequest{}, col) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&CentralRequest{}, col); err != nil {
+					return fmt.Errorf("adding new column %q: %w", col, err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, col := range newColumns {
+				if !tx.Migrator().HasColumn(&CentralRequest{}, col) {
+					continue
+				}
+				if err := tx.Migrator().DropColumn(&CentralRequest{}, col); err != nil {
+					return fmt.Errorf("removing column %q: %w", col, err)
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+func addCentralBackupRequests() *gormigrate.Migration {
+	type CentralBackupRequest struct {
+		db.Model
+		CentralID        string     `json:"central_id" gorm:"index"`
+		ClusterID        string     `json:"cluster_id" gorm:"index"`
+		Type             string     `json:"type"`
+		Status           string     `json:"status" gorm:"index"`
+		SnapshotID       string     `json:"snapshot_id"`
+		RestoreTime      *time.Time `json:"restore_time"`
+		TargetDatabaseID string     `json:"target_database_id"`
+		FailedReason     string     `json:"failed_reason"`
+	}
+	migrationID := "202305060000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralBackupRequest{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralBackupRequest{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
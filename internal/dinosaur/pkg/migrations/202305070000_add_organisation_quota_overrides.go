@@ -0,0 +1,49 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addOrganisationQuotaOverrides() *gormigrate.Migration {
+	type OrganisationQuotaOverride struct {
+		OrganisationID      string `json:"organisation_id" gorm:"primaryKey"`
+		MaxAllowedInstances int    `json:"max_allowed_instances"`
+		CreatedAt           time.Time
+		UpdatedAt           time.Time
+	}
+	type OrganisationQuotaOverrideChange struct {
+		ID                          uint64 `json:"id" gorm:"primarykey"`
+		CreatedAt                   time.Time
+		OrganisationID              string `json:"organisation_id" gorm:"index"`
+		Action                      string `json:"action"`
+		MaxAllowedInstances         int    `json:"max_allowed_instances"`
+		PreviousMaxAllowedInstances int    `json:"previous_max_allowed_instances"`
+		ChangedBy                   string `json:"changed_by"`
+	}
+	migrationID := "202305070000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&OrganisationQuotaOverride{}, &OrganisationQuotaOverrideChange{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&OrganisationQuotaOverrideChange{}, &OrganisationQuotaOverride{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
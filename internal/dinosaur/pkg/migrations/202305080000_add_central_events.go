@@ -0,0 +1,121 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addCentralEvents adds the central_events table and a trigger that records every change of the status, versions,
+// placement and migration phase of central_requests in it. Recording the changes in the database instead of in
+// fleet-manager catches every code path, including the bulk updates of the deprovisioning workers.
+func addCentralEvents() *gormigrate.Migration {
+	type CentralEvent struct {
+		ID        uint64 `json:"id" gorm:"primarykey"`
+		CreatedAt time.Time
+		CentralID string `json:"central_id" gorm:"index"`
+		Type      string `json:"type"`
+		Message   string `json:"message"`
+		Actor     string `json:"actor"`
+	}
+	migrationID := "202305080000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralEvent{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			if err := tx.Exec(`
+CREATE OR REPLACE FUNCTION record_central_request_events() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP = 'INSERT' THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'created',
+			format('Central %s requested in region %s on cluster %s', NEW.name, NEW.region, NEW.cluster_id), NEW.owner);
+		RETURN NULL;
+	END IF;
+
+	IF OLD.status IS DISTINCT FROM NEW.status THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'status_changed',
+			format('Status changed from %s to %s', OLD.status, NEW.status) ||
+				CASE WHEN NEW.status = 'failed' AND NEW.failed_reason <> '' THEN format(': %s', NEW.failed_reason) ELSE '' END,
+			'fleet-manager');
+	END IF;
+	IF OLD.desired_central_version IS DISTINCT FROM NEW.desired_central_version THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'version_changed',
+			format('Desired Central version changed from %s to %s', OLD.desired_central_version, NEW.desired_central_version),
+			'fleet-manager');
+	END IF;
+	IF OLD.rolled_out_central_version IS DISTINCT FROM NEW.rolled_out_central_version AND NEW.rolled_out_central_version <> '' THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'version_changed',
+			format('Central version %s rolled out', NEW.rolled_out_central_version), 'fleet-manager');
+	END IF;
+	IF OLD.actual_central_version IS DISTINCT FROM NEW.actual_central_version THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'version_changed',
+			format('Actual Central version changed from %s to %s', OLD.actual_central_version, NEW.actual_central_version),
+			'fleet-manager');
+	END IF;
+	IF OLD.desired_central_operator_version IS DISTINCT FROM NEW.desired_central_operator_version THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'version_changed',
+			format('Desired Central operator version changed from %s to %s', OLD.desired_central_operator_version, NEW.desired_central_operator_version),
+			'fleet-manager');
+	END IF;
+	IF OLD.placement_id IS DISTINCT FROM NEW.placement_id OR OLD.cluster_id IS DISTINCT FROM NEW.cluster_id THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'placed',
+			format('Placed on cluster %s with placement ID %s', NEW.cluster_id, NEW.placement_id), 'fleet-manager');
+	END IF;
+	IF OLD.migration_status IS DISTINCT FROM NEW.migration_status THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'migration_changed',
+			CASE WHEN NEW.migration_status = ''
+				THEN 'Migration completed'
+				ELSE format('Migration to cluster %s is in phase %s', NEW.migration_target_cluster_id, NEW.migration_status)
+			END,
+			'fleet-manager');
+	END IF;
+	IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+		INSERT INTO central_events (created_at, central_id, type, message, actor)
+		VALUES (now(), NEW.id, 'deleted', 'Central deleted', 'fleet-manager');
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`).Error; err != nil {
+				return fmt.Errorf("creating function record_central_request_events: %w", err)
+			}
+			if err := tx.Exec(`
+DROP TRIGGER IF EXISTS central_request_events ON central_requests;
+CREATE TRIGGER central_request_events
+	AFTER INSERT OR UPDATE ON central_requests
+	FOR EACH ROW EXECUTE PROCEDURE record_central_request_events();`).Error; err != nil {
+				return fmt.Errorf("creating trigger central_request_events: %w", err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec("DROP TRIGGER IF EXISTS central_request_events ON central_requests").Error; err != nil {
+				return fmt.Errorf("dropping trigger central_request_events: %w", err)
+			}
+			if err := tx.Exec("DROP FUNCTION IF EXISTS record_central_request_events()").Error; err != nil {
+				return fmt.Errorf("dropping function record_central_request_events: %w", err)
+			}
+			if err := tx.Migrator().DropTable(&CentralEvent{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
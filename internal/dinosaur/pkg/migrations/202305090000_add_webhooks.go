@@ -0,0 +1,122 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// addWebhooks adds the tables of the webhook subscriptions and their deliveries, and a trigger that enqueues a
+// delivery for every subscription of the organisation when a central_requests row becomes ready, failed, is
+// deprovisioned or deleted. Enqueuing in the same transaction as the status change guarantees that no
+// notification is lost when fleet-manager restarts.
+func addWebhooks() *gormigrate.Migration {
+	type WebhookSubscription struct {
+		api.Meta
+		OrganisationID string `json:"organisation_id" gorm:"index"`
+		Owner          string `json:"owner"`
+		URL            string `json:"url"`
+		Secret         string `json:"secret"`
+		EventTypes     string `json:"event_types"`
+	}
+	type WebhookDelivery struct {
+		ID             uint64 `json:"id" gorm:"primarykey"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+		SubscriptionID string    `json:"subscription_id" gorm:"index"`
+		EventType      string    `json:"event_type"`
+		CentralID      string    `json:"central_id"`
+		Payload        string    `json:"payload"`
+		Attempts       int       `json:"attempts"`
+		NextAttemptAt  time.Time `json:"next_attempt_at" gorm:"index"`
+		LastError      string    `json:"last_error"`
+	}
+	type WebhookDeadLetter struct {
+		ID             uint64 `json:"id" gorm:"primarykey;autoIncrement:false"`
+		CreatedAt      time.Time
+		EnqueuedAt     time.Time `json:"enqueued_at"`
+		SubscriptionID string    `json:"subscription_id" gorm:"index"`
+		EventType      string    `json:"event_type"`
+		CentralID      string    `json:"central_id"`
+		Payload        string    `json:"payload"`
+		Attempts       int       `json:"attempts"`
+		LastError      string    `json:"last_error"`
+	}
+	migrationID := "202305090000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&WebhookSubscription{}, &WebhookDelivery{}, &WebhookDeadLetter{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			if err := tx.Exec(`
+CREATE OR REPLACE FUNCTION enqueue_central_webhook_deliveries() RETURNS trigger AS $$
+DECLARE
+	webhook_event text;
+BEGIN
+	IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+		webhook_event := 'central.deleted';
+	ELSIF OLD.status IS DISTINCT FROM NEW.status THEN
+		webhook_event := CASE NEW.status
+			WHEN 'ready' THEN 'central.ready'
+			WHEN 'failed' THEN 'central.failed'
+			WHEN 'deprovision' THEN 'central.deprovisioning'
+		END;
+	END IF;
+	IF webhook_event IS NULL THEN
+		RETURN NULL;
+	END IF;
+
+	INSERT INTO webhook_deliveries (created_at, updated_at, subscription_id, event_type, central_id, payload, attempts, next_attempt_at, last_error)
+	SELECT now(), now(), s.id, webhook_event, NEW.id,
+		json_build_object(
+			'type', webhook_event,
+			'central_id', NEW.id,
+			'central_name', NEW.name,
+			'organisation_id', NEW.organisation_id,
+			'status', NEW.status,
+			'failed_reason', NEW.failed_reason,
+			'occurred_at', now()
+		)::text,
+		0, now(), ''
+	FROM webhook_subscriptions s
+	WHERE s.organisation_id = NEW.organisation_id
+		AND s.deleted_at IS NULL
+		AND (s.event_types = '' OR webhook_event = ANY(string_to_array(s.event_types, ',')));
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`).Error; err != nil {
+				return fmt.Errorf("creating function enqueue_central_webhook_deliveries: %w", err)
+			}
+			if err := tx.Exec(`
+DROP TRIGGER IF EXISTS central_request_webhooks ON central_requests;
+CREATE TRIGGER central_request_webhooks
+	AFTER UPDATE ON central_requests
+	FOR EACH ROW EXECUTE PROCEDURE enqueue_central_webhook_deliveries();`).Error; err != nil {
+				return fmt.Errorf("creating trigger central_request_webhooks: %w", err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec("DROP TRIGGER IF EXISTS central_request_webhooks ON central_requests").Error; err != nil {
+				return fmt.Errorf("dropping trigger central_request_webhooks: %w", err)
+			}
+			if err := tx.Exec("DROP FUNCTION IF EXISTS enqueue_central_webhook_deliveries()").Error; err != nil {
+				return fmt.Errorf("dropping function enqueue_central_webhook_deliveries: %w", err)
+			}
+			if err := tx.Migrator().DropTable(&WebhookSubscription{}, &WebhookDelivery{}, &WebhookDeadLetter{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addIdempotencyKeys() *gormigrate.Migration {
+	type IdempotencyKey struct {
+		ID           uint64 `json:"id" gorm:"primarykey"`
+		CreatedAt    time.Time
+		Scope        string `json:"scope" gorm:"uniqueIndex:uix_idempotency_keys_scope_endpoint_key"`
+		Endpoint     string `json:"endpoint" gorm:"uniqueIndex:uix_idempotency_keys_scope_endpoint_key"`
+		Key          string `json:"key" gorm:"uniqueIndex:uix_idempotency_keys_scope_endpoint_key"`
+		RequestHash  string `json:"request_hash"`
+		StatusCode   int    `json:"status_code"`
+		ResponseBody string `json:"response_body"`
+	}
+	migrationID := "202305100000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&IdempotencyKey{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&IdempotencyKey{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
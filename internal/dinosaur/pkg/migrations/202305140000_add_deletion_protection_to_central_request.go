@@ -0,0 +1,44 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+func addDeletionProtectionToCentralRequest() *gormigrate.Migration {
+	type CentralRequest struct {
+		db.Model
+		DeletionProtection bool `json:"deletion_protection" gorm:"not null;default:false"`
+	}
+	migrationID := "202305140000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&CentralRequest{}, "DeletionProtection") {
+				return nil
+			}
+			if err := tx.Migrator().AddColumn(&CentralRequest{}, "DeletionProtection"); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasColumn(&CentralRequest{}, "DeletionProtection") {
+				return nil
+			}
+			if err := tx.Migrator().DropColumn(&CentralRequest{}, "DeletionProtection"); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
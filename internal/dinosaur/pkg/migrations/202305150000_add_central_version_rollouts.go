@@ -0,0 +1,56 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+func addCentralVersionRollouts() *gormigrate.Migration {
+	type CentralVersionRollout struct {
+		db.Model
+		CentralVersion   string        `json:"central_version"`
+		InstanceType     string        `json:"instance_type"`
+		Region           string        `json:"region"`
+		ClusterID        string        `json:"cluster_id"`
+		OrganisationIDs  string        `json:"organisation_ids"`
+		BatchSize        int           `json:"batch_size"`
+		SoakTime         time.Duration `json:"soak_time"`
+		WaveTimeout      time.Duration `json:"wave_timeout"`
+		MaxFailures      int           `json:"max_failures"`
+		Status           string        `json:"status" gorm:"index"`
+		PauseReason      string        `json:"pause_reason"`
+		CurrentWave      int           `json:"current_wave"`
+		WaveCentralIDs   string        `json:"wave_central_ids"`
+		WaveStartedAt    *time.Time    `json:"wave_started_at"`
+		WaveHealthyAt    *time.Time    `json:"wave_healthy_at"`
+		UpgradedCentrals int           `json:"upgraded_centrals"`
+		FailedCentrals   int           `json:"failed_centrals"`
+		CreatedBy        string        `json:"created_by"`
+	}
+	migrationID := "202305150000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralVersionRollout{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralVersionRollout{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// notifyCentralRequestsChangesPerCluster replaces the statement-level central_requests_changed trigger
+// with a row-level trigger that sends the IDs of the affected data-plane clusters as notification payload,
+// so that only the watch requests of these clusters are woken up. Postgres drops duplicate notifications
+// within a transaction, so bulk updates notify every cluster once.
+func notifyCentralRequestsChangesPerCluster() *gormigrate.Migration {
+	migrationID := "202305180000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Exec(`
+CREATE OR REPLACE FUNCTION notify_central_requests_changed() RETURNS trigger AS $$
+DECLARE
+	cluster_ids text[] := '{}';
+	changed_cluster_id text;
+BEGIN
+	IF TG_OP <> 'DELETE' THEN
+		cluster_ids := cluster_ids || ARRAY[NEW.cluster_id, NEW.migration_source_cluster_id, NEW.migration_target_cluster_id];
+	END IF;
+	IF TG_OP <> 'INSERT' THEN
+		cluster_ids := cluster_ids || ARRAY[OLD.cluster_id, OLD.migration_source_cluster_id, OLD.migration_target_cluster_id];
+	END IF;
+	FOREACH changed_cluster_id IN ARRAY cluster_ids LOOP
+		IF changed_cluster_id IS NOT NULL AND changed_cluster_id <> '' THEN
+			PERFORM pg_notify('central_requests_changed', changed_cluster_id);
+		END IF;
+	END LOOP;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`).Error; err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			if err := tx.Exec(`
+DROP TRIGGER IF EXISTS central_requests_changed ON central_requests;
+CREATE TRIGGER central_requests_changed
+	AFTER INSERT OR UPDATE OR DELETE ON central_requests
+	FOR EACH ROW EXECUTE PROCEDURE notify_central_requests_changed();`).Error; err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec(`
+CREATE OR REPLACE FUNCTION notify_central_requests_changed() RETURNS trigger AS $$
+BEGIN
+	PERFORM pg_notify('central_requests_changed', '');
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`).Error; err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			if err := tx.Exec(`
+DROP TRIGGER IF EXISTS central_requests_changed ON central_requests;
+CREATE TRIGGER central_requests_changed
+	AFTER INSERT OR UPDATE OR DELETE ON central_requests
+	FOR EACH STATEMENT EXECUTE PROCEDURE notify_central_requests_changed();`).Error; err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addCentralWatchSnapshots() *gormigrate.Migration {
+	type CentralWatchSnapshot struct {
+		ClusterID       string    `json:"cluster_id" gorm:"primaryKey"`
+		ResourceVersion string    `json:"resource_version" gorm:"primaryKey"`
+		CreatedAt       time.Time `gorm:"index"`
+		Centrals        api.JSON  `json:"centrals" gorm:"type:jsonb"`
+	}
+	migrationID := "202305180100"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralWatchSnapshot{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralWatchSnapshot{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -43,6 +43,26 @@ func getMigrations() []*gormigrate.Migration {
 		addCentralDefaultVersion(),
 		dropSkipSchedulingFromClusters(),
 		addSchedulableToClusters(),
+		addCapacityToClusters(),
+		addMigrationToCentralRequest(),
+		addCordonAndDrainToClusters(),
+		addCentralRequestsChangeNotification(),
+		addMaintenanceWindowToCentralRequest(),
+		addCentralBackupRequests(),
+		addOrganisationQuotaOverrides(),
+		addCentralEvents(),
+		addWebhooks(),
+		addIdempotencyKeys(),
+		addVersionToCentralRequest(),
+		addLabelsToCentralRequest(),
+		addPendingDeletionUntilToCentralRequest(),
+		addDeletionProtectionToCentralRequest(),
+		addCentralVersionRollouts(),
+		addHealthToCentralRequest(),
+		addDisplayNameToCentralRequest(),
+		notifyCentralRequestsChangesPerCluster(),
+		addCentralWatchSnapshots(),
+		addRolledOutCentralOperatorVersionToCentralRequest(),
 	}
 }
 
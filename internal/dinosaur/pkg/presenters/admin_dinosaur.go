@@ -46,23 +46,65 @@ func PresentDinosaurRequestAdminEndpoint(request *dbapi.CentralRequest, _ accoun
 		}
 	}
 
+	var maintenanceWindow *admin.MaintenanceWindow
+	if request.MaintenanceWindowDay != "" {
+		maintenanceWindow = &admin.MaintenanceWindow{
+			DayOfWeek:     request.MaintenanceWindowDay,
+			StartTime:     request.MaintenanceWindowStartTime,
+			DurationHours: request.MaintenanceWindowDurationHours,
+		}
+	}
+
+	labels, err := request.GetLabels()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal labels %q: %v", request.Labels, err)
+	}
+
+	var adminHealth *admin.CentralHealth
+	health, err := request.GetHealth()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal health %q: %v", request.Health, err)
+	}
+	if health != nil {
+		adminHealth = &admin.CentralHealth{Status: health.Status}
+		for _, c := range health.Conditions {
+			adminHealth.Conditions = append(adminHealth.Conditions, admin.CentralHealthCondition{
+				Type:    c.Type,
+				Status:  c.Status,
+				Reason:  c.Reason,
+				Message: c.Message,
+			})
+		}
+	}
+
 	return &admin.Central{
-		Id:                   request.ID,
-		Kind:                 "CentralRequest",
-		Href:                 fmt.Sprintf("/api/rhacs/v1/centrals/%s", request.ID),
-		Status:               request.Status,
-		CloudProvider:        request.CloudProvider,
-		MultiAz:              request.MultiAZ,
-		Region:               request.Region,
-		Owner:                request.Owner,
-		Name:                 request.Name,
-		Host:                 request.GetUIHost(), // TODO(ROX-11990): Split the Host in Fleet Manager Public API to UI and Data hosts
-		CreatedAt:            request.CreatedAt,
-		UpdatedAt:            request.UpdatedAt,
-		FailedReason:         request.FailedReason,
-		ActualCentralVersion: request.ActualCentralVersion,
-		InstanceType:         request.InstanceType,
-		Central:              adminCentral,
-		Scanner:              adminScanner,
+		Id:                    request.ID,
+		Kind:                  "CentralRequest",
+		Href:                  fmt.Sprintf("/api/rhacs/v1/centrals/%s", request.ID),
+		Status:                request.Status,
+		CloudProvider:         request.CloudProvider,
+		MultiAz:               request.MultiAZ,
+		Region:                request.Region,
+		Owner:                 request.Owner,
+		Name:                  request.Name,
+		Host:                  request.GetUIHost(), // TODO(ROX-11990): Split the Host in Fleet Manager Public API to UI and Data hosts
+		CreatedAt:             request.CreatedAt,
+		UpdatedAt:             request.UpdatedAt,
+		FailedReason:          request.FailedReason,
+		ActualCentralVersion:  request.ActualCentralVersion,
+		DesiredCentralVersion: request.DesiredCentralVersion,
+		InstanceType:          request.InstanceType,
+		ClusterId:             request.ClusterID,
+		Central:               adminCentral,
+		Scanner:               adminScanner,
+
+		MigrationStatus:          request.MigrationStatus,
+		MigrationTargetClusterId: request.MigrationTargetClusterID,
+
+		MaintenanceWindow:       maintenanceWindow,
+		RolledOutCentralVersion: request.GetRolledOutCentralVersion(),
+		Labels:                  labels,
+		DeletionProtection:      request.DeletionProtection,
+		Health:                  adminHealth,
 	}, nil
 }
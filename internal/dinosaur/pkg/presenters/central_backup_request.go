@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"fmt"
+
+	admin "github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+)
+
+// KindCentralBackupRequest is a string identifier for the type dbapi.CentralBackupRequest
+const KindCentralBackupRequest = "CentralBackupRequest"
+
+// PresentManagedCentralBackupRequest presents a dbapi.CentralBackupRequest to fleetshard-sync.
+func PresentManagedCentralBackupRequest(from *dbapi.CentralBackupRequest) private.ManagedCentralBackupRequest {
+	return private.ManagedCentralBackupRequest{
+		Id:               from.ID,
+		Kind:             "ManagedCentralBackupRequest",
+		CentralId:        from.CentralID,
+		Type:             from.Type,
+		Status:           from.Status,
+		SnapshotId:       from.SnapshotID,
+		RestoreTime:      from.RestoreTime,
+		TargetDatabaseId: from.TargetDatabaseID,
+	}
+}
+
+// PresentCentralBackupRequestAdminEndpoint presents a dbapi.CentralBackupRequest as an admin.CentralBackupRequest.
+func PresentCentralBackupRequestAdminEndpoint(from *dbapi.CentralBackupRequest) admin.CentralBackupRequest {
+	return admin.CentralBackupRequest{
+		Id:               from.ID,
+		Kind:             KindCentralBackupRequest,
+		Href:             fmt.Sprintf("%s/admin/centrals/%s/backup-requests/%s", BasePath, from.CentralID, from.ID),
+		CentralId:        from.CentralID,
+		ClusterId:        from.ClusterID,
+		Type:             from.Type,
+		Status:           from.Status,
+		SnapshotId:       from.SnapshotID,
+		RestoreTime:      from.RestoreTime,
+		TargetDatabaseId: from.TargetDatabaseID,
+		FailedReason:     from.FailedReason,
+		CreatedAt:        from.CreatedAt,
+		UpdatedAt:        from.UpdatedAt,
+	}
+}
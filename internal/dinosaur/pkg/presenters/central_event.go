@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	admin "github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/public"
+)
+
+// adminActor replaces the username of the admin who performed an admin action in the public API.
+const adminActor = "admin"
+
+// PresentCentralEvent presents a dbapi.CentralEvent to the users of the public API. The usernames of admins are not
+// disclosed to users.
+func PresentCentralEvent(from *dbapi.CentralEvent) public.CentralEvent {
+	actor := from.Actor
+	if from.Type == constants.CentralEventTypeAdminAction.String() {
+		actor = adminActor
+	}
+	return public.CentralEvent{
+		Id:        int64(from.ID),
+		CentralId: from.CentralID,
+		Type:      from.Type,
+		Message:   from.Message,
+		Actor:     actor,
+		CreatedAt: from.CreatedAt,
+	}
+}
+
+// PresentCentralEventAdminEndpoint presents a dbapi.CentralEvent as an admin.CentralEvent.
+func PresentCentralEventAdminEndpoint(from *dbapi.CentralEvent) admin.CentralEvent {
+	return admin.CentralEvent{
+		Id:        int64(from.ID),
+		CentralId: from.CentralID,
+		Type:      from.Type,
+		Message:   from.Message,
+		Actor:     from.Actor,
+		CreatedAt: from.CreatedAt,
+	}
+}
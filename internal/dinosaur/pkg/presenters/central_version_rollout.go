@@ -0,0 +1,72 @@
+package presenters
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	admin "github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+)
+
+// KindCentralVersionRollout is a string identifier for the type dbapi.CentralVersionRollout
+const KindCentralVersionRollout = "CentralVersionRollout"
+
+// ConvertCentralVersionRolloutRequest converts an admin.CentralVersionRolloutRequest to a dbapi.CentralVersionRollout.
+func ConvertCentralVersionRolloutRequest(from admin.CentralVersionRolloutRequest) (*dbapi.CentralVersionRollout, error) {
+	rollout := &dbapi.CentralVersionRollout{
+		CentralVersion:  from.CentralVersion,
+		InstanceType:    from.Selector.InstanceType,
+		Region:          from.Selector.Region,
+		ClusterID:       from.Selector.ClusterId,
+		OrganisationIDs: strings.Join(from.Selector.OrganisationIds, ","),
+		BatchSize:       int(from.BatchSize),
+		MaxFailures:     int(from.MaxFailures),
+	}
+	if from.SoakTime != "" {
+		soakTime, err := time.ParseDuration(from.SoakTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid soak time %q: %w", from.SoakTime, err)
+		}
+		rollout.SoakTime = soakTime
+	}
+	if from.WaveTimeout != "" {
+		waveTimeout, err := time.ParseDuration(from.WaveTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wave timeout %q: %w", from.WaveTimeout, err)
+		}
+		rollout.WaveTimeout = waveTimeout
+	}
+	return rollout, nil
+}
+
+// PresentCentralVersionRollout presents a dbapi.CentralVersionRollout as an admin.CentralVersionRollout.
+func PresentCentralVersionRollout(from *dbapi.CentralVersionRollout) admin.CentralVersionRollout {
+	return admin.CentralVersionRollout{
+		Id:             from.ID,
+		Kind:           KindCentralVersionRollout,
+		Href:           fmt.Sprintf("%s/admin/central-version-rollouts/%s", BasePath, from.ID),
+		CentralVersion: from.CentralVersion,
+		Selector: admin.CentralVersionRolloutSelector{
+			InstanceType:    from.InstanceType,
+			Region:          from.Region,
+			ClusterId:       from.ClusterID,
+			OrganisationIds: from.GetOrganisationIDs(),
+		},
+		BatchSize:        int32(from.BatchSize),
+		SoakTime:         from.SoakTime.String(),
+		WaveTimeout:      from.WaveTimeout.String(),
+		MaxFailures:      int32(from.MaxFailures),
+		Status:           from.Status,
+		PauseReason:      from.PauseReason,
+		CurrentWave:      int32(from.CurrentWave),
+		WaveCentralIds:   from.GetWaveCentralIDs(),
+		WaveStartedAt:    from.WaveStartedAt,
+		WaveHealthyAt:    from.WaveHealthyAt,
+		UpgradedCentrals: int32(from.UpgradedCentrals),
+		FailedCentrals:   int32(from.FailedCentrals),
+		CreatedBy:        from.CreatedBy,
+		CreatedAt:        from.CreatedAt,
+		UpdatedAt:        from.UpdatedAt,
+	}
+}
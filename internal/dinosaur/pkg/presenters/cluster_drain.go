@@ -0,0 +1,17 @@
+package presenters
+
+import (
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+)
+
+// PresentClusterDrainStatus ...
+func PresentClusterDrainStatus(status *services.ClusterDrainStatus) private.ClusterDrainStatus {
+	return private.ClusterDrainStatus{
+		ClusterId:         status.Cluster.ClusterID,
+		Cordoned:          status.Cluster.Cordoned,
+		Draining:          status.Cluster.Draining,
+		RemainingCentrals: int32(status.RemainingCentrals),
+		MigratingCentrals: int32(status.MigratingCentrals),
+	}
+}
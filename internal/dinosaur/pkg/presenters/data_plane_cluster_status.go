@@ -4,6 +4,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // ConvertDataPlaneClusterStatus ...
@@ -29,6 +30,15 @@ func ConvertDataPlaneClusterStatus(status private.DataPlaneClusterUpdateStatusRe
 			res.AvailableDinosaurOperatorVersions[i].CentralVersions[j] = api.CentralVersion{Version: v}
 		}
 	}
+	if len(status.Resources.Allocatable) > 0 {
+		res.Capacity = &api.ClusterCapacity{
+			AllocatableCPU:    status.Resources.Allocatable[corev1.ResourceCPU.String()],
+			AllocatableMemory: status.Resources.Allocatable[corev1.ResourceMemory.String()],
+			RequestedCPU:      status.Resources.Requested[corev1.ResourceCPU.String()],
+			RequestedMemory:   status.Resources.Requested[corev1.ResourceMemory.String()],
+			RemainingCentrals: int(status.Resources.RemainingCentralCapacity),
+		}
+	}
 	return &res, nil
 }
 
@@ -44,6 +54,12 @@ func PresentDataPlaneClusterConfig(config *dbapi.DataPlaneClusterConfig) private
 			},
 		},
 	}
+	for _, operator := range config.CentralOperators {
+		res.Spec.Operators = append(res.Spec.Operators, private.DataplaneClusterAgentConfigSpecOperators{
+			Version: operator.Version,
+			Image:   operator.Image,
+		})
+	}
 
 	return res
 }
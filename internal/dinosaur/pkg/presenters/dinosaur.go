@@ -3,6 +3,7 @@ package presenters
 import (
 	"fmt"
 
+	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/public"
 )
@@ -37,6 +38,7 @@ func PresentCentralRequest(request *dbapi.CentralRequest) public.CentralRequest
 		Region:         request.Region,
 		Owner:          request.Owner,
 		Name:           request.Name,
+		DisplayName:    request.GetDisplayName(),
 		CreatedAt:      request.CreatedAt,
 		UpdatedAt:      request.UpdatedAt,
 		FailedReason:   request.FailedReason,
@@ -44,6 +46,38 @@ func PresentCentralRequest(request *dbapi.CentralRequest) public.CentralRequest
 		InstanceType:   request.InstanceType,
 	}
 
+	if request.MaintenanceWindowDay != "" {
+		outputRequest.MaintenanceWindow = &public.MaintenanceWindow{
+			DayOfWeek:     request.MaintenanceWindowDay,
+			StartTime:     request.MaintenanceWindowStartTime,
+			DurationHours: request.MaintenanceWindowDurationHours,
+		}
+	}
+
+	labels, err := request.GetLabels()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal labels %q of Central request %s: %v", request.Labels, request.ID, err)
+	}
+	outputRequest.Labels = labels
+	outputRequest.PendingDeletionUntil = request.PendingDeletionUntil
+	outputRequest.DeletionProtection = request.DeletionProtection
+
+	health, err := request.GetHealth()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal health %q of Central request %s: %v", request.Health, request.ID, err)
+	}
+	if health != nil {
+		outputRequest.Health = &public.CentralHealth{Status: health.Status}
+		for _, c := range health.Conditions {
+			outputRequest.Health.Conditions = append(outputRequest.Health.Conditions, public.CentralHealthCondition{
+				Type:    c.Type,
+				Status:  c.Status,
+				Reason:  c.Reason,
+				Message: c.Message,
+			})
+		}
+	}
+
 	if request.RoutesCreated {
 		if request.GetUIHost() != "" {
 			outputRequest.CentralUIURL = fmt.Sprintf("https://%s", request.GetUIHost())
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
@@ -23,8 +24,9 @@ func NewManagedCentralPresenter(config *config.CentralConfig) *ManagedCentralPre
 	return &ManagedCentralPresenter{centralConfig: config}
 }
 
-// PresentManagedCentral converts DB representation of Central to the private API representation
-func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralRequest) private.ManagedCentral {
+// PresentManagedCentral converts DB representation of Central to the private API representation as seen by the
+// data-plane cluster with the given ID
+func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralRequest, clusterID string) private.ManagedCentral {
 	var central dbapi.CentralSpec
 	var scanner dbapi.ScannerSpec
 
@@ -49,6 +51,11 @@ func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralReque
 		}
 	}
 
+	labels, err := from.GetLabels()
+	if err != nil {
+		glog.Errorf("Failed to unmarshal labels of Central request %q/%s, ignoring them: %v", from.Name, from.ClusterID, err)
+	}
+
 	res := private.ManagedCentral{
 		Id:   from.ID,
 		Kind: "ManagedCentral",
@@ -60,6 +67,7 @@ func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralReque
 				MasPlacementId: from.PlacementID,
 			},
 			Internal: from.Internal,
+			Labels:   labels,
 		},
 		Spec: private.ManagedCentralAllOfSpec{
 			Owners: []string{
@@ -85,8 +93,9 @@ func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralReque
 				Host: from.GetDataHost(),
 			},
 			Versions: private.ManagedCentralVersions{
-				DesiredVersion: from.DesiredCentralVersion,
-				ActualVersion:  from.ActualCentralVersion,
+				DesiredVersion:  from.GetRolledOutCentralVersion(),
+				ActualVersion:   from.ActualCentralVersion,
+				OperatorVersion: from.GetRolledOutCentralOperatorVersion(),
 			},
 			Central: private.ManagedCentralAllOfSpecCentral{
 				InstanceType: from.InstanceType,
@@ -142,9 +151,36 @@ func (c *ManagedCentralPresenter) PresentManagedCentral(from *dbapi.CentralReque
 		res.Metadata.DeletionTimestamp = from.DeletionTimestamp.Format(time.RFC3339)
 	}
 
+	presentMigration(&res, from, clusterID)
+
 	return res
 }
 
+// presentMigration tells the data-plane cluster which role it has in an ongoing migration of the Central.
+// The migration source cluster is asked to remove the Central once it is served from the migration target cluster.
+// Centrals being deleted are removed from both clusters without any migration handling.
+func presentMigration(res *private.ManagedCentral, from *dbapi.CentralRequest, clusterID string) {
+	if from.MigrationStatus == "" || from.DeletionTimestamp != nil {
+		return
+	}
+
+	switch clusterID {
+	case from.MigrationSourceClusterID:
+		res.Spec.Migration = private.ManagedCentralAllOfSpecMigration{
+			Role:   constants.CentralMigrationRoleSource,
+			Status: from.MigrationStatus,
+		}
+		if from.MigrationStatus == constants.CentralMigrationStatusSourceTeardown.String() {
+			res.Metadata.DeletionTimestamp = from.UpdatedAt.Format(time.RFC3339)
+		}
+	case from.MigrationTargetClusterID:
+		res.Spec.Migration = private.ManagedCentralAllOfSpecMigration{
+			Role:   constants.CentralMigrationRoleTarget,
+			Status: from.MigrationStatus,
+		}
+	}
+}
+
 func orDefaultQty(qty resource.Quantity, def resource.Quantity) *resource.Quantity {
 	if qty != (resource.Quantity{}) {
 		return &qty
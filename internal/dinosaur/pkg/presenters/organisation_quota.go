@@ -0,0 +1,37 @@
+package presenters
+
+import (
+	admin "github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+)
+
+// ConvertOrganisationQuota converts an admin.OrganisationQuota to a dbapi.OrganisationQuotaOverride.
+func ConvertOrganisationQuota(from admin.OrganisationQuota) *dbapi.OrganisationQuotaOverride {
+	return &dbapi.OrganisationQuotaOverride{
+		OrganisationID:      from.OrganisationId,
+		MaxAllowedInstances: int(from.MaxAllowedInstances),
+	}
+}
+
+// PresentOrganisationQuota presents a dbapi.OrganisationQuotaOverride as an admin.OrganisationQuota.
+func PresentOrganisationQuota(from *dbapi.OrganisationQuotaOverride) admin.OrganisationQuota {
+	return admin.OrganisationQuota{
+		OrganisationId:      from.OrganisationID,
+		MaxAllowedInstances: int32(from.MaxAllowedInstances),
+		CreatedAt:           from.CreatedAt,
+		UpdatedAt:           from.UpdatedAt,
+	}
+}
+
+// PresentOrganisationQuotaChange presents a dbapi.OrganisationQuotaOverrideChange as an admin.OrganisationQuotaChange.
+func PresentOrganisationQuotaChange(from *dbapi.OrganisationQuotaOverrideChange) admin.OrganisationQuotaChange {
+	return admin.OrganisationQuotaChange{
+		Id:                          int64(from.ID),
+		OrganisationId:              from.OrganisationID,
+		Action:                      from.Action,
+		MaxAllowedInstances:         int32(from.MaxAllowedInstances),
+		PreviousMaxAllowedInstances: int32(from.PreviousMaxAllowedInstances),
+		ChangedBy:                   from.ChangedBy,
+		CreatedAt:                   from.CreatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package presenters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/public"
+)
+
+// ConvertWebhookSubscriptionRequest from payload to WebhookSubscription
+func ConvertWebhookSubscriptionRequest(from public.WebhookSubscriptionRequest) *dbapi.WebhookSubscription {
+	return &dbapi.WebhookSubscription{
+		URL:        from.Url,
+		Secret:     from.Secret,
+		EventTypes: strings.Join(from.EventTypes, ","),
+	}
+}
+
+// PresentWebhookSubscription presents a dbapi.WebhookSubscription without its secret. The secret is only
+// returned once, in the response of the creation.
+func PresentWebhookSubscription(from *dbapi.WebhookSubscription) public.WebhookSubscription {
+	subscription := public.WebhookSubscription{
+		Id:        from.ID,
+		Kind:      "WebhookSubscription",
+		Href:      fmt.Sprintf("/api/rhacs/v1/webhooks/%s", from.ID),
+		Url:       from.URL,
+		Owner:     from.Owner,
+		CreatedAt: from.CreatedAt,
+	}
+	if from.EventTypes != "" {
+		subscription.EventTypes = strings.Split(from.EventTypes, ",")
+	}
+	return subscription
+}
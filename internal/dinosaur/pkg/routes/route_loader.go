@@ -44,15 +44,25 @@ type options struct {
 	ProviderConfig       *config.ProviderConfig
 	IAMConfig            *iam.IAMConfig
 	CentralRequestConfig *config.CentralRequestConfig
+	RateLimitConfig      *config.RateLimitConfig
 
 	AMSClient                    ocm.AMSClient
 	Central                      services.DinosaurService
 	CentralDefaultVersionService services.CentralDefaultVersionService
+	CentralMigrationService      services.CentralMigrationService
+	CentralBackupService         services.CentralBackupService
+	CentralEventService          services.CentralEventService
+	ClusterDrainService          services.ClusterDrainService
+	QuotaOverrideService         services.QuotaOverrideService
+	CentralVersionRolloutService services.CentralVersionRolloutService
+	IdempotencyKeyService        services.IdempotencyKeyService
+	WebhookService               services.WebhookService
 	CloudProviders               services.CloudProvidersService
 	Observatorium                services.ObservatoriumService
 	IAM                          sso.IAMService
 	DataPlaneCluster             services.DataPlaneClusterService
 	DataPlaneCentralService      services.DataPlaneCentralService
+	DataPlaneCentralWatchService services.DataPlaneCentralWatchService
 	AccountService               account.AccountService
 	AuthService                  authorization.Authorization
 	DB                           *db.ConnectionFactory
@@ -89,8 +99,9 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	}
 
 	centralHandler := handlers.NewDinosaurHandler(s.Central, s.ProviderConfig, s.AuthService, s.Telemetry,
-		s.CentralRequestConfig)
+		s.CentralRequestConfig, s.CentralEventService)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig)
+	webhookHandler := handlers.NewWebhookHandler(s.WebhookService)
 	errorsHandler := coreHandlers.NewErrorsHandler()
 	metricsHandler := handlers.NewMetricsHandler(s.Observatorium)
 	serviceStatusHandler := handlers.NewServiceStatusHandler(s.Central, s.AccessControlListConfig)
@@ -102,6 +113,18 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 		append(s.IAMConfig.AdditionalSSOIssuers.GetURIs(), s.ServerConfig.TokenIssuerURL), errors.ErrorUnauthenticated)
 	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.AMSClient, errors.ErrorTermsNotAccepted)
 
+	// /agent-clusters/{id}/centrals/watch is added before the base path router, so that long-poll requests
+	// do not hold a database transaction while waiting for changes.
+	dataPlaneCentralWatchHandler := handlers.NewDataPlaneCentralWatchHandler(s.DataPlaneCentralWatchService, s.ManagedCentralPresenter)
+	apiV1DataPlaneWatchRouter := mainRouter.PathPrefix(basePath + "/v1/agent-clusters").Subrouter()
+	apiV1DataPlaneWatchRouter.HandleFunc("/{id}/centrals/watch", dataPlaneCentralWatchHandler.Watch).
+		Name(logger.NewLogEvent("watch-dataplane-centrals", "watch dataplane centrals").ToString()).
+		Methods(http.MethodGet)
+	apiV1DataPlaneWatchRouter.Use(coreHandlers.MetricsMiddleware)
+	// deliberately returns 404 here if the request doesn't have the required role, so that it will appear as if the endpoint doesn't exist
+	auth.UseFleetShardAuthorizationMiddleware(apiV1DataPlaneWatchRouter,
+		s.IAMConfig.RedhatSSORealm.ValidIssuerURI, s.FleetShardAuthZConfig)
+
 	// base path.
 	apiRouter := mainRouter.PathPrefix(basePath).Subrouter()
 
@@ -135,6 +158,15 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1CentralsRouter.HandleFunc("/{id}", centralHandler.Delete).
 		Name(logger.NewLogEvent("delete-central", "delete a central instance").ToString()).
 		Methods(http.MethodDelete)
+	apiV1CentralsRouter.HandleFunc("/{id}", centralHandler.Update).
+		Name(logger.NewLogEvent("update-central", "update a central instance").ToString()).
+		Methods(http.MethodPatch)
+	apiV1CentralsRouter.HandleFunc("/{id}/restore", centralHandler.Restore).
+		Name(logger.NewLogEvent("restore-central", "restore a central instance pending deletion").ToString()).
+		Methods(http.MethodPost)
+	apiV1CentralsRouter.HandleFunc("/{id}/events", centralHandler.Events).
+		Name(logger.NewLogEvent("list-central-events", "list events of a central instance").ToString()).
+		Methods(http.MethodGet)
 	apiV1CentralsRouter.HandleFunc("", centralHandler.List).
 		Name(logger.NewLogEvent("list-central", "list all central").ToString()).
 		Methods(http.MethodGet)
@@ -144,7 +176,12 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 
 	apiV1CentralsCreateRouter := apiV1CentralsRouter.NewRoute().Subrouter()
 	apiV1CentralsCreateRouter.HandleFunc("", centralHandler.Create).Methods(http.MethodPost)
+	apiV1CentralsCreateRouter.Use(handlers.NewRateLimitMiddleware(s.RateLimitConfig, config.CentralCreateRateLimitClass))
 	apiV1CentralsCreateRouter.Use(requireTermsAcceptance)
+	apiV1CentralsCreateRouter.Use(handlers.NewIdempotencyMiddleware(s.IdempotencyKeyService, "create-central"))
+
+	// the metrics endpoints share the limits of the organisation
+	metricsRateLimit := handlers.NewRateLimitMiddleware(s.RateLimitConfig, config.MetricsRateLimitClass)
 
 	//  /centrals/{id}/metrics
 	apiV1MetricsRouter := apiV1CentralsRouter.PathPrefix("/{id}/metrics").Subrouter()
@@ -154,6 +191,7 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1MetricsRouter.HandleFunc("/query", metricsHandler.GetMetricsByInstantQuery).
 		Name(logger.NewLogEvent("get-metrics-instant", "get metrics by instant").ToString()).
 		Methods(http.MethodGet)
+	apiV1MetricsRouter.Use(metricsRateLimit)
 
 	// /centrals/{id}/metrics/federate
 	// federate endpoint separated from the rest of the /centrals endpoints as it needs to support auth from both sso.redhat.com and mas-sso
@@ -167,6 +205,29 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 			s.IAMConfig.RedhatSSORealm.ValidIssuerURI), errors.ErrorUnauthenticated))
 	apiV1MetricsFederateRouter.Use(requireOrgID)
 	apiV1MetricsFederateRouter.Use(authorizeMiddleware)
+	apiV1MetricsFederateRouter.Use(metricsRateLimit)
+
+	//  /webhooks
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "webhooks",
+		Kind: "WebhookSubscriptionList",
+	})
+	apiV1WebhooksRouter := apiV1Router.PathPrefix("/webhooks").Subrouter()
+	apiV1WebhooksRouter.HandleFunc("", webhookHandler.Create).
+		Name(logger.NewLogEvent("create-webhook-subscription", "create a webhook subscription").ToString()).
+		Methods(http.MethodPost)
+	apiV1WebhooksRouter.HandleFunc("", webhookHandler.List).
+		Name(logger.NewLogEvent("list-webhook-subscriptions", "list webhook subscriptions").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhooksRouter.HandleFunc("/{id}", webhookHandler.Get).
+		Name(logger.NewLogEvent("get-webhook-subscription", "get a webhook subscription").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhooksRouter.HandleFunc("/{id}", webhookHandler.Delete).
+		Name(logger.NewLogEvent("delete-webhook-subscription", "delete a webhook subscription").ToString()).
+		Methods(http.MethodDelete)
+	apiV1WebhooksRouter.Use(requireIssuer)
+	apiV1WebhooksRouter.Use(requireOrgID)
+	apiV1WebhooksRouter.Use(authorizeMiddleware)
 
 	//  /cloud_providers
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -206,6 +267,7 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	// /agent-clusters/{id}
 	dataPlaneClusterHandler := handlers.NewDataPlaneClusterHandler(s.DataPlaneCluster)
 	dataPlaneCentralHandler := handlers.NewDataPlaneDinosaurHandler(s.DataPlaneCentralService, s.Central, s.ManagedCentralPresenter)
+	dataPlaneCentralBackupHandler := handlers.NewDataPlaneCentralBackupHandler(s.CentralBackupService)
 	apiV1DataPlaneRequestsRouter := apiV1Router.PathPrefix("/agent-clusters").Subrouter()
 	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}", dataPlaneClusterHandler.GetDataPlaneClusterConfig).
 		Name(logger.NewLogEvent("get-dataplane-cluster-config", "get dataplane cluster config by id").ToString()).
@@ -219,11 +281,18 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/centrals", dataPlaneCentralHandler.GetAll).
 		Name(logger.NewLogEvent("list-dataplane-centrals", "list all dataplane centrals").ToString()).
 		Methods(http.MethodGet)
+	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/central-backup-requests", dataPlaneCentralBackupHandler.GetPending).
+		Name(logger.NewLogEvent("list-dataplane-central-backup-requests", "list pending dataplane central backup requests").ToString()).
+		Methods(http.MethodGet)
+	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/central-backup-requests/{backup_request_id}/status", dataPlaneCentralBackupHandler.UpdateStatus).
+		Name(logger.NewLogEvent("update-dataplane-central-backup-request-status", "update dataplane central backup request status by id").ToString()).
+		Methods(http.MethodPut)
 	// deliberately returns 404 here if the request doesn't have the required role, so that it will appear as if the endpoint doesn't exist
 	auth.UseFleetShardAuthorizationMiddleware(apiV1DataPlaneRequestsRouter,
 		s.IAMConfig.RedhatSSORealm.ValidIssuerURI, s.FleetShardAuthZConfig)
 
-	adminCentralHandler := handlers.NewAdminCentralHandler(s.Central, s.AccountService, s.ProviderConfig, s.Telemetry, s.CentralDefaultVersionService)
+	adminCentralHandler := handlers.NewAdminCentralHandler(s.Central, s.AccountService, s.ProviderConfig, s.Telemetry, s.CentralDefaultVersionService,
+		s.CentralMigrationService, s.CentralBackupService, s.CentralEventService)
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer(
@@ -256,9 +325,88 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string, op
 	adminCentralsRouter.HandleFunc("/{id}", adminCentralHandler.Update).
 		Name(logger.NewLogEvent("admin-update-central", "[admin] update central by id").ToString()).
 		Methods(http.MethodPatch)
+	adminCentralsRouter.HandleFunc("/{id}/migrate", adminCentralHandler.Migrate).
+		Name(logger.NewLogEvent("admin-migrate-central", "[admin] migrate central by id to another cluster").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/backup", adminCentralHandler.Backup).
+		Name(logger.NewLogEvent("admin-backup-central", "[admin] request backup of central by id").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/restore", adminCentralHandler.Restore).
+		Name(logger.NewLogEvent("admin-restore-central", "[admin] request restore of central by id").ToString()).
+		Methods(http.MethodPost)
+	adminCentralsRouter.HandleFunc("/{id}/backup-requests", adminCentralHandler.ListBackupRequests).
+		Name(logger.NewLogEvent("admin-list-central-backup-requests", "[admin] list backup requests of central by id").ToString()).
+		Methods(http.MethodGet)
+	adminCentralsRouter.HandleFunc("/{id}/backup-requests/{backup_request_id}", adminCentralHandler.GetBackupRequest).
+		Name(logger.NewLogEvent("admin-get-central-backup-request", "[admin] get backup request of central by id").ToString()).
+		Methods(http.MethodGet)
+	adminCentralsRouter.HandleFunc("/{id}/events", adminCentralHandler.ListEvents).
+		Name(logger.NewLogEvent("admin-list-central-events", "[admin] list events of central by id").ToString()).
+		Methods(http.MethodGet)
 
 	adminCreateRouter := adminCentralsRouter.NewRoute().Subrouter()
 	adminCreateRouter.HandleFunc("", adminCentralHandler.Create).Methods(http.MethodPost)
+	adminCreateRouter.Use(handlers.NewIdempotencyMiddleware(s.IdempotencyKeyService, "admin-create-central"))
+
+	adminClusterHandler := handlers.NewAdminClusterHandler(s.ClusterDrainService, s.AccountService)
+	adminClustersRouter := adminRouter.PathPrefix("/clusters").Subrouter()
+	adminClustersRouter.HandleFunc("/{id}/centrals", adminClusterHandler.ListCentrals).
+		Name(logger.NewLogEvent("admin-list-cluster-centrals", "[admin] list centrals of cluster by id").ToString()).
+		Methods(http.MethodGet)
+	adminClustersRouter.HandleFunc("/{id}/cordon", adminClusterHandler.Cordon).
+		Name(logger.NewLogEvent("admin-cordon-cluster", "[admin] cordon cluster by id").ToString()).
+		Methods(http.MethodPost)
+	adminClustersRouter.HandleFunc("/{id}/uncordon", adminClusterHandler.Uncordon).
+		Name(logger.NewLogEvent("admin-uncordon-cluster", "[admin] uncordon cluster by id").ToString()).
+		Methods(http.MethodPost)
+	adminClustersRouter.HandleFunc("/{id}/drain", adminClusterHandler.Drain).
+		Name(logger.NewLogEvent("admin-drain-cluster", "[admin] drain cluster by id").ToString()).
+		Methods(http.MethodPost)
+	adminClustersRouter.HandleFunc("/{id}/drain", adminClusterHandler.GetDrainStatus).
+		Name(logger.NewLogEvent("admin-get-cluster-drain-status", "[admin] get drain status of cluster by id").ToString()).
+		Methods(http.MethodGet)
+
+	adminRolloutHandler := handlers.NewAdminCentralVersionRolloutHandler(s.CentralVersionRolloutService)
+	adminRolloutsRouter := adminRouter.PathPrefix("/central-version-rollouts").Subrouter()
+	adminRolloutsRouter.HandleFunc("", adminRolloutHandler.List).
+		Name(logger.NewLogEvent("admin-list-central-version-rollouts", "[admin] list central version rollouts").ToString()).
+		Methods(http.MethodGet)
+	adminRolloutsRouter.HandleFunc("", adminRolloutHandler.Create).
+		Name(logger.NewLogEvent("admin-create-central-version-rollout", "[admin] create central version rollout").ToString()).
+		Methods(http.MethodPost)
+	adminRolloutsRouter.HandleFunc("/{id}", adminRolloutHandler.Get).
+		Name(logger.NewLogEvent("admin-get-central-version-rollout", "[admin] get central version rollout by id").ToString()).
+		Methods(http.MethodGet)
+	adminRolloutsRouter.HandleFunc("/{id}/pause", adminRolloutHandler.Pause).
+		Name(logger.NewLogEvent("admin-pause-central-version-rollout", "[admin] pause central version rollout by id").ToString()).
+		Methods(http.MethodPost)
+	adminRolloutsRouter.HandleFunc("/{id}/resume", adminRolloutHandler.Resume).
+		Name(logger.NewLogEvent("admin-resume-central-version-rollout", "[admin] resume central version rollout by id").ToString()).
+		Methods(http.MethodPost)
+	adminRolloutsRouter.HandleFunc("/{id}/cancel", adminRolloutHandler.Cancel).
+		Name(logger.NewLogEvent("admin-cancel-central-version-rollout", "[admin] cancel central version rollout by id").ToString()).
+		Methods(http.MethodPost)
+
+	adminQuotaHandler := handlers.NewAdminQuotaHandler(s.QuotaOverrideService)
+	adminQuotasRouter := adminRouter.PathPrefix("/quotas").Subrouter()
+	adminQuotasRouter.HandleFunc("", adminQuotaHandler.List).
+		Name(logger.NewLogEvent("admin-list-quotas", "[admin] list organisation quota overrides").ToString()).
+		Methods(http.MethodGet)
+	adminQuotasRouter.HandleFunc("", adminQuotaHandler.Create).
+		Name(logger.NewLogEvent("admin-create-quota", "[admin] create organisation quota override").ToString()).
+		Methods(http.MethodPost)
+	adminQuotasRouter.HandleFunc("/{organisation_id}", adminQuotaHandler.Get).
+		Name(logger.NewLogEvent("admin-get-quota", "[admin] get organisation quota override by organisation id").ToString()).
+		Methods(http.MethodGet)
+	adminQuotasRouter.HandleFunc("/{organisation_id}", adminQuotaHandler.Update).
+		Name(logger.NewLogEvent("admin-update-quota", "[admin] update organisation quota override by organisation id").ToString()).
+		Methods(http.MethodPatch)
+	adminQuotasRouter.HandleFunc("/{organisation_id}", adminQuotaHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-quota", "[admin] delete organisation quota override by organisation id").ToString()).
+		Methods(http.MethodDelete)
+	adminQuotasRouter.HandleFunc("/{organisation_id}/history", adminQuotaHandler.ListHistory).
+		Name(logger.NewLogEvent("admin-list-quota-history", "[admin] list organisation quota override changes by organisation id").ToString()).
+		Methods(http.MethodGet)
 
 	return nil
 }
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared/utils/arrays"
+)
+
+var pendingCentralBackupRequestStatuses = []string{
+	dinosaurConstants.CentralBackupRequestStatusAccepted.String(),
+	dinosaurConstants.CentralBackupRequestStatusInProgress.String(),
+}
+
+// CentralBackupService manages on-demand backups and restores of the managed DB of Central instances.
+//
+// Backup requests are stored by fleet-manager and carried out by fleetshard-sync on the data-plane cluster of the
+// Central, which reports the progress back. A Central has at most one pending backup request at a time.
+// Restores never overwrite the database of a Central, they always create a new database.
+//
+//go:generate moq -out central_backup_moq.go . CentralBackupService
+type CentralBackupService interface {
+	// RequestBackup requests an on-demand snapshot of the managed DB of the Central with the given ID.
+	RequestBackup(ctx context.Context, centralID string) (*dbapi.CentralBackupRequest, *errors.ServiceError)
+	// RequestRestore requests a restore of the managed DB of the Central with the given ID to a new database, either
+	// from the snapshot of a succeeded backup request or, if snapshotID is empty, to the given point in time.
+	RequestRestore(ctx context.Context, centralID string, snapshotID string, restoreTime *time.Time) (*dbapi.CentralBackupRequest, *errors.ServiceError)
+	// Get returns the backup request with the given ID of the Central with the given ID.
+	Get(centralID string, id string) (*dbapi.CentralBackupRequest, *errors.ServiceError)
+	// ListByCentralID returns all backup requests of the Central with the given ID, most recent first.
+	ListByCentralID(centralID string) (dbapi.CentralBackupRequestList, *errors.ServiceError)
+	// ListPendingByClusterID returns the backup requests to be carried out on the given data-plane cluster.
+	ListPendingByClusterID(clusterID string) (dbapi.CentralBackupRequestList, *errors.ServiceError)
+	// UpdateStatus updates the status of a backup request as reported by the given data-plane cluster.
+	UpdateStatus(clusterID string, id string, status dinosaurConstants.CentralBackupRequestStatus, failedReason string) *errors.ServiceError
+}
+
+type centralBackupService struct {
+	connectionFactory *db.ConnectionFactory
+	dinosaurService   DinosaurService
+}
+
+var _ CentralBackupService = &centralBackupService{}
+
+// NewCentralBackupService ...
+func NewCentralBackupService(connectionFactory *db.ConnectionFactory, dinosaurService DinosaurService) CentralBackupService {
+	return &centralBackupService{
+		connectionFactory: connectionFactory,
+		dinosaurService:   dinosaurService,
+	}
+}
+
+// RequestBackup ...
+func (s *centralBackupService) RequestBackup(ctx context.Context, centralID string) (*dbapi.CentralBackupRequest, *errors.ServiceError) {
+	centralRequest, svcErr := s.getBackupableCentral(centralID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	id := api.NewID()
+	backupRequest := &dbapi.CentralBackupRequest{
+		Meta:       api.Meta{ID: id},
+		CentralID:  centralRequest.ID,
+		ClusterID:  centralRequest.ClusterID,
+		Type:       dinosaurConstants.CentralBackupRequestTypeBackup.String(),
+		Status:     dinosaurConstants.CentralBackupRequestStatusAccepted.String(),
+		SnapshotID: id,
+	}
+	if svcErr := s.create(backupRequest); svcErr != nil {
+		return nil, svcErr
+	}
+
+	logger.NewUHCLogger(ctx).Infof("requested backup %s of central %s", backupRequest.ID, centralID)
+	return backupRequest, nil
+}
+
+// RequestRestore ...
+func (s *centralBackupService) RequestRestore(ctx context.Context, centralID string, snapshotID string, restoreTime *time.Time) (*dbapi.CentralBackupRequest, *errors.ServiceError) {
+	if snapshotID != "" && restoreTime != nil {
+		return nil, errors.BadRequest("either a snapshot ID or a restore time can be given, not both")
+	}
+	if restoreTime != nil && restoreTime.After(time.Now()) {
+		return nil, errors.BadRequest("restore time %s is in the future", restoreTime.Format(time.RFC3339))
+	}
+
+	centralRequest, svcErr := s.getBackupableCentral(centralID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if snapshotID != "" {
+		var backupCount int64
+		if err := s.connectionFactory.New().
+			Model(&dbapi.CentralBackupRequest{}).
+			Where("central_id = ?", centralID).
+			Where("type = ?", dinosaurConstants.CentralBackupRequestTypeBackup.String()).
+			Where("status = ?", dinosaurConstants.CentralBackupRequestStatusSucceeded.String()).
+			Where("snapshot_id = ?", snapshotID).
+			Count(&backupCount).Error; err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to find snapshot %s of central %s", snapshotID, centralID)
+		}
+		if backupCount == 0 {
+			return nil, errors.BadRequest("central %s has no successful backup with snapshot %s", centralID, snapshotID)
+		}
+	}
+
+	id := api.NewID()
+	restoreRequest := &dbapi.CentralBackupRequest{
+		Meta:             api.Meta{ID: id},
+		CentralID:        centralRequest.ID,
+		ClusterID:        centralRequest.ClusterID,
+		Type:             dinosaurConstants.CentralBackupRequestTypeRestore.String(),
+		Status:           dinosaurConstants.CentralBackupRequestStatusAccepted.String(),
+		SnapshotID:       snapshotID,
+		RestoreTime:      restoreTime,
+		TargetDatabaseID: id,
+	}
+	if svcErr := s.create(restoreRequest); svcErr != nil {
+		return nil, svcErr
+	}
+
+	logger.NewUHCLogger(ctx).Infof("requested restore %s of central %s to database %s", restoreRequest.ID, centralID, restoreRequest.TargetDatabaseID)
+	return restoreRequest, nil
+}
+
+func (s *centralBackupService) getBackupableCentral(centralID string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	centralRequest, svcErr := s.dinosaurService.GetByID(centralID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if centralRequest.Status != dinosaurConstants.CentralRequestStatusReady.String() {
+		return nil, errors.BadRequest("central %s cannot be backed up or restored in status %q", centralID, centralRequest.Status)
+	}
+	if centralRequest.MigrationStatus != "" {
+		return nil, errors.Conflict("central %s cannot be backed up or restored while it is migrated", centralID)
+	}
+
+	var pendingCount int64
+	if err := s.connectionFactory.New().
+		Model(&dbapi.CentralBackupRequest{}).
+		Where("central_id = ?", centralID).
+		Where("status IN (?)", pendingCentralBackupRequestStatuses).
+		Count(&pendingCount).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count pending backup requests of central %s", centralID)
+	}
+	if pendingCount > 0 {
+		return nil, errors.Conflict("central %s has a pending backup request", centralID)
+	}
+	return centralRequest, nil
+}
+
+func (s *centralBackupService) create(backupRequest *dbapi.CentralBackupRequest) *errors.ServiceError {
+	if err := s.connectionFactory.New().Create(backupRequest).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create %s request of central %s", backupRequest.Type, backupRequest.CentralID)
+	}
+	return nil
+}
+
+// Get ...
+func (s *centralBackupService) Get(centralID string, id string) (*dbapi.CentralBackupRequest, *errors.ServiceError) {
+	var backupRequest dbapi.CentralBackupRequest
+	if err := s.connectionFactory.New().
+		Where("central_id = ?", centralID).
+		Where("id = ?", id).
+		First(&backupRequest).Error; err != nil {
+		return nil, services.HandleGetError("CentralBackupRequest", "id", id, err)
+	}
+	return &backupRequest, nil
+}
+
+// ListByCentralID ...
+func (s *centralBackupService) ListByCentralID(centralID string) (dbapi.CentralBackupRequestList, *errors.ServiceError) {
+	var backupRequests dbapi.CentralBackupRequestList
+	if err := s.connectionFactory.New().
+		Where("central_id = ?", centralID).
+		Order("created_at DESC").
+		Find(&backupRequests).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list backup requests of central %s", centralID)
+	}
+	return backupRequests, nil
+}
+
+// ListPendingByClusterID ...
+func (s *centralBackupService) ListPendingByClusterID(clusterID string) (dbapi.CentralBackupRequestList, *errors.ServiceError) {
+	var backupRequests dbapi.CentralBackupRequestList
+	if err := s.connectionFactory.New().
+		Where("cluster_id = ?", clusterID).
+		Where("status IN (?)", pendingCentralBackupRequestStatuses).
+		Order("created_at").
+		Find(&backupRequests).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list pending backup requests of cluster %s", clusterID)
+	}
+	return backupRequests, nil
+}
+
+// UpdateStatus ...
+func (s *centralBackupService) UpdateStatus(clusterID string, id string, status dinosaurConstants.CentralBackupRequestStatus, failedReason string) *errors.ServiceError {
+	switch status {
+	case dinosaurConstants.CentralBackupRequestStatusInProgress,
+		dinosaurConstants.CentralBackupRequestStatusSucceeded,
+		dinosaurConstants.CentralBackupRequestStatusFailed:
+	default:
+		return errors.BadRequest("backup request status %q cannot be reported", status)
+	}
+
+	var backupRequest dbapi.CentralBackupRequest
+	if err := s.connectionFactory.New().
+		Where("cluster_id = ?", clusterID).
+		Where("id = ?", id).
+		First(&backupRequest).Error; err != nil {
+		return services.HandleGetError("CentralBackupRequest", "id", id, err)
+	}
+	if backupRequest.Status == status.String() {
+		return nil
+	}
+	if !arrays.Contains(pendingCentralBackupRequestStatuses, backupRequest.Status) {
+		return errors.Conflict("backup request %s is already completed with status %q", id, backupRequest.Status)
+	}
+
+	updates := map[string]interface{}{"status": status.String()}
+	if status == dinosaurConstants.CentralBackupRequestStatusFailed {
+		updates["failed_reason"] = failedReason
+	}
+	if err := s.connectionFactory.New().Model(&backupRequest).Updates(updates).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status of backup request %s", id)
+	}
+	logger.Logger.Infof("%s request %s of central %s is %s", backupRequest.Type, id, backupRequest.CentralID, status)
+	return nil
+}
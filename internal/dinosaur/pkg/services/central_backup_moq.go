@@ -0,0 +1,347 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that CentralBackupServiceMock does implement CentralBackupService.
+// If this is not the case, regenerate this file with moq.
+var _ CentralBackupService = &CentralBackupServiceMock{}
+
+// CentralBackupServiceMock is a mock implementation of CentralBackupService.
+//
+//	func TestSomethingThatUsesCentralBackupService(t *testing.T) {
+//
+//		// make and configure a mocked CentralBackupService
+//		mockedCentralBackupService := &CentralBackupServiceMock{
+//			GetFunc: func(centralID string, id string) (*dbapi.CentralBackupRequest, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListByCentralIDFunc: func(centralID string) (dbapi.CentralBackupRequestList, *serviceError.ServiceError) {
+//				panic("mock out the ListByCentralID method")
+//			},
+//			ListPendingByClusterIDFunc: func(clusterID string) (dbapi.CentralBackupRequestList, *serviceError.ServiceError) {
+//				panic("mock out the ListPendingByClusterID method")
+//			},
+//			RequestBackupFunc: func(ctx context.Context, centralID string) (*dbapi.CentralBackupRequest, *serviceError.ServiceError) {
+//				panic("mock out the RequestBackup method")
+//			},
+//			RequestRestoreFunc: func(ctx context.Context, centralID string, snapshotID string, restoreTime *time.Time) (*dbapi.CentralBackupRequest, *serviceError.ServiceError) {
+//				panic("mock out the RequestRestore method")
+//			},
+//			UpdateStatusFunc: func(clusterID string, id string, status dinosaurConstants.CentralBackupRequestStatus, failedReason string) *serviceError.ServiceError {
+//				panic("mock out the UpdateStatus method")
+//			},
+//		}
+//
+//		// use mockedCentralBackupService in code that requires CentralBackupService
+//		// and then make assertions.
+//
+//	}
+type CentralBackupServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(centralID string, id string) (*dbapi.CentralBackupRequest, *serviceError.ServiceError)
+
+	// ListByCentralIDFunc mocks the ListByCentralID method.
+	ListByCentralIDFunc func(centralID string) (dbapi.CentralBackupRequestList, *serviceError.ServiceError)
+
+	// ListPendingByClusterIDFunc mocks the ListPendingByClusterID method.
+	ListPendingByClusterIDFunc func(clusterID string) (dbapi.CentralBackupRequestList, *serviceError.ServiceError)
+
+	// RequestBackupFunc mocks the RequestBackup method.
+	RequestBackupFunc func(ctx context.Context, centralID string) (*dbapi.CentralBackupRequest, *serviceError.ServiceError)
+
+	// RequestRestoreFunc mocks the RequestRestore method.
+	RequestRestoreFunc func(ctx context.Context, centralID string, snapshotID string, restoreTime *time.Time) (*dbapi.CentralBackupRequest, *serviceError.ServiceError)
+
+	// UpdateStatusFunc mocks the UpdateStatus method.
+	UpdateStatusFunc func(clusterID string, id string, status dinosaurConstants.CentralBackupRequestStatus, failedReason string) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ID is the id argument value.
+			ID string
+		}
+		// ListByCentralID holds details about calls to the ListByCentralID method.
+		ListByCentralID []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+		}
+		// ListPendingByClusterID holds details about calls to the ListPendingByClusterID method.
+		ListPendingByClusterID []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// RequestBackup holds details about calls to the RequestBackup method.
+		RequestBackup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CentralID is the centralID argument value.
+			CentralID string
+		}
+		// RequestRestore holds details about calls to the RequestRestore method.
+		RequestRestore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CentralID is the centralID argument value.
+			CentralID string
+			// SnapshotID is the snapshotID argument value.
+			SnapshotID string
+			// RestoreTime is the restoreTime argument value.
+			RestoreTime *time.Time
+		}
+		// UpdateStatus holds details about calls to the UpdateStatus method.
+		UpdateStatus []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// ID is the id argument value.
+			ID string
+			// Status is the status argument value.
+			Status dinosaurConstants.CentralBackupRequestStatus
+			// FailedReason is the failedReason argument value.
+			FailedReason string
+		}
+	}
+	lockGet                    sync.RWMutex
+	lockListByCentralID        sync.RWMutex
+	lockListPendingByClusterID sync.RWMutex
+	lockRequestBackup          sync.RWMutex
+	lockRequestRestore         sync.RWMutex
+	lockUpdateStatus           sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *CentralBackupServiceMock) Get(centralID string, id string) (*dbapi.CentralBackupRequest, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("CentralBackupServiceMock.GetFunc: method is nil but CentralBackupService.Get was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ID        string
+	}{
+		CentralID: centralID,
+		ID:        id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(centralID, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedCentralBackupService.GetCalls())
+func (mock *CentralBackupServiceMock) GetCalls() []struct {
+	CentralID string
+	ID        string
+} {
+	var calls []struct {
+		CentralID string
+		ID        string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// ListByCentralID calls ListByCentralIDFunc.
+func (mock *CentralBackupServiceMock) ListByCentralID(centralID string) (dbapi.CentralBackupRequestList, *serviceError.ServiceError) {
+	if mock.ListByCentralIDFunc == nil {
+		panic("CentralBackupServiceMock.ListByCentralIDFunc: method is nil but CentralBackupService.ListByCentralID was just called")
+	}
+	callInfo := struct {
+		CentralID string
+	}{
+		CentralID: centralID,
+	}
+	mock.lockListByCentralID.Lock()
+	mock.calls.ListByCentralID = append(mock.calls.ListByCentralID, callInfo)
+	mock.lockListByCentralID.Unlock()
+	return mock.ListByCentralIDFunc(centralID)
+}
+
+// ListByCentralIDCalls gets all the calls that were made to ListByCentralID.
+// Check the length with:
+//
+//	len(mockedCentralBackupService.ListByCentralIDCalls())
+func (mock *CentralBackupServiceMock) ListByCentralIDCalls() []struct {
+	CentralID string
+} {
+	var calls []struct {
+		CentralID string
+	}
+	mock.lockListByCentralID.RLock()
+	calls = mock.calls.ListByCentralID
+	mock.lockListByCentralID.RUnlock()
+	return calls
+}
+
+// ListPendingByClusterID calls ListPendingByClusterIDFunc.
+func (mock *CentralBackupServiceMock) ListPendingByClusterID(clusterID string) (dbapi.CentralBackupRequestList, *serviceError.ServiceError) {
+	if mock.ListPendingByClusterIDFunc == nil {
+		panic("CentralBackupServiceMock.ListPendingByClusterIDFunc: method is nil but CentralBackupService.ListPendingByClusterID was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockListPendingByClusterID.Lock()
+	mock.calls.ListPendingByClusterID = append(mock.calls.ListPendingByClusterID, callInfo)
+	mock.lockListPendingByClusterID.Unlock()
+	return mock.ListPendingByClusterIDFunc(clusterID)
+}
+
+// ListPendingByClusterIDCalls gets all the calls that were made to ListPendingByClusterID.
+// Check the length with:
+//
+//	len(mockedCentralBackupService.ListPendingByClusterIDCalls())
+func (mock *CentralBackupServiceMock) ListPendingByClusterIDCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockListPendingByClusterID.RLock()
+	calls = mock.calls.ListPendingByClusterID
+	mock.lockListPendingByClusterID.RUnlock()
+	return calls
+}
+
+// RequestBackup calls RequestBackupFunc.
+func (mock *CentralBackupServiceMock) RequestBackup(ctx context.Context, centralID string) (*dbapi.CentralBackupRequest, *serviceError.ServiceError) {
+	if mock.RequestBackupFunc == nil {
+		panic("CentralBackupServiceMock.RequestBackupFunc: method is nil but CentralBackupService.RequestBackup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CentralID string
+	}{
+		Ctx:       ctx,
+		CentralID: centralID,
+	}
+	mock.lockRequestBackup.Lock()
+	mock.calls.RequestBackup = append(mock.calls.RequestBackup, callInfo)
+	mock.lockRequestBackup.Unlock()
+	return mock.RequestBackupFunc(ctx, centralID)
+}
+
+// RequestBackupCalls gets all the calls that were made to RequestBackup.
+// Check the length with:
+//
+//	len(mockedCentralBackupService.RequestBackupCalls())
+func (mock *CentralBackupServiceMock) RequestBackupCalls() []struct {
+	Ctx       context.Context
+	CentralID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CentralID string
+	}
+	mock.lockRequestBackup.RLock()
+	calls = mock.calls.RequestBackup
+	mock.lockRequestBackup.RUnlock()
+	return calls
+}
+
+// RequestRestore calls RequestRestoreFunc.
+func (mock *CentralBackupServiceMock) RequestRestore(ctx context.Context, centralID string, snapshotID string, restoreTime *time.Time) (*dbapi.CentralBackupRequest, *serviceError.ServiceError) {
+	if mock.RequestRestoreFunc == nil {
+		panic("CentralBackupServiceMock.RequestRestoreFunc: method is nil but CentralBackupService.RequestRestore was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CentralID   string
+		SnapshotID  string
+		RestoreTime *time.Time
+	}{
+		Ctx:         ctx,
+		CentralID:   centralID,
+		SnapshotID:  snapshotID,
+		RestoreTime: restoreTime,
+	}
+	mock.lockRequestRestore.Lock()
+	mock.calls.RequestRestore = append(mock.calls.RequestRestore, callInfo)
+	mock.lockRequestRestore.Unlock()
+	return mock.RequestRestoreFunc(ctx, centralID, snapshotID, restoreTime)
+}
+
+// RequestRestoreCalls gets all the calls that were made to RequestRestore.
+// Check the length with:
+//
+//	len(mockedCentralBackupService.RequestRestoreCalls())
+func (mock *CentralBackupServiceMock) RequestRestoreCalls() []struct {
+	Ctx         context.Context
+	CentralID   string
+	SnapshotID  string
+	RestoreTime *time.Time
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CentralID   string
+		SnapshotID  string
+		RestoreTime *time.Time
+	}
+	mock.lockRequestRestore.RLock()
+	calls = mock.calls.RequestRestore
+	mock.lockRequestRestore.RUnlock()
+	return calls
+}
+
+// UpdateStatus calls UpdateStatusFunc.
+func (mock *CentralBackupServiceMock) UpdateStatus(clusterID string, id string, status dinosaurConstants.CentralBackupRequestStatus, failedReason string) *serviceError.ServiceError {
+	if mock.UpdateStatusFunc == nil {
+		panic("CentralBackupServiceMock.UpdateStatusFunc: method is nil but CentralBackupService.UpdateStatus was just called")
+	}
+	callInfo := struct {
+		ClusterID    string
+		ID           string
+		Status       dinosaurConstants.CentralBackupRequestStatus
+		FailedReason string
+	}{
+		ClusterID:    clusterID,
+		ID:           id,
+		Status:       status,
+		FailedReason: failedReason,
+	}
+	mock.lockUpdateStatus.Lock()
+	mock.calls.UpdateStatus = append(mock.calls.UpdateStatus, callInfo)
+	mock.lockUpdateStatus.Unlock()
+	return mock.UpdateStatusFunc(clusterID, id, status, failedReason)
+}
+
+// UpdateStatusCalls gets all the calls that were made to UpdateStatus.
+// Check the length with:
+//
+//	len(mockedCentralBackupService.UpdateStatusCalls())
+func (mock *CentralBackupServiceMock) UpdateStatusCalls() []struct {
+	ClusterID    string
+	ID           string
+	Status       dinosaurConstants.CentralBackupRequestStatus
+	FailedReason string
+} {
+	var calls []struct {
+		ClusterID    string
+		ID           string
+		Status       dinosaurConstants.CentralBackupRequestStatus
+		FailedReason string
+	}
+	mock.lockUpdateStatus.RLock()
+	calls = mock.calls.UpdateStatus
+	mock.lockUpdateStatus.RUnlock()
+	return calls
+}
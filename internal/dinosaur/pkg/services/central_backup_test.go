@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/selvatico/go-mocket"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCentralBackupService(central *dbapi.CentralRequest) CentralBackupService {
+	dinosaurService := &DinosaurServiceMock{
+		GetByIDFunc: func(id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+			return central, nil
+		},
+	}
+	return NewCentralBackupService(db.NewMockConnectionFactory(nil), dinosaurService)
+}
+
+func mockPendingCentralBackupRequests(count int) {
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT count(*) FROM "central_backup_requests" WHERE central_id = $1 AND status IN ($2,$3)`).
+		WithReply([]map[string]interface{}{{"count": count}})
+}
+
+func TestCentralBackupService_RequestBackup(t *testing.T) {
+	readyCentral := func(modifyFn func(centralRequest *dbapi.CentralRequest)) *dbapi.CentralRequest {
+		return buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+			centralRequest.Status = dinosaurConstants.CentralRequestStatusReady.String()
+			if modifyFn != nil {
+				modifyFn(centralRequest)
+			}
+		})
+	}
+
+	tests := []struct {
+		name         string
+		central      *dbapi.CentralRequest
+		pendingCount int
+		wantErrCode  errors.ServiceErrorCode
+	}{
+		{
+			name:    "should request a backup of a ready central",
+			central: readyCentral(nil),
+		},
+		{
+			name:        "should fail when the central is not ready",
+			central:     readyCentral(func(c *dbapi.CentralRequest) { c.Status = dinosaurConstants.CentralRequestStatusProvisioning.String() }),
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name: "should fail when the central is being migrated",
+			central: readyCentral(func(c *dbapi.CentralRequest) {
+				c.MigrationStatus = dinosaurConstants.CentralMigrationStatusTargetProvisioning.String()
+			}),
+			wantErrCode: errors.ErrorConflict,
+		},
+		{
+			name:         "should fail when the central has a pending backup request",
+			central:      readyCentral(nil),
+			pendingCount: 1,
+			wantErrCode:  errors.ErrorConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			mockPendingCentralBackupRequests(tt.pendingCount)
+			s := newTestCentralBackupService(tt.central)
+
+			got, err := s.RequestBackup(context.Background(), testID)
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, testID, got.CentralID)
+			assert.Equal(t, testClusterID, got.ClusterID)
+			assert.Equal(t, dinosaurConstants.CentralBackupRequestTypeBackup.String(), got.Type)
+			assert.Equal(t, dinosaurConstants.CentralBackupRequestStatusAccepted.String(), got.Status)
+			assert.Equal(t, got.ID, got.SnapshotID)
+		})
+	}
+}
+
+func TestCentralBackupService_RequestRestore(t *testing.T) {
+	central := buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+		centralRequest.Status = dinosaurConstants.CentralRequestStatusReady.String()
+	})
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		snapshotID    string
+		restoreTime   *time.Time
+		snapshotCount int
+		wantErrCode   errors.ServiceErrorCode
+	}{
+		{
+			name:          "should request a restore from a snapshot",
+			snapshotID:    "snapshot-id",
+			snapshotCount: 1,
+		},
+		{
+			name:        "should request a restore to a point in time",
+			restoreTime: &past,
+		},
+		{
+			name: "should request a restore to the latest restorable time",
+		},
+		{
+			name:        "should fail when both snapshot and restore time are given",
+			snapshotID:  "snapshot-id",
+			restoreTime: &past,
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:        "should fail when the restore time is in the future",
+			restoreTime: &future,
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:        "should fail when the snapshot is not a successful backup of the central",
+			snapshotID:  "snapshot-id",
+			wantErrCode: errors.ErrorBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			mockPendingCentralBackupRequests(0)
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT count(*) FROM "central_backup_requests" WHERE central_id = $1 AND type = $2`).
+				WithReply([]map[string]interface{}{{"count": tt.snapshotCount}})
+			s := newTestCentralBackupService(central)
+
+			got, err := s.RequestRestore(context.Background(), testID, tt.snapshotID, tt.restoreTime)
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, dinosaurConstants.CentralBackupRequestTypeRestore.String(), got.Type)
+			assert.Equal(t, tt.snapshotID, got.SnapshotID)
+			assert.Equal(t, tt.restoreTime, got.RestoreTime)
+			assert.Equal(t, got.ID, got.TargetDatabaseID)
+		})
+	}
+}
+
+func TestCentralBackupService_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		currentStatus string
+		status        dinosaurConstants.CentralBackupRequestStatus
+		wantErrCode   errors.ServiceErrorCode
+	}{
+		{
+			name:          "should move an accepted backup request in progress",
+			currentStatus: dinosaurConstants.CentralBackupRequestStatusAccepted.String(),
+			status:        dinosaurConstants.CentralBackupRequestStatusInProgress,
+		},
+		{
+			name:          "should complete a backup request in progress",
+			currentStatus: dinosaurConstants.CentralBackupRequestStatusInProgress.String(),
+			status:        dinosaurConstants.CentralBackupRequestStatusSucceeded,
+		},
+		{
+			name:          "should ignore an unchanged status",
+			currentStatus: dinosaurConstants.CentralBackupRequestStatusSucceeded.String(),
+			status:        dinosaurConstants.CentralBackupRequestStatusSucceeded,
+		},
+		{
+			name:          "should fail to change the status of a completed backup request",
+			currentStatus: dinosaurConstants.CentralBackupRequestStatusFailed.String(),
+			status:        dinosaurConstants.CentralBackupRequestStatusSucceeded,
+			wantErrCode:   errors.ErrorConflict,
+		},
+		{
+			name:          "should fail to report the accepted status",
+			currentStatus: dinosaurConstants.CentralBackupRequestStatusInProgress.String(),
+			status:        dinosaurConstants.CentralBackupRequestStatusAccepted,
+			wantErrCode:   errors.ErrorBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT * FROM "central_backup_requests" WHERE cluster_id = $1 AND id = $2`).
+				WithReply([]map[string]interface{}{{"id": "backup-id", "central_id": testID, "cluster_id": testClusterID, "status": tt.currentStatus}})
+			updateMock := mocket.Catcher.NewMock().WithQuery(`UPDATE "central_backup_requests"`).WithRowsNum(1)
+			s := newTestCentralBackupService(nil)
+
+			err := s.UpdateStatus(testClusterID, "backup-id", tt.status, "")
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.currentStatus != tt.status.String(), updateMock.Triggered)
+		})
+	}
+}
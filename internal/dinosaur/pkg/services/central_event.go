@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+)
+
+// CentralEventService records and lists the lifecycle events of Centrals.
+//
+// Changes of the status, versions, placement and migration phase of a Central are recorded by a trigger on the
+// central_requests table. This service records the events that are not visible in the table: who requested a
+// change through the public or admin API, and the status reports of fleetshard-sync.
+//
+//go:generate moq -out central_event_moq.go . CentralEventService
+type CentralEventService interface {
+	// Record appends an event to the history of a Central.
+	Record(event *dbapi.CentralEvent) *errors.ServiceError
+	// RecordAction records a change of a Central requested through the API by the user in the context.
+	RecordAction(ctx context.Context, eventType dinosaurConstants.CentralEventType, centralID string, format string, args ...interface{})
+	// RecordStatusReport records the status reported by fleetshard-sync, unless it equals the last reported status.
+	RecordStatusReport(centralID, clusterID, message string) *errors.ServiceError
+	// ListByCentralID returns a page of the history of a Central, most recent first.
+	ListByCentralID(centralID string, listArgs *services.ListArguments) (dbapi.CentralEventList, *api.PagingMeta, *errors.ServiceError)
+}
+
+type centralEventService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ CentralEventService = &centralEventService{}
+
+// NewCentralEventService ...
+func NewCentralEventService(connectionFactory *db.ConnectionFactory) CentralEventService {
+	return &centralEventService{connectionFactory: connectionFactory}
+}
+
+// Record ...
+func (s *centralEventService) Record(event *dbapi.CentralEvent) *errors.ServiceError {
+	if err := s.connectionFactory.New().Create(event).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record %s event of central %s", event.Type, event.CentralID)
+	}
+	return nil
+}
+
+// RecordAction ...
+//
+// The event history is informational, so a failure to record the event is logged instead of failing the action.
+func (s *centralEventService) RecordAction(ctx context.Context, eventType dinosaurConstants.CentralEventType, centralID string, format string, args ...interface{}) {
+	event := &dbapi.CentralEvent{
+		CentralID: centralID,
+		Type:      eventType.String(),
+		Message:   fmt.Sprintf(format, args...),
+		Actor:     changedBy(ctx),
+	}
+	if svcErr := s.Record(event); svcErr != nil {
+		glog.Errorf("recording %s event of central %s: %v", eventType, centralID, svcErr)
+	}
+}
+
+// RecordStatusReport ...
+func (s *centralEventService) RecordStatusReport(centralID, clusterID, message string) *errors.ServiceError {
+	var last dbapi.CentralEvent
+	err := s.connectionFactory.New().
+		Where("central_id = ?", centralID).
+		Where("type = ?", dinosaurConstants.CentralEventTypeStatusReport.String()).
+		Order("id DESC").
+		First(&last).Error
+	if err != nil && !services.IsRecordNotFoundError(err) {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get last status report of central %s", centralID)
+	}
+	// fleetshard-sync reports the status of every Central periodically, record only the changes.
+	if err == nil && last.Message == message {
+		return nil
+	}
+
+	return s.Record(&dbapi.CentralEvent{
+		CentralID: centralID,
+		Type:      dinosaurConstants.CentralEventTypeStatusReport.String(),
+		Message:   message,
+		Actor:     fmt.Sprintf("fleetshard-sync (cluster %s)", clusterID),
+	})
+}
+
+// ListByCentralID ...
+func (s *centralEventService) ListByCentralID(centralID string, listArgs *services.ListArguments) (dbapi.CentralEventList, *api.PagingMeta, *errors.ServiceError) {
+	var events dbapi.CentralEventList
+	dbConn := s.connectionFactory.New().Where("central_id = ?", centralID)
+
+	pagingMeta, svcErr := services.ListPage(dbConn, listArgs, []string{"id desc"}, &events)
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
+	return events, pagingMeta, nil
+}
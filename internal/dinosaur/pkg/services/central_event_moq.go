@@ -0,0 +1,254 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"sync"
+)
+
+// Ensure, that CentralEventServiceMock does implement CentralEventService.
+// If this is not the case, regenerate this file with moq.
+var _ CentralEventService = &CentralEventServiceMock{}
+
+// CentralEventServiceMock is a mock implementation of CentralEventService.
+//
+//	func TestSomethingThatUsesCentralEventService(t *testing.T) {
+//
+//		// make and configure a mocked CentralEventService
+//		mockedCentralEventService := &CentralEventServiceMock{
+//			ListByCentralIDFunc: func(centralID string, listArgs *services.ListArguments) (dbapi.CentralEventList, *api.PagingMeta, *serviceError.ServiceError) {
+//				panic("mock out the ListByCentralID method")
+//			},
+//			RecordFunc: func(event *dbapi.CentralEvent) *serviceError.ServiceError {
+//				panic("mock out the Record method")
+//			},
+//			RecordActionFunc: func(ctx context.Context, eventType dinosaurConstants.CentralEventType, centralID string, format string, args ...interface{})  {
+//				panic("mock out the RecordAction method")
+//			},
+//			RecordStatusReportFunc: func(centralID string, clusterID string, message string) *serviceError.ServiceError {
+//				panic("mock out the RecordStatusReport method")
+//			},
+//		}
+//
+//		// use mockedCentralEventService in code that requires CentralEventService
+//		// and then make assertions.
+//
+//	}
+type CentralEventServiceMock struct {
+	// ListByCentralIDFunc mocks the ListByCentralID method.
+	ListByCentralIDFunc func(centralID string, listArgs *services.ListArguments) (dbapi.CentralEventList, *api.PagingMeta, *serviceError.ServiceError)
+
+	// RecordFunc mocks the Record method.
+	RecordFunc func(event *dbapi.CentralEvent) *serviceError.ServiceError
+
+	// RecordActionFunc mocks the RecordAction method.
+	RecordActionFunc func(ctx context.Context, eventType dinosaurConstants.CentralEventType, centralID string, format string, args ...interface{})
+
+	// RecordStatusReportFunc mocks the RecordStatusReport method.
+	RecordStatusReportFunc func(centralID string, clusterID string, message string) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListByCentralID holds details about calls to the ListByCentralID method.
+		ListByCentralID []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
+		}
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Event is the event argument value.
+			Event *dbapi.CentralEvent
+		}
+		// RecordAction holds details about calls to the RecordAction method.
+		RecordAction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EventType is the eventType argument value.
+			EventType dinosaurConstants.CentralEventType
+			// CentralID is the centralID argument value.
+			CentralID string
+			// Format is the format argument value.
+			Format string
+			// Args is the args argument value.
+			Args []interface{}
+		}
+		// RecordStatusReport holds details about calls to the RecordStatusReport method.
+		RecordStatusReport []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Message is the message argument value.
+			Message string
+		}
+	}
+	lockListByCentralID    sync.RWMutex
+	lockRecord             sync.RWMutex
+	lockRecordAction       sync.RWMutex
+	lockRecordStatusReport sync.RWMutex
+}
+
+// ListByCentralID calls ListByCentralIDFunc.
+func (mock *CentralEventServiceMock) ListByCentralID(centralID string, listArgs *services.ListArguments) (dbapi.CentralEventList, *api.PagingMeta, *serviceError.ServiceError) {
+	if mock.ListByCentralIDFunc == nil {
+		panic("CentralEventServiceMock.ListByCentralIDFunc: method is nil but CentralEventService.ListByCentralID was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ListArgs  *services.ListArguments
+	}{
+		CentralID: centralID,
+		ListArgs:  listArgs,
+	}
+	mock.lockListByCentralID.Lock()
+	mock.calls.ListByCentralID = append(mock.calls.ListByCentralID, callInfo)
+	mock.lockListByCentralID.Unlock()
+	return mock.ListByCentralIDFunc(centralID, listArgs)
+}
+
+// ListByCentralIDCalls gets all the calls that were made to ListByCentralID.
+// Check the length with:
+//
+//	len(mockedCentralEventService.ListByCentralIDCalls())
+func (mock *CentralEventServiceMock) ListByCentralIDCalls() []struct {
+	CentralID string
+	ListArgs  *services.ListArguments
+} {
+	var calls []struct {
+		CentralID string
+		ListArgs  *services.ListArguments
+	}
+	mock.lockListByCentralID.RLock()
+	calls = mock.calls.ListByCentralID
+	mock.lockListByCentralID.RUnlock()
+	return calls
+}
+
+// Record calls RecordFunc.
+func (mock *CentralEventServiceMock) Record(event *dbapi.CentralEvent) *serviceError.ServiceError {
+	if mock.RecordFunc == nil {
+		panic("CentralEventServiceMock.RecordFunc: method is nil but CentralEventService.Record was just called")
+	}
+	callInfo := struct {
+		Event *dbapi.CentralEvent
+	}{
+		Event: event,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	return mock.RecordFunc(event)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedCentralEventService.RecordCalls())
+func (mock *CentralEventServiceMock) RecordCalls() []struct {
+	Event *dbapi.CentralEvent
+} {
+	var calls []struct {
+		Event *dbapi.CentralEvent
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
+
+// RecordAction calls RecordActionFunc.
+func (mock *CentralEventServiceMock) RecordAction(ctx context.Context, eventType dinosaurConstants.CentralEventType, centralID string, format string, args ...interface{}) {
+	if mock.RecordActionFunc == nil {
+		panic("CentralEventServiceMock.RecordActionFunc: method is nil but CentralEventService.RecordAction was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EventType dinosaurConstants.CentralEventType
+		CentralID string
+		Format    string
+		Args      []interface{}
+	}{
+		Ctx:       ctx,
+		EventType: eventType,
+		CentralID: centralID,
+		Format:    format,
+		Args:      args,
+	}
+	mock.lockRecordAction.Lock()
+	mock.calls.RecordAction = append(mock.calls.RecordAction, callInfo)
+	mock.lockRecordAction.Unlock()
+	mock.RecordActionFunc(ctx, eventType, centralID, format, args...)
+}
+
+// RecordActionCalls gets all the calls that were made to RecordAction.
+// Check the length with:
+//
+//	len(mockedCentralEventService.RecordActionCalls())
+func (mock *CentralEventServiceMock) RecordActionCalls() []struct {
+	Ctx       context.Context
+	EventType dinosaurConstants.CentralEventType
+	CentralID string
+	Format    string
+	Args      []interface{}
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EventType dinosaurConstants.CentralEventType
+		CentralID string
+		Format    string
+		Args      []interface{}
+	}
+	mock.lockRecordAction.RLock()
+	calls = mock.calls.RecordAction
+	mock.lockRecordAction.RUnlock()
+	return calls
+}
+
+// RecordStatusReport calls RecordStatusReportFunc.
+func (mock *CentralEventServiceMock) RecordStatusReport(centralID string, clusterID string, message string) *serviceError.ServiceError {
+	if mock.RecordStatusReportFunc == nil {
+		panic("CentralEventServiceMock.RecordStatusReportFunc: method is nil but CentralEventService.RecordStatusReport was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ClusterID string
+		Message   string
+	}{
+		CentralID: centralID,
+		ClusterID: clusterID,
+		Message:   message,
+	}
+	mock.lockRecordStatusReport.Lock()
+	mock.calls.RecordStatusReport = append(mock.calls.RecordStatusReport, callInfo)
+	mock.lockRecordStatusReport.Unlock()
+	return mock.RecordStatusReportFunc(centralID, clusterID, message)
+}
+
+// RecordStatusReportCalls gets all the calls that were made to RecordStatusReport.
+// Check the length with:
+//
+//	len(mockedCentralEventService.RecordStatusReportCalls())
+func (mock *CentralEventServiceMock) RecordStatusReportCalls() []struct {
+	CentralID string
+	ClusterID string
+	Message   string
+} {
+	var calls []struct {
+		CentralID string
+		ClusterID string
+		Message   string
+	}
+	mock.lockRecordStatusReport.RLock()
+	calls = mock.calls.RecordStatusReport
+	mock.lockRecordStatusReport.RUnlock()
+	return calls
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	mocket "github.com/selvatico/go-mocket"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCentralEventInsert captures the values of the inserted event.
+func mockCentralEventInsert() (*mocket.FakeResponse, *[]interface{}) {
+	var args []interface{}
+	eventInsert := mocket.Catcher.NewMock().
+		WithQuery(`INSERT INTO "central_events"`).
+		WithCallback(func(_ string, namedValues []driver.NamedValue) {
+			args = nil
+			for _, namedValue := range namedValues {
+				args = append(args, namedValue.Value)
+			}
+		})
+	return eventInsert, &args
+}
+
+func TestCentralEventService_RecordAction(t *testing.T) {
+	mocket.Catcher.Reset()
+	eventInsert, eventArgs := mockCentralEventInsert()
+	s := NewCentralEventService(db.NewMockConnectionFactory(nil))
+
+	s.RecordAction(newAdminContext(), dinosaurConstants.CentralEventTypeAdminAction, "central-id", "Central version set to %s", "4.0.0")
+
+	require.True(t, eventInsert.Triggered)
+	assert.Equal(t, []interface{}{"central-id", "admin_action", "Central version set to 4.0.0", "admin"}, (*eventArgs)[1:])
+}
+
+func TestCentralEventService_RecordStatusReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		lastReport string
+		wantInsert bool
+	}{
+		{
+			name:       "should record the first report",
+			wantInsert: true,
+		},
+		{
+			name:       "should record a changed report",
+			lastReport: "Installing",
+			wantInsert: true,
+		},
+		{
+			name:       "should not record an unchanged report",
+			lastReport: "Ready",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			if tt.lastReport != "" {
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "central_events" WHERE central_id = $1 AND type = $2`).
+					WithReply([]map[string]interface{}{{"id": 1, "central_id": "central-id", "type": "status_report", "message": tt.lastReport}})
+			}
+			eventInsert, eventArgs := mockCentralEventInsert()
+			s := NewCentralEventService(db.NewMockConnectionFactory(nil))
+
+			err := s.RecordStatusReport("central-id", "cluster-id", "Ready")
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantInsert, eventInsert.Triggered)
+			if tt.wantInsert {
+				assert.Equal(t, []interface{}{"central-id", "status_report", "Ready", "fleetshard-sync (cluster cluster-id)"}, (*eventArgs)[1:])
+			}
+		})
+	}
+}
+
+func TestCentralEventService_ListByCentralID(t *testing.T) {
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT count(*) FROM "central_events" WHERE central_id = $1`).
+		WithReply([]map[string]interface{}{{"count": 3}})
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "central_events" WHERE central_id = $1 ORDER BY "id" DESC LIMIT 3 OFFSET 2`).
+		WithReply([]map[string]interface{}{{"id": 1, "central_id": "central-id", "type": "created"}})
+	s := NewCentralEventService(db.NewMockConnectionFactory(nil))
+
+	events, paging, err := s.ListByCentralID("central-id", &services.ListArguments{Page: 2, Size: 2})
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "created", events[0].Type)
+	assert.Equal(t, 2, paging.Page)
+	assert.Equal(t, 1, paging.Size)
+	assert.Equal(t, 3, paging.Total)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+)
+
+// CentralMigrationService moves Central instances between data-plane clusters of the same region.
+//
+// A migration goes through the phases defined by constants.CentralMigrationStatus:
+//  1. source_preparing: the source cluster moves a Central still using the master credentials of its managed DB to
+//     the Central DB user, because the target cluster resets the master password to gain access to the DB.
+//  2. target_provisioning: the Central is installed on the target cluster next to the running instance on the
+//     source cluster. The target re-points the existing managed DB to its own namespace, using a separate DB
+//     user until the cutover so that the Central on the source cluster keeps its DB access.
+//  3. switching_routes: the Central is ready on the target cluster and its CNAME records are switched to the
+//     routers of the target cluster.
+//  4. source_teardown: the Central is served from the target cluster and removed from the source cluster. The
+//     target rotates the credentials of the Central DB user, switches over to it and drops the migration user.
+//
+//go:generate moq -out central_migration_moq.go . CentralMigrationService
+type CentralMigrationService interface {
+	// StartMigration starts moving the Central with the given ID to the given data-plane cluster.
+	StartMigration(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralRequest, *errors.ServiceError)
+	// ListByMigrationStatus returns all Centrals in the given migration phase.
+	ListByMigrationStatus(status dinosaurConstants.CentralMigrationStatus) ([]*dbapi.CentralRequest, *errors.ServiceError)
+}
+
+type centralMigrationService struct {
+	connectionFactory *db.ConnectionFactory
+	dinosaurService   DinosaurService
+	clusterService    ClusterService
+}
+
+var _ CentralMigrationService = &centralMigrationService{}
+
+// NewCentralMigrationService ...
+func NewCentralMigrationService(connectionFactory *db.ConnectionFactory, dinosaurService DinosaurService, clusterService ClusterService) CentralMigrationService {
+	return &centralMigrationService{
+		connectionFactory: connectionFactory,
+		dinosaurService:   dinosaurService,
+		clusterService:    clusterService,
+	}
+}
+
+// StartMigration ...
+func (s *centralMigrationService) StartMigration(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralRequest, *errors.ServiceError) {
+	centralRequest, svcErr := s.dinosaurService.GetByID(centralID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if centralRequest.Status != dinosaurConstants.CentralRequestStatusReady.String() {
+		return nil, errors.BadRequest("central %s cannot be migrated in status %q", centralID, centralRequest.Status)
+	}
+	if centralRequest.MigrationStatus != "" {
+		return nil, errors.Conflict("central %s is already being migrated to cluster %s", centralID, centralRequest.MigrationTargetClusterID)
+	}
+	if centralRequest.ClusterID == targetClusterID {
+		return nil, errors.BadRequest("central %s is already placed on cluster %s", centralID, targetClusterID)
+	}
+
+	targetCluster, svcErr := s.clusterService.FindClusterByID(targetClusterID)
+	if svcErr != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, svcErr, "unable to find migration target cluster %s", targetClusterID)
+	}
+	if targetCluster == nil {
+		return nil, errors.BadRequest("migration target cluster %s not found", targetClusterID)
+	}
+	if err := validateMigrationTargetCluster(centralRequest, targetCluster); err != nil {
+		return nil, err
+	}
+
+	dbConn := s.connectionFactory.New().
+		Model(centralRequest).
+		Where("status = ?", dinosaurConstants.CentralRequestStatusReady.String()).
+		Where("migration_status = ''").
+		Updates(map[string]interface{}{
+			"migration_status":            dinosaurConstants.CentralMigrationStatusSourcePreparing.String(),
+			"migration_source_cluster_id": centralRequest.ClusterID,
+			"migration_target_cluster_id": targetClusterID,
+		})
+	if err := dbConn.Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to start migration of central %s", centralID)
+	}
+	if dbConn.RowsAffected == 0 {
+		return nil, errors.Conflict("central %s changed while starting its migration", centralID)
+	}
+
+	centralRequest.MigrationStatus = dinosaurConstants.CentralMigrationStatusSourcePreparing.String()
+	centralRequest.MigrationSourceClusterID = centralRequest.ClusterID
+	centralRequest.MigrationTargetClusterID = targetClusterID
+
+	logger.NewUHCLogger(ctx).Infof("started migration of central %s from cluster %s to cluster %s", centralID, centralRequest.ClusterID, targetClusterID)
+	return centralRequest, nil
+}
+
+func validateMigrationTargetCluster(centralRequest *dbapi.CentralRequest, cluster *api.Cluster) *errors.ServiceError {
+	if cluster.Status != api.ClusterReady || !cluster.IsSchedulable() {
+		return errors.BadRequest("migration target cluster %s is not ready for new centrals", cluster.ClusterID)
+	}
+	if cluster.CloudProvider != centralRequest.CloudProvider || cluster.Region != centralRequest.Region {
+		return errors.BadRequest("migration target cluster %s is not in the region %s/%s of central %s",
+			cluster.ClusterID, centralRequest.CloudProvider, centralRequest.Region, centralRequest.ID)
+	}
+	if centralRequest.MultiAZ && !cluster.MultiAZ {
+		return errors.BadRequest("migration target cluster %s does not support multi AZ centrals", cluster.ClusterID)
+	}
+	if !supportsInstanceType(cluster, centralRequest.InstanceType) {
+		return errors.BadRequest("migration target cluster %s does not support the instance type %q", cluster.ClusterID, centralRequest.InstanceType)
+	}
+	return nil
+}
+
+// ListByMigrationStatus ...
+func (s *centralMigrationService) ListByMigrationStatus(status dinosaurConstants.CentralMigrationStatus) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	var centralRequests []*dbapi.CentralRequest
+	dbConn := s.connectionFactory.New().
+		Where("migration_status = ?", status.String()).
+		Where("status NOT IN (?)", dinosaurDeletionStatuses)
+	if err := dbConn.Find(&centralRequests).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list centrals in migration status %s", status)
+	}
+	return centralRequests, nil
+}
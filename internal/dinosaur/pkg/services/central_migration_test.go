@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/selvatico/go-mocket"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMigrationTargetClusterID = "test-target-cluster-id"
+
+func TestCentralMigrationService_StartMigration(t *testing.T) {
+	readyCentral := func(modifyFn func(centralRequest *dbapi.CentralRequest)) *dbapi.CentralRequest {
+		return buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+			centralRequest.Status = dinosaurConstants.CentralRequestStatusReady.String()
+			centralRequest.InstanceType = "standard"
+			if modifyFn != nil {
+				modifyFn(centralRequest)
+			}
+		})
+	}
+	targetCluster := func(modifyFn func(cluster *api.Cluster)) *api.Cluster {
+		return buildCluster(func(cluster *api.Cluster) {
+			cluster.ClusterID = testMigrationTargetClusterID
+			cluster.Status = api.ClusterReady
+			cluster.Region = testCentralRequestRegion
+			cluster.CloudProvider = testCentralRequestProvider
+			cluster.SupportedInstanceType = "eval,standard"
+			if modifyFn != nil {
+				modifyFn(cluster)
+			}
+		})
+	}
+
+	tests := []struct {
+		name            string
+		central         *dbapi.CentralRequest
+		cluster         *api.Cluster
+		targetClusterID string
+		setupFn         func()
+		wantErrCode     errors.ServiceErrorCode
+	}{
+		{
+			name:            "should start the migration to a matching cluster",
+			central:         readyCentral(nil),
+			cluster:         targetCluster(nil),
+			targetClusterID: testMigrationTargetClusterID,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithRowsNum(1)
+			},
+		},
+		{
+			name:            "should fail when the central is not ready",
+			central:         readyCentral(func(c *dbapi.CentralRequest) { c.Status = dinosaurConstants.CentralRequestStatusProvisioning.String() }),
+			cluster:         targetCluster(nil),
+			targetClusterID: testMigrationTargetClusterID,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name: "should fail when the central is already being migrated",
+			central: readyCentral(func(c *dbapi.CentralRequest) {
+				c.MigrationStatus = dinosaurConstants.CentralMigrationStatusSwitchingRoutes.String()
+			}),
+			cluster:         targetCluster(nil),
+			targetClusterID: testMigrationTargetClusterID,
+			wantErrCode:     errors.ErrorConflict,
+		},
+		{
+			name:            "should fail when the target is the current cluster",
+			central:         readyCentral(nil),
+			cluster:         targetCluster(nil),
+			targetClusterID: testClusterID,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name:            "should fail when the target cluster does not exist",
+			central:         readyCentral(nil),
+			targetClusterID: testMigrationTargetClusterID,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name:            "should fail when the target cluster is not schedulable",
+			central:         readyCentral(nil),
+			cluster:         targetCluster(func(c *api.Cluster) { c.Schedulable = false }),
+			targetClusterID: testMigrationTargetClusterID,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name:            "should fail when the target cluster is in another region",
+			central:         readyCentral(nil),
+			cluster:         targetCluster(func(c *api.Cluster) { c.Region = "eu-west-1" }),
+			targetClusterID: testMigrationTargetClusterID,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name:            "should fail when the target cluster does not support the instance type",
+			central:         readyCentral(nil),
+			cluster:         targetCluster(func(c *api.Cluster) { c.SupportedInstanceType = "eval" }),
+			targetClusterID: testMigrationTargetClusterID,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name:            "should fail when the target cluster only supports an instance type containing the instance type",
+			central:         readyCentral(nil),
+			cluster:         targetCluster(func(c *api.Cluster) { c.SupportedInstanceType = "eval,standard-large" }),
+			targetClusterID: testMigrationTargetClusterID,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name:            "should fail when the central changed concurrently",
+			central:         readyCentral(nil),
+			cluster:         targetCluster(nil),
+			targetClusterID: testMigrationTargetClusterID,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithRowsNum(0)
+			},
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			dinosaurService := &DinosaurServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+					return tt.central, nil
+				},
+			}
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return tt.cluster, nil
+				},
+			}
+			s := NewCentralMigrationService(db.NewMockConnectionFactory(nil), dinosaurService, clusterService)
+
+			got, err := s.StartMigration(context.Background(), testID, tt.targetClusterID)
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, dinosaurConstants.CentralMigrationStatusSourcePreparing.String(), got.MigrationStatus)
+			assert.Equal(t, testClusterID, got.MigrationSourceClusterID)
+			assert.Equal(t, testMigrationTargetClusterID, got.MigrationTargetClusterID)
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// defaultCentralVersionRolloutWaveTimeout is the wave timeout of rollouts which do not set one.
+const defaultCentralVersionRolloutWaveTimeout = time.Hour
+
+var activeCentralVersionRolloutStatuses = []string{
+	dinosaurConstants.CentralVersionRolloutStatusInProgress.String(),
+	dinosaurConstants.CentralVersionRolloutStatusPaused.String(),
+}
+
+// CentralVersionRolloutService manages progressive rollouts of Central versions.
+//
+// A rollout is carried out by the central version rollout worker, which upgrades the Centrals matching the selector
+// of the rollout wave by wave. At most one rollout is in progress or paused at a time, so that rollouts never
+// compete for the same Centrals.
+//
+//go:generate moq -out central_version_rollout_moq.go . CentralVersionRolloutService
+type CentralVersionRolloutService interface {
+	// Create starts a rollout on behalf of the admin in the context.
+	Create(ctx context.Context, rollout *dbapi.CentralVersionRollout) *errors.ServiceError
+	// Get returns the rollout with the given ID.
+	Get(id string) (*dbapi.CentralVersionRollout, *errors.ServiceError)
+	// List returns all rollouts, most recent first.
+	List() (dbapi.CentralVersionRolloutList, *errors.ServiceError)
+	// Pause pauses a rollout in progress. The current wave is kept and continued once the rollout is resumed.
+	Pause(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *errors.ServiceError)
+	// Resume resumes a paused rollout. The wave timeout and soak time of the current wave start over.
+	Resume(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *errors.ServiceError)
+	// Cancel stops a rollout in progress or paused. Centrals which were already part of a wave keep the new version.
+	Cancel(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *errors.ServiceError)
+
+	// ListInProgress returns the rollouts which are in progress.
+	ListInProgress() (dbapi.CentralVersionRolloutList, *errors.ServiceError)
+	// ListWaveCandidates returns up to the batch size of the rollout ready Centrals matching its selector which do
+	// not have the version of the rollout as desired version yet, oldest first.
+	ListWaveCandidates(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *errors.ServiceError)
+	// ListWaveCentrals returns the Centrals of the current wave of the rollout which were not deleted.
+	ListWaveCentrals(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *errors.ServiceError)
+	// UpdateProgress stores the progress of a rollout. Rollouts which were paused or cancelled in the meantime are
+	// not updated and a conflict is returned.
+	UpdateProgress(rollout *dbapi.CentralVersionRollout) *errors.ServiceError
+}
+
+type centralVersionRolloutService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ CentralVersionRolloutService = &centralVersionRolloutService{}
+
+// NewCentralVersionRolloutService ...
+func NewCentralVersionRolloutService(connectionFactory *db.ConnectionFactory) CentralVersionRolloutService {
+	return &centralVersionRolloutService{connectionFactory: connectionFactory}
+}
+
+// Create ...
+func (s *centralVersionRolloutService) Create(ctx context.Context, rollout *dbapi.CentralVersionRollout) *errors.ServiceError {
+	if svcErr := validateCentralVersionRollout(rollout); svcErr != nil {
+		return svcErr
+	}
+
+	var activeCount int64
+	if err := s.connectionFactory.New().
+		Model(&dbapi.CentralVersionRollout{}).
+		Where("status IN (?)", activeCentralVersionRolloutStatuses).
+		Count(&activeCount).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to count active central version rollouts")
+	}
+	if activeCount > 0 {
+		return errors.Conflict("another central version rollout is in progress or paused")
+	}
+
+	rollout.ID = api.NewID()
+	if rollout.WaveTimeout == 0 {
+		rollout.WaveTimeout = defaultCentralVersionRolloutWaveTimeout
+	}
+	rollout.Status = dinosaurConstants.CentralVersionRolloutStatusInProgress.String()
+	rollout.CreatedBy = changedBy(ctx)
+	if err := s.connectionFactory.New().Create(rollout).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create central version rollout")
+	}
+
+	logger.NewUHCLogger(ctx).Infof("%s started rollout %s of central version %s in waves of %d centrals",
+		rollout.CreatedBy, rollout.ID, rollout.CentralVersion, rollout.BatchSize)
+	return nil
+}
+
+// Get ...
+func (s *centralVersionRolloutService) Get(id string) (*dbapi.CentralVersionRollout, *errors.ServiceError) {
+	var rollout dbapi.CentralVersionRollout
+	if err := s.connectionFactory.New().
+		Where("id = ?", id).
+		First(&rollout).Error; err != nil {
+		return nil, services.HandleGetError("CentralVersionRollout", "id", id, err)
+	}
+	return &rollout, nil
+}
+
+// List ...
+func (s *centralVersionRolloutService) List() (dbapi.CentralVersionRolloutList, *errors.ServiceError) {
+	var rollouts dbapi.CentralVersionRolloutList
+	if err := s.connectionFactory.New().
+		Order("created_at DESC").
+		Find(&rollouts).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list central version rollouts")
+	}
+	return rollouts, nil
+}
+
+// Pause ...
+func (s *centralVersionRolloutService) Pause(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *errors.ServiceError) {
+	rollout, svcErr := s.transition(id, dinosaurConstants.CentralVersionRolloutStatusInProgress, func(rollout *dbapi.CentralVersionRollout) map[string]interface{} {
+		return map[string]interface{}{
+			"status":       dinosaurConstants.CentralVersionRolloutStatusPaused.String(),
+			"pause_reason": "paused by " + changedBy(ctx),
+		}
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	logger.NewUHCLogger(ctx).Infof("%s paused central version rollout %s", changedBy(ctx), id)
+	return rollout, nil
+}
+
+// Resume ...
+func (s *centralVersionRolloutService) Resume(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *errors.ServiceError) {
+	rollout, svcErr := s.transition(id, dinosaurConstants.CentralVersionRolloutStatusPaused, func(rollout *dbapi.CentralVersionRollout) map[string]interface{} {
+		values := map[string]interface{}{
+			"status":       dinosaurConstants.CentralVersionRolloutStatusInProgress.String(),
+			"pause_reason": "",
+		}
+		now := time.Now()
+		if rollout.WaveStartedAt != nil {
+			values["wave_started_at"] = &now
+		}
+		if rollout.WaveHealthyAt != nil {
+			values["wave_healthy_at"] = &now
+		}
+		return values
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	logger.NewUHCLogger(ctx).Infof("%s resumed central version rollout %s", changedBy(ctx), id)
+	return rollout, nil
+}
+
+// Cancel ...
+func (s *centralVersionRolloutService) Cancel(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *errors.ServiceError) {
+	rollout, svcErr := s.transition(id, "", func(rollout *dbapi.CentralVersionRollout) map[string]interface{} {
+		return map[string]interface{}{
+			"status":           dinosaurConstants.CentralVersionRolloutStatusCancelled.String(),
+			"wave_central_ids": "",
+			"wave_started_at":  nil,
+			"wave_healthy_at":  nil,
+		}
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	logger.NewUHCLogger(ctx).Infof("%s cancelled central version rollout %s", changedBy(ctx), id)
+	return rollout, nil
+}
+
+// transition updates a rollout in the given status, or in any active status if from is empty, with the values
+// returned by update. It returns a conflict if the status of the rollout does not allow the transition.
+func (s *centralVersionRolloutService) transition(id string, from dinosaurConstants.CentralVersionRolloutStatus,
+	update func(rollout *dbapi.CentralVersionRollout) map[string]interface{}) (*dbapi.CentralVersionRollout, *errors.ServiceError) {
+	rollout, svcErr := s.Get(id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	fromStatuses := activeCentralVersionRolloutStatuses
+	if from != "" {
+		fromStatuses = []string{from.String()}
+	}
+	if !arrays.Contains(fromStatuses, rollout.Status) {
+		return nil, errors.Conflict("central version rollout %s cannot be changed in status %q", id, rollout.Status)
+	}
+
+	result := s.connectionFactory.New().
+		Model(&dbapi.CentralVersionRollout{Meta: api.Meta{ID: id}}).
+		Where("status = ?", rollout.Status).
+		Updates(update(rollout))
+	if result.Error != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to update central version rollout %s", id)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.Conflict("central version rollout %s was changed concurrently", id)
+	}
+	return s.Get(id)
+}
+
+// ListInProgress ...
+func (s *centralVersionRolloutService) ListInProgress() (dbapi.CentralVersionRolloutList, *errors.ServiceError) {
+	var rollouts dbapi.CentralVersionRolloutList
+	if err := s.connectionFactory.New().
+		Where("status = ?", dinosaurConstants.CentralVersionRolloutStatusInProgress.String()).
+		Order("created_at").
+		Find(&rollouts).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list central version rollouts in progress")
+	}
+	return rollouts, nil
+}
+
+// ListWaveCandidates ...
+func (s *centralVersionRolloutService) ListWaveCandidates(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New().
+		Where("status = ?", dinosaurConstants.CentralRequestStatusReady.String()).
+		Where("desired_central_version <> ?", rollout.CentralVersion).
+		Where("migration_status = ''")
+	if rollout.InstanceType != "" {
+		dbConn = dbConn.Where("instance_type = ?", rollout.InstanceType)
+	}
+	if rollout.Region != "" {
+		dbConn = dbConn.Where("region = ?", rollout.Region)
+	}
+	if rollout.ClusterID != "" {
+		dbConn = dbConn.Where("cluster_id = ?", rollout.ClusterID)
+	}
+	if orgIDs := rollout.GetOrganisationIDs(); len(orgIDs) > 0 {
+		dbConn = dbConn.Where("organisation_id IN (?)", orgIDs)
+	}
+
+	var centrals []*dbapi.CentralRequest
+	if err := dbConn.
+		Order("created_at").
+		Limit(rollout.BatchSize).
+		Find(&centrals).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list centrals of the next wave of central version rollout %s", rollout.ID)
+	}
+	return centrals, nil
+}
+
+// ListWaveCentrals ...
+func (s *centralVersionRolloutService) ListWaveCentrals(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	ids := rollout.GetWaveCentralIDs()
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var centrals []*dbapi.CentralRequest
+	if err := s.connectionFactory.New().
+		Where("id IN (?)", ids).
+		Find(&centrals).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list centrals of wave %d of central version rollout %s", rollout.CurrentWave, rollout.ID)
+	}
+	return centrals, nil
+}
+
+// UpdateProgress ...
+func (s *centralVersionRolloutService) UpdateProgress(rollout *dbapi.CentralVersionRollout) *errors.ServiceError {
+	result := s.connectionFactory.New().
+		Model(&dbapi.CentralVersionRollout{Meta: api.Meta{ID: rollout.ID}}).
+		Where("status = ?", dinosaurConstants.CentralVersionRolloutStatusInProgress.String()).
+		Updates(map[string]interface{}{
+			"status":            rollout.Status,
+			"pause_reason":      rollout.PauseReason,
+			"current_wave":      rollout.CurrentWave,
+			"wave_central_ids":  rollout.WaveCentralIDs,
+			"wave_started_at":   rollout.WaveStartedAt,
+			"wave_healthy_at":   rollout.WaveHealthyAt,
+			"upgraded_centrals": rollout.UpgradedCentrals,
+			"failed_centrals":   rollout.FailedCentrals,
+		})
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to update progress of central version rollout %s", rollout.ID)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Conflict("central version rollout %s is no longer in progress", rollout.ID)
+	}
+	return nil
+}
+
+func validateCentralVersionRollout(rollout *dbapi.CentralVersionRollout) *errors.ServiceError {
+	if strings.TrimSpace(rollout.CentralVersion) == "" {
+		return errors.BadRequest("central version must not be empty")
+	}
+	switch types.DinosaurInstanceType(rollout.InstanceType) {
+	case "", types.STANDARD, types.EVAL:
+	default:
+		return errors.BadRequest("invalid instance type %q", rollout.InstanceType)
+	}
+	if rollout.BatchSize < 1 {
+		return errors.BadRequest("batch size must be at least 1, got %d", rollout.BatchSize)
+	}
+	if rollout.SoakTime < 0 || rollout.WaveTimeout < 0 {
+		return errors.BadRequest("soak time and wave timeout must not be negative")
+	}
+	if rollout.MaxFailures < 0 {
+		return errors.BadRequest("max failures must not be negative, got %d", rollout.MaxFailures)
+	}
+	return nil
+}
@@ -0,0 +1,483 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that CentralVersionRolloutServiceMock does implement CentralVersionRolloutService.
+// If this is not the case, regenerate this file with moq.
+var _ CentralVersionRolloutService = &CentralVersionRolloutServiceMock{}
+
+// CentralVersionRolloutServiceMock is a mock implementation of CentralVersionRolloutService.
+//
+//	func TestSomethingThatUsesCentralVersionRolloutService(t *testing.T) {
+//
+//		// make and configure a mocked CentralVersionRolloutService
+//		mockedCentralVersionRolloutService := &CentralVersionRolloutServiceMock{
+//			CancelFunc: func(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+//				panic("mock out the Cancel method")
+//			},
+//			CreateFunc: func(ctx context.Context, rollout *dbapi.CentralVersionRollout) *serviceError.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			GetFunc: func(id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func() (dbapi.CentralVersionRolloutList, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListInProgressFunc: func() (dbapi.CentralVersionRolloutList, *serviceError.ServiceError) {
+//				panic("mock out the ListInProgress method")
+//			},
+//			ListWaveCandidatesFunc: func(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListWaveCandidates method")
+//			},
+//			ListWaveCentralsFunc: func(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListWaveCentrals method")
+//			},
+//			PauseFunc: func(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+//				panic("mock out the Pause method")
+//			},
+//			ResumeFunc: func(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+//				panic("mock out the Resume method")
+//			},
+//			UpdateProgressFunc: func(rollout *dbapi.CentralVersionRollout) *serviceError.ServiceError {
+//				panic("mock out the UpdateProgress method")
+//			},
+//		}
+//
+//		// use mockedCentralVersionRolloutService in code that requires CentralVersionRolloutService
+//		// and then make assertions.
+//
+//	}
+type CentralVersionRolloutServiceMock struct {
+	// CancelFunc mocks the Cancel method.
+	CancelFunc func(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, rollout *dbapi.CentralVersionRollout) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func() (dbapi.CentralVersionRolloutList, *serviceError.ServiceError)
+
+	// ListInProgressFunc mocks the ListInProgress method.
+	ListInProgressFunc func() (dbapi.CentralVersionRolloutList, *serviceError.ServiceError)
+
+	// ListWaveCandidatesFunc mocks the ListWaveCandidates method.
+	ListWaveCandidatesFunc func(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *serviceError.ServiceError)
+
+	// ListWaveCentralsFunc mocks the ListWaveCentrals method.
+	ListWaveCentralsFunc func(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *serviceError.ServiceError)
+
+	// PauseFunc mocks the Pause method.
+	PauseFunc func(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError)
+
+	// ResumeFunc mocks the Resume method.
+	ResumeFunc func(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError)
+
+	// UpdateProgressFunc mocks the UpdateProgress method.
+	UpdateProgressFunc func(rollout *dbapi.CentralVersionRollout) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Cancel holds details about calls to the Cancel method.
+		Cancel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rollout is the rollout argument value.
+			Rollout *dbapi.CentralVersionRollout
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+		}
+		// ListInProgress holds details about calls to the ListInProgress method.
+		ListInProgress []struct {
+		}
+		// ListWaveCandidates holds details about calls to the ListWaveCandidates method.
+		ListWaveCandidates []struct {
+			// Rollout is the rollout argument value.
+			Rollout *dbapi.CentralVersionRollout
+		}
+		// ListWaveCentrals holds details about calls to the ListWaveCentrals method.
+		ListWaveCentrals []struct {
+			// Rollout is the rollout argument value.
+			Rollout *dbapi.CentralVersionRollout
+		}
+		// Pause holds details about calls to the Pause method.
+		Pause []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Resume holds details about calls to the Resume method.
+		Resume []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateProgress holds details about calls to the UpdateProgress method.
+		UpdateProgress []struct {
+			// Rollout is the rollout argument value.
+			Rollout *dbapi.CentralVersionRollout
+		}
+	}
+	lockCancel             sync.RWMutex
+	lockCreate             sync.RWMutex
+	lockGet                sync.RWMutex
+	lockList               sync.RWMutex
+	lockListInProgress     sync.RWMutex
+	lockListWaveCandidates sync.RWMutex
+	lockListWaveCentrals   sync.RWMutex
+	lockPause              sync.RWMutex
+	lockResume             sync.RWMutex
+	lockUpdateProgress     sync.RWMutex
+}
+
+// Cancel calls CancelFunc.
+func (mock *CentralVersionRolloutServiceMock) Cancel(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+	if mock.CancelFunc == nil {
+		panic("CentralVersionRolloutServiceMock.CancelFunc: method is nil but CentralVersionRolloutService.Cancel was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockCancel.Lock()
+	mock.calls.Cancel = append(mock.calls.Cancel, callInfo)
+	mock.lockCancel.Unlock()
+	return mock.CancelFunc(ctx, id)
+}
+
+// CancelCalls gets all the calls that were made to Cancel.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.CancelCalls())
+func (mock *CentralVersionRolloutServiceMock) CancelCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockCancel.RLock()
+	calls = mock.calls.Cancel
+	mock.lockCancel.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *CentralVersionRolloutServiceMock) Create(ctx context.Context, rollout *dbapi.CentralVersionRollout) *serviceError.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("CentralVersionRolloutServiceMock.CreateFunc: method is nil but CentralVersionRolloutService.Create was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Rollout *dbapi.CentralVersionRollout
+	}{
+		Ctx:     ctx,
+		Rollout: rollout,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, rollout)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.CreateCalls())
+func (mock *CentralVersionRolloutServiceMock) CreateCalls() []struct {
+	Ctx     context.Context
+	Rollout *dbapi.CentralVersionRollout
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Rollout *dbapi.CentralVersionRollout
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *CentralVersionRolloutServiceMock) Get(id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("CentralVersionRolloutServiceMock.GetFunc: method is nil but CentralVersionRolloutService.Get was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.GetCalls())
+func (mock *CentralVersionRolloutServiceMock) GetCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *CentralVersionRolloutServiceMock) List() (dbapi.CentralVersionRolloutList, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("CentralVersionRolloutServiceMock.ListFunc: method is nil but CentralVersionRolloutService.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.ListCalls())
+func (mock *CentralVersionRolloutServiceMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListInProgress calls ListInProgressFunc.
+func (mock *CentralVersionRolloutServiceMock) ListInProgress() (dbapi.CentralVersionRolloutList, *serviceError.ServiceError) {
+	if mock.ListInProgressFunc == nil {
+		panic("CentralVersionRolloutServiceMock.ListInProgressFunc: method is nil but CentralVersionRolloutService.ListInProgress was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListInProgress.Lock()
+	mock.calls.ListInProgress = append(mock.calls.ListInProgress, callInfo)
+	mock.lockListInProgress.Unlock()
+	return mock.ListInProgressFunc()
+}
+
+// ListInProgressCalls gets all the calls that were made to ListInProgress.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.ListInProgressCalls())
+func (mock *CentralVersionRolloutServiceMock) ListInProgressCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListInProgress.RLock()
+	calls = mock.calls.ListInProgress
+	mock.lockListInProgress.RUnlock()
+	return calls
+}
+
+// ListWaveCandidates calls ListWaveCandidatesFunc.
+func (mock *CentralVersionRolloutServiceMock) ListWaveCandidates(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+	if mock.ListWaveCandidatesFunc == nil {
+		panic("CentralVersionRolloutServiceMock.ListWaveCandidatesFunc: method is nil but CentralVersionRolloutService.ListWaveCandidates was just called")
+	}
+	callInfo := struct {
+		Rollout *dbapi.CentralVersionRollout
+	}{
+		Rollout: rollout,
+	}
+	mock.lockListWaveCandidates.Lock()
+	mock.calls.ListWaveCandidates = append(mock.calls.ListWaveCandidates, callInfo)
+	mock.lockListWaveCandidates.Unlock()
+	return mock.ListWaveCandidatesFunc(rollout)
+}
+
+// ListWaveCandidatesCalls gets all the calls that were made to ListWaveCandidates.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.ListWaveCandidatesCalls())
+func (mock *CentralVersionRolloutServiceMock) ListWaveCandidatesCalls() []struct {
+	Rollout *dbapi.CentralVersionRollout
+} {
+	var calls []struct {
+		Rollout *dbapi.CentralVersionRollout
+	}
+	mock.lockListWaveCandidates.RLock()
+	calls = mock.calls.ListWaveCandidates
+	mock.lockListWaveCandidates.RUnlock()
+	return calls
+}
+
+// ListWaveCentrals calls ListWaveCentralsFunc.
+func (mock *CentralVersionRolloutServiceMock) ListWaveCentrals(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+	if mock.ListWaveCentralsFunc == nil {
+		panic("CentralVersionRolloutServiceMock.ListWaveCentralsFunc: method is nil but CentralVersionRolloutService.ListWaveCentrals was just called")
+	}
+	callInfo := struct {
+		Rollout *dbapi.CentralVersionRollout
+	}{
+		Rollout: rollout,
+	}
+	mock.lockListWaveCentrals.Lock()
+	mock.calls.ListWaveCentrals = append(mock.calls.ListWaveCentrals, callInfo)
+	mock.lockListWaveCentrals.Unlock()
+	return mock.ListWaveCentralsFunc(rollout)
+}
+
+// ListWaveCentralsCalls gets all the calls that were made to ListWaveCentrals.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.ListWaveCentralsCalls())
+func (mock *CentralVersionRolloutServiceMock) ListWaveCentralsCalls() []struct {
+	Rollout *dbapi.CentralVersionRollout
+} {
+	var calls []struct {
+		Rollout *dbapi.CentralVersionRollout
+	}
+	mock.lockListWaveCentrals.RLock()
+	calls = mock.calls.ListWaveCentrals
+	mock.lockListWaveCentrals.RUnlock()
+	return calls
+}
+
+// Pause calls PauseFunc.
+func (mock *CentralVersionRolloutServiceMock) Pause(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+	if mock.PauseFunc == nil {
+		panic("CentralVersionRolloutServiceMock.PauseFunc: method is nil but CentralVersionRolloutService.Pause was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockPause.Lock()
+	mock.calls.Pause = append(mock.calls.Pause, callInfo)
+	mock.lockPause.Unlock()
+	return mock.PauseFunc(ctx, id)
+}
+
+// PauseCalls gets all the calls that were made to Pause.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.PauseCalls())
+func (mock *CentralVersionRolloutServiceMock) PauseCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockPause.RLock()
+	calls = mock.calls.Pause
+	mock.lockPause.RUnlock()
+	return calls
+}
+
+// Resume calls ResumeFunc.
+func (mock *CentralVersionRolloutServiceMock) Resume(ctx context.Context, id string) (*dbapi.CentralVersionRollout, *serviceError.ServiceError) {
+	if mock.ResumeFunc == nil {
+		panic("CentralVersionRolloutServiceMock.ResumeFunc: method is nil but CentralVersionRolloutService.Resume was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockResume.Lock()
+	mock.calls.Resume = append(mock.calls.Resume, callInfo)
+	mock.lockResume.Unlock()
+	return mock.ResumeFunc(ctx, id)
+}
+
+// ResumeCalls gets all the calls that were made to Resume.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.ResumeCalls())
+func (mock *CentralVersionRolloutServiceMock) ResumeCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockResume.RLock()
+	calls = mock.calls.Resume
+	mock.lockResume.RUnlock()
+	return calls
+}
+
+// UpdateProgress calls UpdateProgressFunc.
+func (mock *CentralVersionRolloutServiceMock) UpdateProgress(rollout *dbapi.CentralVersionRollout) *serviceError.ServiceError {
+	if mock.UpdateProgressFunc == nil {
+		panic("CentralVersionRolloutServiceMock.UpdateProgressFunc: method is nil but CentralVersionRolloutService.UpdateProgress was just called")
+	}
+	callInfo := struct {
+		Rollout *dbapi.CentralVersionRollout
+	}{
+		Rollout: rollout,
+	}
+	mock.lockUpdateProgress.Lock()
+	mock.calls.UpdateProgress = append(mock.calls.UpdateProgress, callInfo)
+	mock.lockUpdateProgress.Unlock()
+	return mock.UpdateProgressFunc(rollout)
+}
+
+// UpdateProgressCalls gets all the calls that were made to UpdateProgress.
+// Check the length with:
+//
+//	len(mockedCentralVersionRolloutService.UpdateProgressCalls())
+func (mock *CentralVersionRolloutServiceMock) UpdateProgressCalls() []struct {
+	Rollout *dbapi.CentralVersionRollout
+} {
+	var calls []struct {
+		Rollout *dbapi.CentralVersionRollout
+	}
+	mock.lockUpdateProgress.RLock()
+	calls = mock.calls.UpdateProgress
+	mock.lockUpdateProgress.RUnlock()
+	return calls
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	mocket "github.com/selvatico/go-mocket"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralVersionRolloutService_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		rollout     dbapi.CentralVersionRollout
+		active      bool
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name:    "should create a rollout in progress",
+			rollout: dbapi.CentralVersionRollout{CentralVersion: "4.0.0", BatchSize: 5, InstanceType: "standard"},
+		},
+		{
+			name:        "should fail when another rollout is active",
+			rollout:     dbapi.CentralVersionRollout{CentralVersion: "4.0.0", BatchSize: 5},
+			active:      true,
+			wantErrCode: errors.ErrorConflict,
+		},
+		{
+			name:        "should fail without central version",
+			rollout:     dbapi.CentralVersionRollout{BatchSize: 5},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:        "should fail when the batch size is lower than one",
+			rollout:     dbapi.CentralVersionRollout{CentralVersion: "4.0.0"},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:        "should fail for an unknown instance type",
+			rollout:     dbapi.CentralVersionRollout{CentralVersion: "4.0.0", BatchSize: 5, InstanceType: "premium"},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			activeCount := 0
+			if tt.active {
+				activeCount = 1
+			}
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT count(*) FROM "central_version_rollouts"`).
+				WithReply([]map[string]interface{}{{"count": activeCount}})
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "central_version_rollouts"`)
+			s := NewCentralVersionRolloutService(db.NewMockConnectionFactory(nil))
+
+			rollout := tt.rollout
+			err := s.Create(newAdminContext(), &rollout)
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				assert.False(t, insert.Triggered)
+				return
+			}
+			require.Nil(t, err)
+			assert.True(t, insert.Triggered)
+			assert.NotEmpty(t, rollout.ID)
+			assert.Equal(t, dinosaurConstants.CentralVersionRolloutStatusInProgress.String(), rollout.Status)
+			assert.Equal(t, time.Hour, rollout.WaveTimeout)
+			assert.Equal(t, "admin", rollout.CreatedBy)
+		})
+	}
+}
+
+func TestCentralVersionRolloutService_Pause(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      dinosaurConstants.CentralVersionRolloutStatus
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name:   "should pause a rollout in progress",
+			status: dinosaurConstants.CentralVersionRolloutStatusInProgress,
+		},
+		{
+			name:        "should fail to pause a completed rollout",
+			status:      dinosaurConstants.CentralVersionRolloutStatusCompleted,
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT * FROM "central_version_rollouts" WHERE id = $1`).
+				WithReply([]map[string]interface{}{{"id": "rollout-id", "status": tt.status.String()}})
+			update := mocket.Catcher.NewMock().
+				WithQuery(`UPDATE "central_version_rollouts" SET "pause_reason"=$1,"status"=$2`).
+				WithRowsNum(1)
+			s := NewCentralVersionRolloutService(db.NewMockConnectionFactory(nil))
+
+			_, err := s.Pause(newAdminContext(), "rollout-id")
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				assert.False(t, update.Triggered)
+				return
+			}
+			require.Nil(t, err)
+			assert.True(t, update.Triggered)
+		})
+	}
+}
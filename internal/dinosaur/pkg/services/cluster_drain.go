@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+)
+
+// ClusterDrainStatus describes the cordon and drain progress of a data-plane cluster.
+type ClusterDrainStatus struct {
+	Cluster *api.Cluster
+	// RemainingCentrals is the number of Centrals still hosted on the cluster, including Centrals migrated from
+	// or to the cluster.
+	RemainingCentrals int
+	// MigratingCentrals is the number of remaining Centrals which are being migrated away from the cluster.
+	MigratingCentrals int
+}
+
+// ClusterDrainService cordons data-plane clusters and drains their Centrals to other clusters of the same region.
+// The Centrals of a draining cluster are moved by the CentralMigrationService.
+//
+//go:generate moq -out cluster_drain_moq.go . ClusterDrainService
+type ClusterDrainService interface {
+	// Cordon stops placing new Centrals on the cluster with the given ID. A draining cluster stays cordoned but
+	// is not drained anymore.
+	Cordon(ctx context.Context, clusterID string) (*ClusterDrainStatus, *errors.ServiceError)
+	// Uncordon allows placing new Centrals on the cluster with the given ID again and stops draining it.
+	Uncordon(ctx context.Context, clusterID string) (*ClusterDrainStatus, *errors.ServiceError)
+	// Drain cordons the cluster with the given ID and marks all of its Centrals for migration to other clusters.
+	Drain(ctx context.Context, clusterID string) (*ClusterDrainStatus, *errors.ServiceError)
+	// GetDrainStatus returns the cordon and drain progress of the cluster with the given ID.
+	GetDrainStatus(clusterID string) (*ClusterDrainStatus, *errors.ServiceError)
+	// ListCentrals returns the Centrals hosted on the cluster with the given ID.
+	ListCentrals(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError)
+	// ListDrainingClusters returns all clusters which are being drained.
+	ListDrainingClusters() ([]*api.Cluster, *errors.ServiceError)
+}
+
+type clusterDrainService struct {
+	connectionFactory *db.ConnectionFactory
+	clusterService    ClusterService
+	dinosaurService   DinosaurService
+}
+
+var _ ClusterDrainService = &clusterDrainService{}
+
+// NewClusterDrainService ...
+func NewClusterDrainService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, dinosaurService DinosaurService) ClusterDrainService {
+	return &clusterDrainService{
+		connectionFactory: connectionFactory,
+		clusterService:    clusterService,
+		dinosaurService:   dinosaurService,
+	}
+}
+
+// Cordon ...
+func (s *clusterDrainService) Cordon(ctx context.Context, clusterID string) (*ClusterDrainStatus, *errors.ServiceError) {
+	cluster, svcErr := s.updateCluster(clusterID, true, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	logger.NewUHCLogger(ctx).Infof("cordoned cluster %s", clusterID)
+	return s.drainStatus(cluster)
+}
+
+// Uncordon ...
+func (s *clusterDrainService) Uncordon(ctx context.Context, clusterID string) (*ClusterDrainStatus, *errors.ServiceError) {
+	cluster, svcErr := s.updateCluster(clusterID, false, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	logger.NewUHCLogger(ctx).Infof("uncordoned cluster %s", clusterID)
+	return s.drainStatus(cluster)
+}
+
+// Drain ...
+func (s *clusterDrainService) Drain(ctx context.Context, clusterID string) (*ClusterDrainStatus, *errors.ServiceError) {
+	cluster, svcErr := s.updateCluster(clusterID, true, true)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	logger.NewUHCLogger(ctx).Infof("started draining cluster %s", clusterID)
+	return s.drainStatus(cluster)
+}
+
+// GetDrainStatus ...
+func (s *clusterDrainService) GetDrainStatus(clusterID string) (*ClusterDrainStatus, *errors.ServiceError) {
+	cluster, svcErr := s.findCluster(clusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return s.drainStatus(cluster)
+}
+
+// ListCentrals ...
+func (s *clusterDrainService) ListCentrals(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	if _, svcErr := s.findCluster(clusterID); svcErr != nil {
+		return nil, svcErr
+	}
+	return s.dinosaurService.ListByClusterID(clusterID)
+}
+
+// ListDrainingClusters ...
+func (s *clusterDrainService) ListDrainingClusters() ([]*api.Cluster, *errors.ServiceError) {
+	var clusters []*api.Cluster
+	dbConn := s.connectionFactory.New().
+		Where("draining = ?", true).
+		Where("status NOT IN (?)", api.ClusterDeletionStatuses)
+	if err := dbConn.Find(&clusters).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list draining clusters")
+	}
+	return clusters, nil
+}
+
+func (s *clusterDrainService) findCluster(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, svcErr := s.clusterService.FindClusterByID(clusterID)
+	if svcErr != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, svcErr, "unable to find cluster %s", clusterID)
+	}
+	if cluster == nil {
+		return nil, errors.NotFound("cluster %s not found", clusterID)
+	}
+	return cluster, nil
+}
+
+func (s *clusterDrainService) updateCluster(clusterID string, cordoned bool, draining bool) (*api.Cluster, *errors.ServiceError) {
+	cluster, svcErr := s.findCluster(clusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	// use a map to also update the fields to false
+	if svcErr := s.clusterService.Updates(*cluster, map[string]interface{}{
+		"cordoned": cordoned,
+		"draining": draining,
+	}); svcErr != nil {
+		return nil, svcErr
+	}
+	cluster.Cordoned = cordoned
+	cluster.Draining = draining
+	return cluster, nil
+}
+
+func (s *clusterDrainService) drainStatus(cluster *api.Cluster) (*ClusterDrainStatus, *errors.ServiceError) {
+	centrals, svcErr := s.dinosaurService.ListByClusterID(cluster.ClusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	status := &ClusterDrainStatus{
+		Cluster:           cluster,
+		RemainingCentrals: len(centrals),
+	}
+	for _, central := range centrals {
+		if central.MigrationStatus != "" && central.MigrationSourceClusterID == cluster.ClusterID {
+			status.MigratingCentrals++
+		}
+	}
+	return status, nil
+}
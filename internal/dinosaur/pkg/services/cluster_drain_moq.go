@@ -0,0 +1,309 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ClusterDrainServiceMock does implement ClusterDrainService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterDrainService = &ClusterDrainServiceMock{}
+
+// ClusterDrainServiceMock is a mock implementation of ClusterDrainService.
+//
+//	func TestSomethingThatUsesClusterDrainService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterDrainService
+//		mockedClusterDrainService := &ClusterDrainServiceMock{
+//			CordonFunc: func(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+//				panic("mock out the Cordon method")
+//			},
+//			DrainFunc: func(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+//				panic("mock out the Drain method")
+//			},
+//			GetDrainStatusFunc: func(clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+//				panic("mock out the GetDrainStatus method")
+//			},
+//			ListCentralsFunc: func(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListCentrals method")
+//			},
+//			ListDrainingClustersFunc: func() ([]*api.Cluster, *serviceError.ServiceError) {
+//				panic("mock out the ListDrainingClusters method")
+//			},
+//			UncordonFunc: func(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+//				panic("mock out the Uncordon method")
+//			},
+//		}
+//
+//		// use mockedClusterDrainService in code that requires ClusterDrainService
+//		// and then make assertions.
+//
+//	}
+type ClusterDrainServiceMock struct {
+	// CordonFunc mocks the Cordon method.
+	CordonFunc func(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError)
+
+	// DrainFunc mocks the Drain method.
+	DrainFunc func(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError)
+
+	// GetDrainStatusFunc mocks the GetDrainStatus method.
+	GetDrainStatusFunc func(clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError)
+
+	// ListCentralsFunc mocks the ListCentrals method.
+	ListCentralsFunc func(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError)
+
+	// ListDrainingClustersFunc mocks the ListDrainingClusters method.
+	ListDrainingClustersFunc func() ([]*api.Cluster, *serviceError.ServiceError)
+
+	// UncordonFunc mocks the Uncordon method.
+	UncordonFunc func(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Cordon holds details about calls to the Cordon method.
+		Cordon []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// Drain holds details about calls to the Drain method.
+		Drain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// GetDrainStatus holds details about calls to the GetDrainStatus method.
+		GetDrainStatus []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// ListCentrals holds details about calls to the ListCentrals method.
+		ListCentrals []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// ListDrainingClusters holds details about calls to the ListDrainingClusters method.
+		ListDrainingClusters []struct {
+		}
+		// Uncordon holds details about calls to the Uncordon method.
+		Uncordon []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+	}
+	lockCordon               sync.RWMutex
+	lockDrain                sync.RWMutex
+	lockGetDrainStatus       sync.RWMutex
+	lockListCentrals         sync.RWMutex
+	lockListDrainingClusters sync.RWMutex
+	lockUncordon             sync.RWMutex
+}
+
+// Cordon calls CordonFunc.
+func (mock *ClusterDrainServiceMock) Cordon(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+	if mock.CordonFunc == nil {
+		panic("ClusterDrainServiceMock.CordonFunc: method is nil but ClusterDrainService.Cordon was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+	}
+	mock.lockCordon.Lock()
+	mock.calls.Cordon = append(mock.calls.Cordon, callInfo)
+	mock.lockCordon.Unlock()
+	return mock.CordonFunc(ctx, clusterID)
+}
+
+// CordonCalls gets all the calls that were made to Cordon.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.CordonCalls())
+func (mock *ClusterDrainServiceMock) CordonCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+	}
+	mock.lockCordon.RLock()
+	calls = mock.calls.Cordon
+	mock.lockCordon.RUnlock()
+	return calls
+}
+
+// Drain calls DrainFunc.
+func (mock *ClusterDrainServiceMock) Drain(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+	if mock.DrainFunc == nil {
+		panic("ClusterDrainServiceMock.DrainFunc: method is nil but ClusterDrainService.Drain was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+	}
+	mock.lockDrain.Lock()
+	mock.calls.Drain = append(mock.calls.Drain, callInfo)
+	mock.lockDrain.Unlock()
+	return mock.DrainFunc(ctx, clusterID)
+}
+
+// DrainCalls gets all the calls that were made to Drain.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.DrainCalls())
+func (mock *ClusterDrainServiceMock) DrainCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+	}
+	mock.lockDrain.RLock()
+	calls = mock.calls.Drain
+	mock.lockDrain.RUnlock()
+	return calls
+}
+
+// GetDrainStatus calls GetDrainStatusFunc.
+func (mock *ClusterDrainServiceMock) GetDrainStatus(clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+	if mock.GetDrainStatusFunc == nil {
+		panic("ClusterDrainServiceMock.GetDrainStatusFunc: method is nil but ClusterDrainService.GetDrainStatus was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockGetDrainStatus.Lock()
+	mock.calls.GetDrainStatus = append(mock.calls.GetDrainStatus, callInfo)
+	mock.lockGetDrainStatus.Unlock()
+	return mock.GetDrainStatusFunc(clusterID)
+}
+
+// GetDrainStatusCalls gets all the calls that were made to GetDrainStatus.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.GetDrainStatusCalls())
+func (mock *ClusterDrainServiceMock) GetDrainStatusCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockGetDrainStatus.RLock()
+	calls = mock.calls.GetDrainStatus
+	mock.lockGetDrainStatus.RUnlock()
+	return calls
+}
+
+// ListCentrals calls ListCentralsFunc.
+func (mock *ClusterDrainServiceMock) ListCentrals(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+	if mock.ListCentralsFunc == nil {
+		panic("ClusterDrainServiceMock.ListCentralsFunc: method is nil but ClusterDrainService.ListCentrals was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockListCentrals.Lock()
+	mock.calls.ListCentrals = append(mock.calls.ListCentrals, callInfo)
+	mock.lockListCentrals.Unlock()
+	return mock.ListCentralsFunc(clusterID)
+}
+
+// ListCentralsCalls gets all the calls that were made to ListCentrals.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.ListCentralsCalls())
+func (mock *ClusterDrainServiceMock) ListCentralsCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockListCentrals.RLock()
+	calls = mock.calls.ListCentrals
+	mock.lockListCentrals.RUnlock()
+	return calls
+}
+
+// ListDrainingClusters calls ListDrainingClustersFunc.
+func (mock *ClusterDrainServiceMock) ListDrainingClusters() ([]*api.Cluster, *serviceError.ServiceError) {
+	if mock.ListDrainingClustersFunc == nil {
+		panic("ClusterDrainServiceMock.ListDrainingClustersFunc: method is nil but ClusterDrainService.ListDrainingClusters was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListDrainingClusters.Lock()
+	mock.calls.ListDrainingClusters = append(mock.calls.ListDrainingClusters, callInfo)
+	mock.lockListDrainingClusters.Unlock()
+	return mock.ListDrainingClustersFunc()
+}
+
+// ListDrainingClustersCalls gets all the calls that were made to ListDrainingClusters.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.ListDrainingClustersCalls())
+func (mock *ClusterDrainServiceMock) ListDrainingClustersCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListDrainingClusters.RLock()
+	calls = mock.calls.ListDrainingClusters
+	mock.lockListDrainingClusters.RUnlock()
+	return calls
+}
+
+// Uncordon calls UncordonFunc.
+func (mock *ClusterDrainServiceMock) Uncordon(ctx context.Context, clusterID string) (*ClusterDrainStatus, *serviceError.ServiceError) {
+	if mock.UncordonFunc == nil {
+		panic("ClusterDrainServiceMock.UncordonFunc: method is nil but ClusterDrainService.Uncordon was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClusterID string
+	}{
+		Ctx:       ctx,
+		ClusterID: clusterID,
+	}
+	mock.lockUncordon.Lock()
+	mock.calls.Uncordon = append(mock.calls.Uncordon, callInfo)
+	mock.lockUncordon.Unlock()
+	return mock.UncordonFunc(ctx, clusterID)
+}
+
+// UncordonCalls gets all the calls that were made to Uncordon.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.UncordonCalls())
+func (mock *ClusterDrainServiceMock) UncordonCalls() []struct {
+	Ctx       context.Context
+	ClusterID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClusterID string
+	}
+	mock.lockUncordon.RLock()
+	calls = mock.calls.Uncordon
+	mock.lockUncordon.RUnlock()
+	return calls
+}
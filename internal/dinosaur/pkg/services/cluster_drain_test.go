@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterDrainService_Drain(t *testing.T) {
+	cluster := buildCluster(func(cluster *api.Cluster) {
+		cluster.ID = "id"
+		cluster.ClusterID = testClusterID
+	})
+	var updatedValues map[string]interface{}
+	clusterService := &ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return cluster, nil
+		},
+		UpdatesFunc: func(cluster api.Cluster, values map[string]interface{}) *errors.ServiceError {
+			updatedValues = values
+			return nil
+		},
+	}
+	dinosaurService := &DinosaurServiceMock{
+		ListByClusterIDFunc: func(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+			return []*dbapi.CentralRequest{
+				buildCentralRequest(nil),
+				buildCentralRequest(func(c *dbapi.CentralRequest) {
+					c.MigrationStatus = dinosaurConstants.CentralMigrationStatusTargetProvisioning.String()
+					c.MigrationSourceClusterID = testClusterID
+					c.MigrationTargetClusterID = testMigrationTargetClusterID
+				}),
+			}, nil
+		},
+	}
+	s := NewClusterDrainService(nil, clusterService, dinosaurService)
+
+	status, err := s.Drain(context.Background(), testClusterID)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"cordoned": true, "draining": true}, updatedValues)
+	assert.True(t, status.Cluster.Cordoned)
+	assert.True(t, status.Cluster.Draining)
+	assert.False(t, status.Cluster.IsSchedulable())
+	assert.Equal(t, 2, status.RemainingCentrals)
+	assert.Equal(t, 1, status.MigratingCentrals)
+
+	status, err = s.Uncordon(context.Background(), testClusterID)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"cordoned": false, "draining": false}, updatedValues)
+	assert.True(t, status.Cluster.IsSchedulable())
+}
+
+func TestClusterDrainService_ClusterNotFound(t *testing.T) {
+	clusterService := &ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return nil, nil
+		},
+	}
+	s := NewClusterDrainService(nil, clusterService, &DinosaurServiceMock{})
+
+	_, err := s.Cordon(context.Background(), testClusterID)
+	require.NotNil(t, err)
+	assert.Equal(t, errors.ErrorNotFound, err.Code)
+
+	_, err = s.ListCentrals(testClusterID)
+	require.NotNil(t, err)
+	assert.Equal(t, errors.ErrorNotFound, err.Code)
+}
@@ -1,10 +1,29 @@
 package services
 
 import (
+	"math/rand"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	apiErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+)
+
+// Reasons for a placement decision, exposed as label of the placement decision metric.
+const (
+	placementReasonFirstReady             = "first_ready"
+	placementReasonLeastLoaded            = "least_loaded"
+	placementReasonWeightedCapacity       = "weighted_capacity"
+	placementReasonCapacityNotReported    = "capacity_not_reported"
+	placementReasonCapacityExhausted      = "capacity_exhausted"
+	placementReasonOrgAffinity            = "org_affinity"
+	placementReasonNoOrgCentrals          = "no_org_centrals"
+	placementReasonOrgAntiAffinity        = "org_anti_affinity"
+	placementReasonNoClusterAvailable     = "no_cluster_available"
+	placementReasonSingleClusterAvailable = "single_cluster_available"
 )
 
 // ClusterPlacementStrategy ...
@@ -18,11 +37,26 @@ type ClusterPlacementStrategy interface {
 // NewClusterPlacementStrategy return a concrete strategy impl. depends on the
 // placement configuration. An appropriate ClusterPlacementStrategy implementation
 // is returned based on the received parameters content
-func NewClusterPlacementStrategy(clusterService ClusterService) ClusterPlacementStrategy {
-	return &FirstReadyPlacementStrategy{clusterService: clusterService}
+func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig) ClusterPlacementStrategy {
+	switch dataplaneClusterConfig.ClusterPlacementStrategy {
+	case config.LeastLoadedPlacement:
+		return &LeastLoadedPlacementStrategy{clusterService: clusterService}
+	case config.WeightedCapacityPlacement:
+		return &WeightedCapacityPlacementStrategy{clusterService: clusterService, randIntn: rand.Intn}
+	case config.OrgAffinityPlacement:
+		return &OrgAffinityPlacementStrategy{clusterService: clusterService}
+	case config.OrgAntiAffinityPlacement:
+		return &OrgAntiAffinityPlacementStrategy{clusterService: clusterService}
+	default:
+		return &FirstReadyPlacementStrategy{clusterService: clusterService}
+	}
 }
 
 var _ ClusterPlacementStrategy = (*FirstReadyPlacementStrategy)(nil)
+var _ ClusterPlacementStrategy = (*LeastLoadedPlacementStrategy)(nil)
+var _ ClusterPlacementStrategy = (*WeightedCapacityPlacementStrategy)(nil)
+var _ ClusterPlacementStrategy = (*OrgAffinityPlacementStrategy)(nil)
+var _ ClusterPlacementStrategy = (*OrgAntiAffinityPlacementStrategy)(nil)
 
 // FirstReadyPlacementStrategy ...
 type FirstReadyPlacementStrategy struct {
@@ -31,7 +65,185 @@ type FirstReadyPlacementStrategy struct {
 
 // FindCluster ...
 func (d FirstReadyPlacementStrategy) FindCluster(central *dbapi.CentralRequest) (*api.Cluster, error) {
-	clusters, err := d.clusterService.FindAllClusters(FindClusterCriteria{
+	clusters, err := findSchedulableClusters(d.clusterService, central)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clusters) == 0 {
+		return recordPlacementDecision(config.FirstReadyPlacement, placementReasonNoClusterAvailable, nil), nil
+	}
+	return recordPlacementDecision(config.FirstReadyPlacement, placementReasonFirstReady, clusters[0]), nil
+}
+
+// LeastLoadedPlacementStrategy places a Central on the schedulable cluster with the lowest number of Central instances.
+type LeastLoadedPlacementStrategy struct {
+	clusterService ClusterService
+}
+
+// FindCluster ...
+func (d LeastLoadedPlacementStrategy) FindCluster(central *dbapi.CentralRequest) (*api.Cluster, error) {
+	clusters, err := findSchedulableClusters(d.clusterService, central)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) <= 1 {
+		return recordSingleOrNoClusterDecision(config.LeastLoadedPlacement, clusters), nil
+	}
+
+	counts, err := countCentralsPerCluster(d.clusterService, "", clusters)
+	if err != nil {
+		return nil, err
+	}
+	return recordPlacementDecision(config.LeastLoadedPlacement, placementReasonLeastLoaded, leastLoadedCluster(clusters, counts)), nil
+}
+
+// WeightedCapacityPlacementStrategy places a Central on a randomly selected schedulable cluster. The probability of a
+// cluster to be selected is proportional to the remaining Central capacity reported by fleetshard-sync.
+type WeightedCapacityPlacementStrategy struct {
+	clusterService ClusterService
+	randIntn       func(n int) int
+}
+
+// FindCluster ...
+func (d WeightedCapacityPlacementStrategy) FindCluster(central *dbapi.CentralRequest) (*api.Cluster, error) {
+	clusters, err := findSchedulableClusters(d.clusterService, central)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) <= 1 {
+		return recordSingleOrNoClusterDecision(config.WeightedCapacityPlacement, clusters), nil
+	}
+
+	var weighted, notReported []*api.Cluster
+	var weights []int
+	totalWeight := 0
+	for _, c := range clusters {
+		capacity, err := c.GetCapacity()
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading capacity of cluster %q", c.ClusterID)
+		}
+		if capacity == nil {
+			notReported = append(notReported, c)
+			continue
+		}
+		if capacity.RemainingCentrals > 0 {
+			weighted = append(weighted, c)
+			weights = append(weights, capacity.RemainingCentrals)
+			totalWeight += capacity.RemainingCentrals
+		}
+	}
+
+	if totalWeight > 0 {
+		pick := d.randIntn(totalWeight)
+		for i, c := range weighted {
+			if pick < weights[i] {
+				return recordPlacementDecision(config.WeightedCapacityPlacement, placementReasonWeightedCapacity, c), nil
+			}
+			pick -= weights[i]
+		}
+	}
+
+	// The reported capacity is an estimate and clusters may scale up their compute nodes. Hence, we fall back
+	// to the least loaded cluster rather than refusing to place the Central.
+	reason := placementReasonCapacityExhausted
+	candidates := clusters
+	if len(notReported) > 0 {
+		reason = placementReasonCapacityNotReported
+		candidates = notReported
+	}
+	counts, err := countCentralsPerCluster(d.clusterService, "", candidates)
+	if err != nil {
+		return nil, err
+	}
+	return recordPlacementDecision(config.WeightedCapacityPlacement, reason, leastLoadedCluster(candidates, counts)), nil
+}
+
+// OrgAffinityPlacementStrategy places a Central on the schedulable cluster hosting the most Centrals of the same
+// organisation. Ties are resolved in favour of the least loaded cluster.
+type OrgAffinityPlacementStrategy struct {
+	clusterService ClusterService
+}
+
+// FindCluster ...
+func (d OrgAffinityPlacementStrategy) FindCluster(central *dbapi.CentralRequest) (*api.Cluster, error) {
+	clusters, err := findSchedulableClusters(d.clusterService, central)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) <= 1 {
+		return recordSingleOrNoClusterDecision(config.OrgAffinityPlacement, clusters), nil
+	}
+
+	orgCounts, err := countCentralsPerCluster(d.clusterService, central.OrganisationID, clusters)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := countCentralsPerCluster(d.clusterService, "", clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	maxOrgCount := 0
+	var candidates []*api.Cluster
+	for _, c := range clusters {
+		switch orgCount := orgCounts[c.ClusterID]; {
+		case orgCount > maxOrgCount:
+			maxOrgCount = orgCount
+			candidates = []*api.Cluster{c}
+		case orgCount == maxOrgCount && orgCount > 0:
+			candidates = append(candidates, c)
+		}
+	}
+	if len(candidates) == 0 {
+		return recordPlacementDecision(config.OrgAffinityPlacement, placementReasonNoOrgCentrals, leastLoadedCluster(clusters, counts)), nil
+	}
+	return recordPlacementDecision(config.OrgAffinityPlacement, placementReasonOrgAffinity, leastLoadedCluster(candidates, counts)), nil
+}
+
+// OrgAntiAffinityPlacementStrategy places a Central on the schedulable cluster hosting the fewest Centrals of the
+// same organisation. Ties are resolved in favour of the least loaded cluster.
+type OrgAntiAffinityPlacementStrategy struct {
+	clusterService ClusterService
+}
+
+// FindCluster ...
+func (d OrgAntiAffinityPlacementStrategy) FindCluster(central *dbapi.CentralRequest) (*api.Cluster, error) {
+	clusters, err := findSchedulableClusters(d.clusterService, central)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) <= 1 {
+		return recordSingleOrNoClusterDecision(config.OrgAntiAffinityPlacement, clusters), nil
+	}
+
+	orgCounts, err := countCentralsPerCluster(d.clusterService, central.OrganisationID, clusters)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := countCentralsPerCluster(d.clusterService, "", clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	minOrgCount := -1
+	var candidates []*api.Cluster
+	for _, c := range clusters {
+		switch orgCount := orgCounts[c.ClusterID]; {
+		case minOrgCount < 0 || orgCount < minOrgCount:
+			minOrgCount = orgCount
+			candidates = []*api.Cluster{c}
+		case orgCount == minOrgCount:
+			candidates = append(candidates, c)
+		}
+	}
+	return recordPlacementDecision(config.OrgAntiAffinityPlacement, placementReasonOrgAntiAffinity, leastLoadedCluster(candidates, counts)), nil
+}
+
+// findSchedulableClusters returns the ready and schedulable clusters supporting the Central's instance type
+// in the order returned by the ClusterService.
+func findSchedulableClusters(clusterService ClusterService, central *dbapi.CentralRequest) ([]*api.Cluster, error) {
+	clusters, err := clusterService.FindAllClusters(FindClusterCriteria{
 		Provider: central.CloudProvider,
 		Region:   central.Region,
 		MultiAZ:  central.MultiAZ,
@@ -41,13 +253,67 @@ func (d FirstReadyPlacementStrategy) FindCluster(central *dbapi.CentralRequest)
 		return nil, err
 	}
 
+	var res []*api.Cluster
 	for _, c := range clusters {
-		if c.Schedulable && supportsInstanceType(c, central.InstanceType) {
-			return c, nil
+		if c.IsSchedulable() && supportsInstanceType(c, central.InstanceType) {
+			res = append(res, c)
 		}
 	}
 
-	return nil, nil
+	return res, nil
+}
+
+// countCentralsPerCluster returns the number of Centrals per cluster ID. If organisationID is not empty,
+// only Centrals of this organisation are counted.
+func countCentralsPerCluster(clusterService ClusterService, organisationID string, clusters []*api.Cluster) (map[string]int, error) {
+	clusterIDs := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		clusterIDs = append(clusterIDs, c.ClusterID)
+	}
+
+	var counts []ResDinosaurInstanceCount
+	var svcErr *apiErrors.ServiceError
+	if organisationID == "" {
+		counts, svcErr = clusterService.FindDinosaurInstanceCount(clusterIDs)
+	} else {
+		counts, svcErr = clusterService.FindOrganisationDinosaurInstanceCount(organisationID, clusterIDs)
+	}
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	res := make(map[string]int, len(counts))
+	for _, count := range counts {
+		res[count.Clusterid] = count.Count
+	}
+	return res, nil
+}
+
+// leastLoadedCluster returns the first cluster with the lowest Central count.
+func leastLoadedCluster(clusters []*api.Cluster, counts map[string]int) *api.Cluster {
+	var res *api.Cluster
+	for _, c := range clusters {
+		if res == nil || counts[c.ClusterID] < counts[res.ClusterID] {
+			res = c
+		}
+	}
+	return res
+}
+
+func recordSingleOrNoClusterDecision(strategy string, clusters []*api.Cluster) *api.Cluster {
+	if len(clusters) == 0 {
+		return recordPlacementDecision(strategy, placementReasonNoClusterAvailable, nil)
+	}
+	return recordPlacementDecision(strategy, placementReasonSingleClusterAvailable, clusters[0])
+}
+
+func recordPlacementDecision(strategy, reason string, cluster *api.Cluster) *api.Cluster {
+	clusterID := ""
+	if cluster != nil {
+		clusterID = cluster.ClusterID
+	}
+	metrics.IncreaseCentralPlacementDecisionCountMetric(strategy, reason, clusterID)
+	return cluster
 }
 
 func supportsInstanceType(c *api.Cluster, instanceType string) bool {
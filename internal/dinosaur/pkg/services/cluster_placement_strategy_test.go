@@ -28,11 +28,43 @@ func TestPlacementStrategyType(t *testing.T) {
 			dataPlaneConfig: &config.DataplaneClusterConfig{},
 			expectedType:    &FirstReadyPlacementStrategy{},
 		},
+		{
+			description: "LeastLoadedPlacementStrategy",
+			createClusterService: func() ClusterService {
+				return &ClusterServiceMock{}
+			},
+			dataPlaneConfig: &config.DataplaneClusterConfig{ClusterPlacementStrategy: config.LeastLoadedPlacement},
+			expectedType:    &LeastLoadedPlacementStrategy{},
+		},
+		{
+			description: "WeightedCapacityPlacementStrategy",
+			createClusterService: func() ClusterService {
+				return &ClusterServiceMock{}
+			},
+			dataPlaneConfig: &config.DataplaneClusterConfig{ClusterPlacementStrategy: config.WeightedCapacityPlacement},
+			expectedType:    &WeightedCapacityPlacementStrategy{},
+		},
+		{
+			description: "OrgAffinityPlacementStrategy",
+			createClusterService: func() ClusterService {
+				return &ClusterServiceMock{}
+			},
+			dataPlaneConfig: &config.DataplaneClusterConfig{ClusterPlacementStrategy: config.OrgAffinityPlacement},
+			expectedType:    &OrgAffinityPlacementStrategy{},
+		},
+		{
+			description: "OrgAntiAffinityPlacementStrategy",
+			createClusterService: func() ClusterService {
+				return &ClusterServiceMock{}
+			},
+			dataPlaneConfig: &config.DataplaneClusterConfig{ClusterPlacementStrategy: config.OrgAntiAffinityPlacement},
+			expectedType:    &OrgAntiAffinityPlacementStrategy{},
+		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.description, func(t *testing.T) {
-			strategy := NewClusterPlacementStrategy(tc.createClusterService())
+			strategy := NewClusterPlacementStrategy(tc.createClusterService(), tc.dataPlaneConfig)
 
 			require.IsType(t, tc.expectedType, strategy)
 		})
@@ -156,3 +188,223 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 
 	}
 }
+
+func buildPlacementClusters(t *testing.T) (*api.Cluster, *api.Cluster, *api.Cluster) {
+	newCluster := func(clusterID string, remainingCentrals int, reported bool) *api.Cluster {
+		return buildCluster(func(cluster *api.Cluster) {
+			cluster.ClusterID = clusterID
+			cluster.SupportedInstanceType = "standard,eval"
+			if reported {
+				require.NoError(t, cluster.SetCapacity(api.ClusterCapacity{RemainingCentrals: remainingCentrals}))
+			}
+		})
+	}
+	return newCluster("cluster1", 1, true), newCluster("cluster2", 3, true), newCluster("cluster3", 0, false)
+}
+
+func newPlacementClusterServiceMock(clusters []*api.Cluster, counts map[string]int, orgCounts map[string]int) *ClusterServiceMock {
+	toRes := func(counts map[string]int, clusterIDs []string) []ResDinosaurInstanceCount {
+		res := make([]ResDinosaurInstanceCount, 0, len(clusterIDs))
+		for _, clusterID := range clusterIDs {
+			res = append(res, ResDinosaurInstanceCount{Clusterid: clusterID, Count: counts[clusterID]})
+		}
+		return res
+	}
+	return &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+			return clusters, nil
+		},
+		FindDinosaurInstanceCountFunc: func(clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceErrors.ServiceError) {
+			return toRes(counts, clusterIDs), nil
+		},
+		FindOrganisationDinosaurInstanceCountFunc: func(organisationID string, clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceErrors.ServiceError) {
+			return toRes(orgCounts, clusterIDs), nil
+		},
+	}
+}
+
+func TestLeastLoadedPlacementStrategy(t *testing.T) {
+	centralRequest := buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+		centralRequest.InstanceType = "standard"
+	})
+	cluster1, cluster2, cluster3 := buildPlacementClusters(t)
+
+	tt := []struct {
+		description     string
+		clusterService  *ClusterServiceMock
+		expectedError   bool
+		expectedCluster *api.Cluster
+	}{
+		{
+			description:     "should return the cluster with the fewest centrals",
+			clusterService:  newPlacementClusterServiceMock([]*api.Cluster{cluster1, cluster2, cluster3}, map[string]int{"cluster1": 5, "cluster2": 2, "cluster3": 4}, nil),
+			expectedCluster: cluster2,
+		},
+		{
+			description:     "should return the first cluster on a tie",
+			clusterService:  newPlacementClusterServiceMock([]*api.Cluster{cluster1, cluster2}, map[string]int{}, nil),
+			expectedCluster: cluster1,
+		},
+		{
+			description:     "should return nil if clusters is empty",
+			clusterService:  newPlacementClusterServiceMock([]*api.Cluster{}, nil, nil),
+			expectedCluster: nil,
+		},
+		{
+			description: "should return error if FindDinosaurInstanceCount returns error",
+			clusterService: &ClusterServiceMock{
+				FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+					return []*api.Cluster{cluster1, cluster2}, nil
+				},
+				FindDinosaurInstanceCountFunc: func(clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceErrors.ServiceError) {
+					return nil, serviceErrors.New(apiErrors.ErrorGeneral, "error in FindDinosaurInstanceCount")
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			strategy := LeastLoadedPlacementStrategy{clusterService: tc.clusterService}
+			cluster, err := strategy.FindCluster(centralRequest)
+			if tc.expectedError {
+				require.Error(t, err)
+				require.Nil(t, cluster)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCluster, cluster)
+		})
+	}
+}
+
+func TestWeightedCapacityPlacementStrategy(t *testing.T) {
+	centralRequest := buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+		centralRequest.InstanceType = "standard"
+	})
+	cluster1, cluster2, cluster3 := buildPlacementClusters(t)
+	exhausted := buildCluster(func(cluster *api.Cluster) {
+		cluster.ClusterID = "exhausted"
+		cluster.SupportedInstanceType = "standard"
+		require.NoError(t, cluster.SetCapacity(api.ClusterCapacity{RemainingCentrals: 0}))
+	})
+
+	tt := []struct {
+		description     string
+		clusters        []*api.Cluster
+		counts          map[string]int
+		pick            int
+		expectedCluster *api.Cluster
+	}{
+		{
+			description:     "should pick the first cluster within its weight",
+			clusters:        []*api.Cluster{cluster1, cluster2, cluster3},
+			pick:            0,
+			expectedCluster: cluster1,
+		},
+		{
+			description:     "should pick the second cluster within its weight",
+			clusters:        []*api.Cluster{cluster1, cluster2, cluster3},
+			pick:            3,
+			expectedCluster: cluster2,
+		},
+		{
+			description:     "should fall back to clusters not reporting capacity",
+			clusters:        []*api.Cluster{exhausted, cluster3},
+			counts:          map[string]int{"exhausted": 0, "cluster3": 10},
+			expectedCluster: cluster3,
+		},
+		{
+			description:     "should fall back to the least loaded cluster if capacity is exhausted",
+			clusters:        []*api.Cluster{exhausted, buildCluster(func(cluster *api.Cluster) { cluster.ClusterID = "loaded" })},
+			counts:          map[string]int{"exhausted": 1, "loaded": 10},
+			expectedCluster: exhausted,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			var totalWeight int
+			strategy := WeightedCapacityPlacementStrategy{
+				clusterService: newPlacementClusterServiceMock(tc.clusters, tc.counts, nil),
+				randIntn: func(n int) int {
+					totalWeight = n
+					return tc.pick
+				},
+			}
+			cluster, err := strategy.FindCluster(centralRequest)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCluster, cluster)
+			if tc.expectedCluster == cluster1 || tc.expectedCluster == cluster2 {
+				require.Equal(t, 4, totalWeight)
+			}
+		})
+	}
+}
+
+func TestOrgAffinityPlacementStrategies(t *testing.T) {
+	centralRequest := buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+		centralRequest.InstanceType = "standard"
+		centralRequest.OrganisationID = "org"
+	})
+	cluster1, cluster2, cluster3 := buildPlacementClusters(t)
+	clusters := []*api.Cluster{cluster1, cluster2, cluster3}
+
+	tt := []struct {
+		description     string
+		counts          map[string]int
+		orgCounts       map[string]int
+		antiAffinity    bool
+		expectedCluster *api.Cluster
+	}{
+		{
+			description:     "affinity should return the cluster with most centrals of the organisation",
+			counts:          map[string]int{"cluster1": 1, "cluster2": 5, "cluster3": 5},
+			orgCounts:       map[string]int{"cluster2": 1, "cluster3": 2},
+			expectedCluster: cluster3,
+		},
+		{
+			description:     "affinity should prefer the least loaded cluster on a tie",
+			counts:          map[string]int{"cluster1": 1, "cluster2": 5, "cluster3": 3},
+			orgCounts:       map[string]int{"cluster2": 2, "cluster3": 2},
+			expectedCluster: cluster3,
+		},
+		{
+			description:     "affinity should return the least loaded cluster if the organisation has no centrals",
+			counts:          map[string]int{"cluster1": 3, "cluster2": 2, "cluster3": 5},
+			orgCounts:       map[string]int{},
+			expectedCluster: cluster2,
+		},
+		{
+			description:     "anti-affinity should return the cluster with fewest centrals of the organisation",
+			counts:          map[string]int{"cluster1": 1, "cluster2": 5, "cluster3": 5},
+			orgCounts:       map[string]int{"cluster1": 2, "cluster2": 1, "cluster3": 3},
+			antiAffinity:    true,
+			expectedCluster: cluster2,
+		},
+		{
+			description:     "anti-affinity should prefer the least loaded cluster on a tie",
+			counts:          map[string]int{"cluster1": 4, "cluster2": 5, "cluster3": 3},
+			orgCounts:       map[string]int{"cluster1": 1},
+			antiAffinity:    true,
+			expectedCluster: cluster3,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			clusterService := newPlacementClusterServiceMock(clusters, tc.counts, tc.orgCounts)
+			var strategy ClusterPlacementStrategy = OrgAffinityPlacementStrategy{clusterService: clusterService}
+			if tc.antiAffinity {
+				strategy = OrgAntiAffinityPlacementStrategy{clusterService: clusterService}
+			}
+			cluster, err := strategy.FindCluster(centralRequest)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCluster, cluster)
+			for _, call := range clusterService.FindOrganisationDinosaurInstanceCountCalls() {
+				require.Equal(t, "org", call.OrganisationID)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 
 	constants2 "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
@@ -58,6 +59,11 @@ type ClusterService interface {
 	FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *apiErrors.ServiceError)
 	// FindDinosaurInstanceCount returns the dinosaur instance counts associated with the list of clusters. If the list is empty, it will list all clusterIds that have Dinosaur instances assigned.
 	FindDinosaurInstanceCount(clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError)
+	// FindOrganisationDinosaurInstanceCount returns the dinosaur instance counts of the given organisation associated with the list of clusters.
+	// Clusters without any dinosaur instance of the organisation are reported with a count of 0.
+	FindOrganisationDinosaurInstanceCount(organisationID string, clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError)
+	// FindCentralOperatorVersions returns the distinct desired and rolled out central operator versions of the centrals assigned to the given cluster.
+	FindCentralOperatorVersions(clusterID string) ([]string, *apiErrors.ServiceError)
 	// UpdateMultiClusterStatus updates a list of clusters' status to a status
 	UpdateMultiClusterStatus(clusterIds []string, status api.ClusterStatus) *apiErrors.ServiceError
 	// CountByStatus returns the count of clusters for each given status in the database
@@ -509,12 +515,25 @@ func (c clusterService) GetExternalID(clusterID string) (string, *apiErrors.Serv
 
 // FindDinosaurInstanceCount ...
 func (c clusterService) FindDinosaurInstanceCount(clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError) {
+	return c.findDinosaurInstanceCount("", clusterIDs)
+}
+
+// FindOrganisationDinosaurInstanceCount ...
+func (c clusterService) FindOrganisationDinosaurInstanceCount(organisationID string, clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError) {
+	return c.findDinosaurInstanceCount(organisationID, clusterIDs)
+}
+
+func (c clusterService) findDinosaurInstanceCount(organisationID string, clusterIDs []string) ([]ResDinosaurInstanceCount, *apiErrors.ServiceError) {
 	var res []ResDinosaurInstanceCount
 	query := c.connectionFactory.New().
 		Model(&dbapi.CentralRequest{}).
 		Select("cluster_id as Clusterid, count(1) as Count").
 		Where("status != ?", constants2.CentralRequestStatusAccepted.String()) // dinosaur in accepted state do not have a cluster_id assigned to them
 
+	if organisationID != "" {
+		query = query.Where("organisation_id = ?", organisationID)
+	}
+
 	if len(clusterIDs) > 0 {
 		query = query.Where("cluster_id in (?)", clusterIDs)
 	} else {
@@ -543,6 +562,32 @@ func (c clusterService) FindDinosaurInstanceCount(clusterIDs []string) ([]ResDin
 	return res, nil
 }
 
+// FindCentralOperatorVersions ...
+func (c clusterService) FindCentralOperatorVersions(clusterID string) ([]string, *apiErrors.ServiceError) {
+	// Centrals with a maintenance window keep using the rolled out operator version until the window starts.
+	seen := map[string]bool{}
+	var versions []string
+	for _, column := range []string{"desired_central_operator_version", "rolled_out_central_operator_version"} {
+		var columnVersions []string
+		if err := c.connectionFactory.New().
+			Model(&dbapi.CentralRequest{}).
+			Distinct(column).
+			Where("cluster_id = ?", clusterID).
+			Where(column+" != ''").
+			Pluck(column, &columnVersions).Error; err != nil {
+			return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find central operator versions of cluster %s", clusterID)
+		}
+		for _, version := range columnVersions {
+			if !seen[version] {
+				seen[version] = true
+				versions = append(versions, version)
+			}
+		}
+	}
+	sort.Strings(versions)
+	return versions, nil
+}
+
 // FindAllClusters ...
 func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *apiErrors.ServiceError) {
 	dbConn := c.connectionFactory.New().
@@ -1290,6 +1290,30 @@ func Test_clusterService_FindDinosaurInstanceCount(t *testing.T) {
 	}
 }
 
+func Test_clusterService_FindOrganisationDinosaurInstanceCount(t *testing.T) {
+	mocket.Catcher.Reset().NewMock().WithQuery(`organisation_id = $2`).WithReply([]map[string]interface{}{
+		{
+			"clusterid": "test01",
+			"count":     1,
+		},
+	})
+	c := clusterService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+	}
+
+	got, err := c.FindOrganisationDinosaurInstanceCount("org", []string{"test01", "test02"})
+	if err != nil {
+		t.Fatalf("FindOrganisationDinosaurInstanceCount() unexpected error = %v", err)
+	}
+	want := []ResDinosaurInstanceCount{
+		{Clusterid: "test01", Count: 1},
+		{Clusterid: "test02", Count: 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindOrganisationDinosaurInstanceCount() got = %v, want %v", got, want)
+	}
+}
+
 func Test_clusterService_FindAllClusters(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2237,3 +2261,20 @@ func Test_ClusterService_GetExternalID(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterService_FindCentralOperatorVersions(t *testing.T) {
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT DISTINCT "desired_central_operator_version" FROM "central_requests"`).
+		WithReply([]map[string]interface{}{{"desired_central_operator_version": "4.0.0"}, {"desired_central_operator_version": "3.74.0"}})
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT DISTINCT "rolled_out_central_operator_version" FROM "central_requests"`).
+		WithReply([]map[string]interface{}{{"rolled_out_central_operator_version": "3.74.0"}, {"rolled_out_central_operator_version": "3.73.0"}})
+	c := &clusterService{connectionFactory: db.NewMockConnectionFactory(nil)}
+
+	versions, svcErr := c.FindCentralOperatorVersions(testClusterID)
+
+	g := gomega.NewWithT(t)
+	g.Expect(svcErr).To(gomega.BeNil())
+	g.Expect(versions).To(gomega.Equal([]string{"3.73.0", "3.74.0", "4.0.0"}))
+}
@@ -47,6 +47,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceError.ServiceError) {
 //				panic("mock out the FindAllClusters method")
 //			},
+//			FindCentralOperatorVersionsFunc: func(clusterID string) ([]string, *serviceError.ServiceError) {
+//				panic("mock out the FindCentralOperatorVersions method")
+//			},
 //			FindClusterFunc: func(criteria FindClusterCriteria) (*api.Cluster, *serviceError.ServiceError) {
 //				panic("mock out the FindCluster method")
 //			},
@@ -59,6 +62,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *serviceError.ServiceError) {
 //				panic("mock out the FindNonEmptyClusterByID method")
 //			},
+//			FindOrganisationDinosaurInstanceCountFunc: func(organisationID string, clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceError.ServiceError) {
+//				panic("mock out the FindOrganisationDinosaurInstanceCount method")
+//			},
 //			GetClusterDNSFunc: func(clusterID string) (string, *serviceError.ServiceError) {
 //				panic("mock out the GetClusterDNS method")
 //			},
@@ -141,6 +147,9 @@ type ClusterServiceMock struct {
 	// FindAllClustersFunc mocks the FindAllClusters method.
 	FindAllClustersFunc func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceError.ServiceError)
 
+	// FindCentralOperatorVersionsFunc mocks the FindCentralOperatorVersions method.
+	FindCentralOperatorVersionsFunc func(clusterID string) ([]string, *serviceError.ServiceError)
+
 	// FindClusterFunc mocks the FindCluster method.
 	FindClusterFunc func(criteria FindClusterCriteria) (*api.Cluster, *serviceError.ServiceError)
 
@@ -153,6 +162,9 @@ type ClusterServiceMock struct {
 	// FindNonEmptyClusterByIDFunc mocks the FindNonEmptyClusterByID method.
 	FindNonEmptyClusterByIDFunc func(clusterID string) (*api.Cluster, *serviceError.ServiceError)
 
+	// FindOrganisationDinosaurInstanceCountFunc mocks the FindOrganisationDinosaurInstanceCount method.
+	FindOrganisationDinosaurInstanceCountFunc func(organisationID string, clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceError.ServiceError)
+
 	// GetClusterDNSFunc mocks the GetClusterDNS method.
 	GetClusterDNSFunc func(clusterID string) (string, *serviceError.ServiceError)
 
@@ -254,6 +266,11 @@ type ClusterServiceMock struct {
 			// Criteria is the criteria argument value.
 			Criteria FindClusterCriteria
 		}
+		// FindCentralOperatorVersions holds details about calls to the FindCentralOperatorVersions method.
+		FindCentralOperatorVersions []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
 		// FindCluster holds details about calls to the FindCluster method.
 		FindCluster []struct {
 			// Criteria is the criteria argument value.
@@ -274,6 +291,13 @@ type ClusterServiceMock struct {
 			// ClusterID is the clusterID argument value.
 			ClusterID string
 		}
+		// FindOrganisationDinosaurInstanceCount holds details about calls to the FindOrganisationDinosaurInstanceCount method.
+		FindOrganisationDinosaurInstanceCount []struct {
+			// OrganisationID is the organisationID argument value.
+			OrganisationID string
+			// ClusterIDs is the clusterIDs argument value.
+			ClusterIDs []string
+		}
 		// GetClusterDNS holds details about calls to the GetClusterDNS method.
 		GetClusterDNS []struct {
 			// ClusterID is the clusterID argument value.
@@ -373,35 +397,37 @@ type ClusterServiceMock struct {
 			Values map[string]interface{}
 		}
 	}
-	lockApplyResources                      sync.RWMutex
-	lockCheckClusterStatus                  sync.RWMutex
-	lockCheckDinosaurOperatorVersionReady   sync.RWMutex
-	lockConfigureAndSaveIdentityProvider    sync.RWMutex
-	lockCountByStatus                       sync.RWMutex
-	lockCreate                              sync.RWMutex
-	lockDelete                              sync.RWMutex
-	lockDeleteByClusterID                   sync.RWMutex
-	lockFindAllClusters                     sync.RWMutex
-	lockFindCluster                         sync.RWMutex
-	lockFindClusterByID                     sync.RWMutex
-	lockFindDinosaurInstanceCount           sync.RWMutex
-	lockFindNonEmptyClusterByID             sync.RWMutex
-	lockGetClusterDNS                       sync.RWMutex
-	lockGetComputeNodes                     sync.RWMutex
-	lockGetExternalID                       sync.RWMutex
-	lockInstallDinosaurOperator             sync.RWMutex
-	lockIsDinosaurVersionAvailableInCluster sync.RWMutex
-	lockListAllClusterIds                   sync.RWMutex
-	lockListByStatus                        sync.RWMutex
-	lockListGroupByProviderAndRegion        sync.RWMutex
-	lockRegisterClusterJob                  sync.RWMutex
-	lockScaleDownComputeNodes               sync.RWMutex
-	lockScaleUpComputeNodes                 sync.RWMutex
-	lockSetComputeNodes                     sync.RWMutex
-	lockUpdate                              sync.RWMutex
-	lockUpdateMultiClusterStatus            sync.RWMutex
-	lockUpdateStatus                        sync.RWMutex
-	lockUpdates                             sync.RWMutex
+	lockApplyResources                        sync.RWMutex
+	lockCheckClusterStatus                    sync.RWMutex
+	lockCheckDinosaurOperatorVersionReady     sync.RWMutex
+	lockConfigureAndSaveIdentityProvider      sync.RWMutex
+	lockCountByStatus                         sync.RWMutex
+	lockCreate                                sync.RWMutex
+	lockDelete                                sync.RWMutex
+	lockDeleteByClusterID                     sync.RWMutex
+	lockFindAllClusters                       sync.RWMutex
+	lockFindCentralOperatorVersions           sync.RWMutex
+	lockFindCluster                           sync.RWMutex
+	lockFindClusterByID                       sync.RWMutex
+	lockFindDinosaurInstanceCount             sync.RWMutex
+	lockFindNonEmptyClusterByID               sync.RWMutex
+	lockFindOrganisationDinosaurInstanceCount sync.RWMutex
+	lockGetClusterDNS                         sync.RWMutex
+	lockGetComputeNodes                       sync.RWMutex
+	lockGetExternalID                         sync.RWMutex
+	lockInstallDinosaurOperator               sync.RWMutex
+	lockIsDinosaurVersionAvailableInCluster   sync.RWMutex
+	lockListAllClusterIds                     sync.RWMutex
+	lockListByStatus                          sync.RWMutex
+	lockListGroupByProviderAndRegion          sync.RWMutex
+	lockRegisterClusterJob                    sync.RWMutex
+	lockScaleDownComputeNodes                 sync.RWMutex
+	lockScaleUpComputeNodes                   sync.RWMutex
+	lockSetComputeNodes                       sync.RWMutex
+	lockUpdate                                sync.RWMutex
+	lockUpdateMultiClusterStatus              sync.RWMutex
+	lockUpdateStatus                          sync.RWMutex
+	lockUpdates                               sync.RWMutex
 }
 
 // ApplyResources calls ApplyResourcesFunc.
@@ -704,6 +730,38 @@ func (mock *ClusterServiceMock) FindAllClustersCalls() []struct {
 	return calls
 }
 
+// FindCentralOperatorVersions calls FindCentralOperatorVersionsFunc.
+func (mock *ClusterServiceMock) FindCentralOperatorVersions(clusterID string) ([]string, *serviceError.ServiceError) {
+	if mock.FindCentralOperatorVersionsFunc == nil {
+		panic("ClusterServiceMock.FindCentralOperatorVersionsFunc: method is nil but ClusterService.FindCentralOperatorVersions was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockFindCentralOperatorVersions.Lock()
+	mock.calls.FindCentralOperatorVersions = append(mock.calls.FindCentralOperatorVersions, callInfo)
+	mock.lockFindCentralOperatorVersions.Unlock()
+	return mock.FindCentralOperatorVersionsFunc(clusterID)
+}
+
+// FindCentralOperatorVersionsCalls gets all the calls that were made to FindCentralOperatorVersions.
+// Check the length with:
+//
+//	len(mockedClusterService.FindCentralOperatorVersionsCalls())
+func (mock *ClusterServiceMock) FindCentralOperatorVersionsCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockFindCentralOperatorVersions.RLock()
+	calls = mock.calls.FindCentralOperatorVersions
+	mock.lockFindCentralOperatorVersions.RUnlock()
+	return calls
+}
+
 // FindCluster calls FindClusterFunc.
 func (mock *ClusterServiceMock) FindCluster(criteria FindClusterCriteria) (*api.Cluster, *serviceError.ServiceError) {
 	if mock.FindClusterFunc == nil {
@@ -832,6 +890,42 @@ func (mock *ClusterServiceMock) FindNonEmptyClusterByIDCalls() []struct {
 	return calls
 }
 
+// FindOrganisationDinosaurInstanceCount calls FindOrganisationDinosaurInstanceCountFunc.
+func (mock *ClusterServiceMock) FindOrganisationDinosaurInstanceCount(organisationID string, clusterIDs []string) ([]ResDinosaurInstanceCount, *serviceError.ServiceError) {
+	if mock.FindOrganisationDinosaurInstanceCountFunc == nil {
+		panic("ClusterServiceMock.FindOrganisationDinosaurInstanceCountFunc: method is nil but ClusterService.FindOrganisationDinosaurInstanceCount was just called")
+	}
+	callInfo := struct {
+		OrganisationID string
+		ClusterIDs     []string
+	}{
+		OrganisationID: organisationID,
+		ClusterIDs:     clusterIDs,
+	}
+	mock.lockFindOrganisationDinosaurInstanceCount.Lock()
+	mock.calls.FindOrganisationDinosaurInstanceCount = append(mock.calls.FindOrganisationDinosaurInstanceCount, callInfo)
+	mock.lockFindOrganisationDinosaurInstanceCount.Unlock()
+	return mock.FindOrganisationDinosaurInstanceCountFunc(organisationID, clusterIDs)
+}
+
+// FindOrganisationDinosaurInstanceCountCalls gets all the calls that were made to FindOrganisationDinosaurInstanceCount.
+// Check the length with:
+//
+//	len(mockedClusterService.FindOrganisationDinosaurInstanceCountCalls())
+func (mock *ClusterServiceMock) FindOrganisationDinosaurInstanceCountCalls() []struct {
+	OrganisationID string
+	ClusterIDs     []string
+} {
+	var calls []struct {
+		OrganisationID string
+		ClusterIDs     []string
+	}
+	mock.lockFindOrganisationDinosaurInstanceCount.RLock()
+	calls = mock.calls.FindOrganisationDinosaurInstanceCount
+	mock.lockFindOrganisationDinosaurInstanceCount.RUnlock()
+	return calls
+}
+
 // GetClusterDNS calls GetClusterDNSFunc.
 func (mock *ClusterServiceMock) GetClusterDNS(clusterID string) (string, *serviceError.ServiceError) {
 	if mock.GetClusterDNSFunc == nil {
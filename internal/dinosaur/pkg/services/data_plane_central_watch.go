@@ -0,0 +1,448 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/lib/pq"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/environments"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// centralRequestsChangedChannel is the notification channel the central_requests trigger notifies on.
+const centralRequestsChangedChannel = "central_requests_changed"
+
+// WatchEventType is the type of change of a Central reported to a data-plane watch.
+type WatchEventType string
+
+// Watch event types.
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventModified WatchEventType = "MODIFIED"
+	WatchEventDeleted  WatchEventType = "DELETED"
+)
+
+// CentralWatchEvent describes the change of a Central assigned to a data-plane cluster.
+type CentralWatchEvent struct {
+	Type      WatchEventType
+	CentralID string
+	// ResourceVersion is the version of the Central. It is empty for DELETED events.
+	ResourceVersion string
+	// Central is nil for DELETED events.
+	Central *private.ManagedCentral
+}
+
+// CentralWatchResult is the result of a data-plane watch request.
+type CentralWatchResult struct {
+	// ResourceVersion identifies the set of Centrals after applying the events. It is passed to the next watch request.
+	ResourceVersion string
+	Events          []CentralWatchEvent
+}
+
+// ManagedCentralPresenterFunc presents a Central as the ManagedCentral sent to the data-plane cluster with the given ID.
+type ManagedCentralPresenterFunc func(central *dbapi.CentralRequest, clusterID string) private.ManagedCentral
+
+// DataPlaneCentralWatchService lets data-plane clusters watch the Centrals assigned to them.
+//
+//go:generate moq -out data_plane_central_watch_moq.go . DataPlaneCentralWatchService
+type DataPlaneCentralWatchService interface {
+	environments.BootService
+	// Watch returns the changes of the Centrals assigned to the given cluster since the given resource version.
+	// If there are no changes, it waits until there are changes, the timeout expires or the context is done.
+	// Centrals are compared as presented by the given function, so that changes the cluster does not see are
+	// not reported. An empty resource version returns all Centrals as ADDED events. A resource version that is
+	// not known (anymore) results in an ErrorGone error, in which case the caller has to start over without a
+	// resource version.
+	Watch(ctx context.Context, clusterID string, resourceVersion string, timeout time.Duration, present ManagedCentralPresenterFunc) (*CentralWatchResult, *errors.ServiceError)
+}
+
+var _ DataPlaneCentralWatchService = &dataPlaneCentralWatchService{}
+
+type dataPlaneCentralWatchService struct {
+	connectionFactory *db.ConnectionFactory
+	dinosaurService   DinosaurService
+	config            *config.CentralWatchConfig
+	notifier          *centralChangeNotifier
+	history           centralSnapshotStore
+	listener          *pq.Listener
+	stopCh            chan struct{}
+}
+
+// NewDataPlaneCentralWatchService ...
+func NewDataPlaneCentralWatchService(connectionFactory *db.ConnectionFactory, dinosaurService DinosaurService, config *config.CentralWatchConfig) DataPlaneCentralWatchService {
+	return &dataPlaneCentralWatchService{
+		connectionFactory: connectionFactory,
+		dinosaurService:   dinosaurService,
+		config:            config,
+		notifier:          newCentralChangeNotifier(),
+		history:           newDBCentralSnapshotStore(connectionFactory, config.HistorySize),
+		stopCh:            make(chan struct{}),
+	}
+}
+
+// Start listens for change notifications of the central_requests table. Without notifications,
+// waiting watch requests still pick up changes every resync period.
+func (s *dataPlaneCentralWatchService) Start() {
+	s.listener = pq.NewListener(s.connectionFactory.Config.ConnectionString(), time.Second, time.Minute,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				glog.Warningf("central change notification listener: %v", err)
+			}
+		})
+	go func() {
+		// Listen blocks until the connection is established.
+		if err := s.listener.Listen(centralRequestsChangedChannel); err != nil {
+			glog.Errorf("listening on %s: %v", centralRequestsChangedChannel, err)
+			return
+		}
+		s.dispatchNotifications(s.listener.Notify)
+	}()
+	glog.Info("central change notification listener started")
+}
+
+// dispatchNotifications wakes up the watch requests of the clusters named in the notifications. Notifications
+// are collected for the debounce period, so that a burst of writes wakes up every watch request only once.
+func (s *dataPlaneCentralWatchService) dispatchNotifications(notifications <-chan *pq.Notification) {
+	changedClusters := map[string]struct{}{}
+	allChanged := false
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case notification := <-notifications:
+			// A nil notification is sent after a reconnect, when notifications may have been lost.
+			if notification == nil || notification.Extra == "" {
+				allChanged = true
+			} else {
+				changedClusters[notification.Extra] = struct{}{}
+			}
+			if debounce == nil {
+				debounce = time.After(s.config.DebouncePeriod)
+			}
+		case <-debounce:
+			if allChanged {
+				s.notifier.notifyAll()
+			} else {
+				for clusterID := range changedClusters {
+					s.notifier.notify(clusterID)
+				}
+			}
+			changedClusters = map[string]struct{}{}
+			allChanged = false
+			debounce = nil
+		}
+	}
+}
+
+// Stop stops listening for change notifications.
+func (s *dataPlaneCentralWatchService) Stop() {
+	close(s.stopCh)
+	if s.listener != nil {
+		if err := s.listener.Close(); err != nil {
+			glog.Errorf("closing central change notification listener: %v", err)
+		}
+	}
+}
+
+// Watch ...
+func (s *dataPlaneCentralWatchService) Watch(ctx context.Context, clusterID string, resourceVersion string, timeout time.Duration, present ManagedCentralPresenterFunc) (*CentralWatchResult, *errors.ServiceError) {
+	if timeout <= 0 || timeout > s.config.MaxTimeout {
+		timeout = s.config.MaxTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		// Subscribe before listing, so that a change in between is not missed.
+		changed := s.notifier.changed(clusterID)
+		centralRequests, svcErr := s.dinosaurService.ListByClusterID(clusterID)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		centrals := make([]private.ManagedCentral, 0, len(centralRequests))
+		for _, centralRequest := range centralRequests {
+			centrals = append(centrals, present(centralRequest, clusterID))
+		}
+		current := newCentralSnapshot(centrals)
+		currentVersion := current.resourceVersion()
+		if err := s.history.add(clusterID, currentVersion, current); err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to store resource version %q of cluster %q", currentVersion, clusterID)
+		}
+
+		if resourceVersion == "" {
+			return &CentralWatchResult{ResourceVersion: currentVersion, Events: diffCentralSnapshots(centralSnapshot{}, current, centrals)}, nil
+		}
+		if resourceVersion != currentVersion {
+			previous, ok, err := s.history.get(clusterID, resourceVersion)
+			if err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get resource version %q of cluster %q", resourceVersion, clusterID)
+			}
+			if !ok {
+				return nil, errors.New(errors.ErrorGone, "resource version %q of cluster %q is too old", resourceVersion, clusterID)
+			}
+			return &CentralWatchResult{ResourceVersion: currentVersion, Events: diffCentralSnapshots(previous, current, centrals)}, nil
+		}
+
+		resync := time.NewTimer(s.config.ResyncPeriod)
+		select {
+		case <-changed:
+		case <-resync.C:
+		case <-timer.C:
+			resync.Stop()
+			return &CentralWatchResult{ResourceVersion: currentVersion, Events: []CentralWatchEvent{}}, nil
+		case <-ctx.Done():
+			resync.Stop()
+			return &CentralWatchResult{ResourceVersion: currentVersion, Events: []CentralWatchEvent{}}, nil
+		}
+		resync.Stop()
+	}
+}
+
+// centralSnapshot maps the IDs of the Centrals assigned to a cluster to their resource versions.
+type centralSnapshot map[string]string
+
+func newCentralSnapshot(centrals []private.ManagedCentral) centralSnapshot {
+	snapshot := make(centralSnapshot, len(centrals))
+	for _, central := range centrals {
+		snapshot[central.Id] = centralResourceVersion(central)
+	}
+	return snapshot
+}
+
+// resourceVersion returns a version identifying the whole set of Centrals.
+func (s centralSnapshot) resourceVersion() string {
+	ids := make([]string, 0, len(s))
+	for id := range s {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	hash := sha256.New()
+	for _, id := range ids {
+		hash.Write([]byte(id + "=" + s[id] + "\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// centralResourceVersion returns a version of the ManagedCentral that changes whenever any of its fields change.
+// Columns of the Central that are not presented to the data-plane cluster do not change the version.
+func centralResourceVersion(central private.ManagedCentral) string {
+	data, err := json.Marshal(central)
+	if err != nil {
+		// Treat the Central as changed on every watch request rather than never.
+		return time.Now().String()
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// diffCentralSnapshots returns the events leading from the previous to the current snapshot, sorted by Central ID.
+func diffCentralSnapshots(previous, current centralSnapshot, centrals []private.ManagedCentral) []CentralWatchEvent {
+	events := []CentralWatchEvent{}
+	for i := range centrals {
+		central := &centrals[i]
+		version := current[central.Id]
+		previousVersion, existed := previous[central.Id]
+		switch {
+		case !existed:
+			events = append(events, CentralWatchEvent{Type: WatchEventAdded, CentralID: central.Id, ResourceVersion: version, Central: central})
+		case previousVersion != version:
+			events = append(events, CentralWatchEvent{Type: WatchEventModified, CentralID: central.Id, ResourceVersion: version, Central: central})
+		}
+	}
+	for id := range previous {
+		if _, exists := current[id]; !exists {
+			events = append(events, CentralWatchEvent{Type: WatchEventDeleted, CentralID: id})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CentralID < events[j].CentralID
+	})
+	return events
+}
+
+// centralSnapshotStore keeps the most recent snapshots of each cluster, so that watch requests can resume from them.
+type centralSnapshotStore interface {
+	add(clusterID, resourceVersion string, snapshot centralSnapshot) error
+	get(clusterID, resourceVersion string) (centralSnapshot, bool, error)
+}
+
+type versionedCentralSnapshot struct {
+	resourceVersion string
+	snapshot        centralSnapshot
+}
+
+// centralSnapshotHistory keeps the most recent snapshots of each cluster in memory.
+type centralSnapshotHistory struct {
+	mutex     sync.Mutex
+	size      int
+	snapshots map[string][]versionedCentralSnapshot
+}
+
+func newCentralSnapshotHistory(size int) *centralSnapshotHistory {
+	if size < 1 {
+		size = 1
+	}
+	return &centralSnapshotHistory{
+		size:      size,
+		snapshots: map[string][]versionedCentralSnapshot{},
+	}
+}
+
+func (h *centralSnapshotHistory) add(clusterID, resourceVersion string, snapshot centralSnapshot) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	snapshots := h.snapshots[clusterID]
+	for _, s := range snapshots {
+		if s.resourceVersion == resourceVersion {
+			return nil
+		}
+	}
+	snapshots = append(snapshots, versionedCentralSnapshot{resourceVersion: resourceVersion, snapshot: snapshot})
+	if len(snapshots) > h.size {
+		snapshots = snapshots[len(snapshots)-h.size:]
+	}
+	h.snapshots[clusterID] = snapshots
+	return nil
+}
+
+func (h *centralSnapshotHistory) get(clusterID, resourceVersion string) (centralSnapshot, bool, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, s := range h.snapshots[clusterID] {
+		if s.resourceVersion == resourceVersion {
+			return s.snapshot, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// dbCentralSnapshotStore stores snapshots in the database, so that watch requests can resume on any
+// fleet-manager replica. Snapshots are cached in memory, as most watch requests resume from the last one.
+type dbCentralSnapshotStore struct {
+	connectionFactory *db.ConnectionFactory
+	size              int
+	cache             *centralSnapshotHistory
+}
+
+func newDBCentralSnapshotStore(connectionFactory *db.ConnectionFactory, size int) *dbCentralSnapshotStore {
+	if size < 1 {
+		size = 1
+	}
+	return &dbCentralSnapshotStore{
+		connectionFactory: connectionFactory,
+		size:              size,
+		cache:             newCentralSnapshotHistory(size),
+	}
+}
+
+func (h *dbCentralSnapshotStore) add(clusterID, resourceVersion string, snapshot centralSnapshot) error {
+	if _, ok, _ := h.cache.get(clusterID, resourceVersion); ok {
+		return nil
+	}
+	centrals, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshalling snapshot: %w", err)
+	}
+	dbConn := h.connectionFactory.New()
+	result := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbapi.CentralWatchSnapshot{
+		ClusterID:       clusterID,
+		ResourceVersion: resourceVersion,
+		Centrals:        centrals,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("storing snapshot: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		// Only keep the most recent snapshots of the cluster.
+		recent := dbConn.Model(&dbapi.CentralWatchSnapshot{}).
+			Select("resource_version").
+			Where("cluster_id = ?", clusterID).
+			Order("created_at DESC").
+			Limit(h.size)
+		if err := dbConn.
+			Where("cluster_id = ?", clusterID).
+			Where("resource_version NOT IN (?)", recent).
+			Delete(&dbapi.CentralWatchSnapshot{}).Error; err != nil {
+			return fmt.Errorf("deleting old snapshots: %w", err)
+		}
+	}
+	return h.cache.add(clusterID, resourceVersion, snapshot)
+}
+
+func (h *dbCentralSnapshotStore) get(clusterID, resourceVersion string) (centralSnapshot, bool, error) {
+	if snapshot, ok, _ := h.cache.get(clusterID, resourceVersion); ok {
+		return snapshot, true, nil
+	}
+	var stored []dbapi.CentralWatchSnapshot
+	if err := h.connectionFactory.New().
+		Where("cluster_id = ? AND resource_version = ?", clusterID, resourceVersion).
+		Limit(1).
+		Find(&stored).Error; err != nil {
+		return nil, false, fmt.Errorf("getting snapshot: %w", err)
+	}
+	if len(stored) == 0 {
+		return nil, false, nil
+	}
+	snapshot := centralSnapshot{}
+	if err := json.Unmarshal(stored[0].Centrals, &snapshot); err != nil {
+		return nil, false, fmt.Errorf("unmarshalling snapshot: %w", err)
+	}
+	return snapshot, true, h.cache.add(clusterID, resourceVersion, snapshot)
+}
+
+// centralChangeNotifier wakes up the waiting watch requests of a cluster when its Centrals change.
+type centralChangeNotifier struct {
+	mutex    sync.Mutex
+	channels map[string]chan struct{}
+}
+
+func newCentralChangeNotifier() *centralChangeNotifier {
+	return &centralChangeNotifier{channels: map[string]chan struct{}{}}
+}
+
+// changed returns a channel that is closed on the next change of the Centrals of the given cluster.
+func (n *centralChangeNotifier) changed(clusterID string) <-chan struct{} {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	ch, ok := n.channels[clusterID]
+	if !ok {
+		ch = make(chan struct{})
+		n.channels[clusterID] = ch
+	}
+	return ch
+}
+
+// notify wakes up the watch requests of the given cluster.
+func (n *centralChangeNotifier) notify(clusterID string) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if ch, ok := n.channels[clusterID]; ok {
+		close(ch)
+		delete(n.channels, clusterID)
+	}
+}
+
+// notifyAll wakes up the watch requests of all clusters.
+func (n *centralChangeNotifier) notifyAll() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for _, ch := range n.channels {
+		close(ch)
+	}
+	n.channels = map[string]chan struct{}{}
+}
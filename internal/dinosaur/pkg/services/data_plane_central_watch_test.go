@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type watchedCentrals struct {
+	mutex    sync.Mutex
+	centrals []*dbapi.CentralRequest
+}
+
+func (w *watchedCentrals) set(centrals ...*dbapi.CentralRequest) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.centrals = centrals
+}
+
+func (w *watchedCentrals) list(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return append([]*dbapi.CentralRequest{}, w.centrals...), nil
+}
+
+func newTestCentralWatchService(centrals *watchedCentrals) *dataPlaneCentralWatchService {
+	cfg := config.NewCentralWatchConfig()
+	cfg.MaxTimeout = time.Second
+	dinosaurService := &DinosaurServiceMock{
+		ListByClusterIDFunc: centrals.list,
+	}
+	s := NewDataPlaneCentralWatchService(nil, dinosaurService, cfg).(*dataPlaneCentralWatchService)
+	s.history = newCentralSnapshotHistory(cfg.HistorySize)
+	return s
+}
+
+// presentWatchedCentral presents the fields of a Central the tests change.
+func presentWatchedCentral(central *dbapi.CentralRequest, clusterID string) private.ManagedCentral {
+	return private.ManagedCentral{Id: central.ID, RequestStatus: central.Status}
+}
+
+func eventTypes(result *CentralWatchResult) map[string]WatchEventType {
+	types := map[string]WatchEventType{}
+	for _, event := range result.Events {
+		types[event.CentralID] = event.Type
+	}
+	return types
+}
+
+func TestDataPlaneCentralWatchService_Watch(t *testing.T) {
+	central := func(id string, status string) *dbapi.CentralRequest {
+		return buildCentralRequest(func(c *dbapi.CentralRequest) {
+			c.ID = id
+			c.Status = status
+		})
+	}
+	centrals := &watchedCentrals{}
+	centrals.set(central("a", "provisioning"), central("b", "ready"))
+	s := newTestCentralWatchService(centrals)
+
+	initial, err := s.Watch(context.Background(), testClusterID, "", 0, presentWatchedCentral)
+	require.Nil(t, err)
+	assert.NotEmpty(t, initial.ResourceVersion)
+	assert.Equal(t, map[string]WatchEventType{"a": WatchEventAdded, "b": WatchEventAdded}, eventTypes(initial))
+	assert.Equal(t, "a", initial.Events[0].CentralID)
+	assert.NotEmpty(t, initial.Events[0].ResourceVersion)
+
+	centrals.set(central("a", "ready"), central("c", "provisioning"))
+	changed, err := s.Watch(context.Background(), testClusterID, initial.ResourceVersion, 0, presentWatchedCentral)
+	require.Nil(t, err)
+	assert.NotEqual(t, initial.ResourceVersion, changed.ResourceVersion)
+	assert.Equal(t, map[string]WatchEventType{"a": WatchEventModified, "b": WatchEventDeleted, "c": WatchEventAdded}, eventTypes(changed))
+	for _, event := range changed.Events {
+		if event.Type == WatchEventDeleted {
+			assert.Nil(t, event.Central)
+			assert.Empty(t, event.ResourceVersion)
+		}
+	}
+
+	// An older resource version that is still in the history can be resumed from.
+	resumed, err := s.Watch(context.Background(), testClusterID, initial.ResourceVersion, 0, presentWatchedCentral)
+	require.Nil(t, err)
+	assert.Equal(t, changed.ResourceVersion, resumed.ResourceVersion)
+	assert.Len(t, resumed.Events, 3)
+}
+
+func TestDataPlaneCentralWatchService_WatchIgnoresFieldsNotPresented(t *testing.T) {
+	central := buildCentralRequest(nil)
+	centrals := &watchedCentrals{}
+	centrals.set(central)
+	s := newTestCentralWatchService(centrals)
+	s.config.MaxTimeout = 10 * time.Millisecond
+
+	initial, err := s.Watch(context.Background(), testClusterID, "", 0, presentWatchedCentral)
+	require.Nil(t, err)
+
+	touched := *central
+	touched.UpdatedAt = central.UpdatedAt.Add(time.Minute)
+	touched.Health = api.JSON(`{"ready":true}`)
+	centrals.set(&touched)
+	result, err := s.Watch(context.Background(), testClusterID, initial.ResourceVersion, 0, presentWatchedCentral)
+	require.Nil(t, err)
+	assert.Equal(t, initial.ResourceVersion, result.ResourceVersion)
+	assert.Empty(t, result.Events)
+}
+
+func TestDataPlaneCentralWatchService_WatchUnknownResourceVersion(t *testing.T) {
+	centrals := &watchedCentrals{}
+	centrals.set(buildCentralRequest(nil))
+	s := newTestCentralWatchService(centrals)
+
+	_, err := s.Watch(context.Background(), testClusterID, "unknown", 0, presentWatchedCentral)
+	require.NotNil(t, err)
+	assert.Equal(t, errors.ErrorGone, err.Code)
+}
+
+func TestDataPlaneCentralWatchService_WatchWaitsForChanges(t *testing.T) {
+	centrals := &watchedCentrals{}
+	centrals.set(buildCentralRequest(nil))
+	s := newTestCentralWatchService(centrals)
+	s.config.ResyncPeriod = time.Minute
+
+	initial, err := s.Watch(context.Background(), testClusterID, "", 0, presentWatchedCentral)
+	require.Nil(t, err)
+
+	t.Run("returns no events on timeout", func(t *testing.T) {
+		result, err := s.Watch(context.Background(), testClusterID, initial.ResourceVersion, 10*time.Millisecond, presentWatchedCentral)
+		require.Nil(t, err)
+		assert.Equal(t, initial.ResourceVersion, result.ResourceVersion)
+		assert.Empty(t, result.Events)
+	})
+
+	t.Run("returns no events when the request is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, err := s.Watch(ctx, testClusterID, initial.ResourceVersion, 0, presentWatchedCentral)
+		require.Nil(t, err)
+		assert.Empty(t, result.Events)
+	})
+
+	t.Run("returns the change after a notification", func(t *testing.T) {
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			centrals.set()
+			s.notifier.notify(testClusterID)
+		}()
+		result, err := s.Watch(context.Background(), testClusterID, initial.ResourceVersion, 0, presentWatchedCentral)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]WatchEventType{testID: WatchEventDeleted}, eventTypes(result))
+	})
+}
+
+func TestDataPlaneCentralWatchService_DispatchNotifications(t *testing.T) {
+	s := newTestCentralWatchService(&watchedCentrals{})
+	s.config.DebouncePeriod = 10 * time.Millisecond
+	notifications := make(chan *pq.Notification)
+	go s.dispatchNotifications(notifications)
+	defer close(s.stopCh)
+
+	isClosed := func(ch <-chan struct{}) bool {
+		select {
+		case <-ch:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}
+
+	t.Run("wakes up the watch requests of the changed clusters only", func(t *testing.T) {
+		changed, other := s.notifier.changed("changed"), s.notifier.changed("other")
+		notifications <- &pq.Notification{Extra: "changed"}
+		notifications <- &pq.Notification{Extra: "changed"}
+		assert.True(t, isClosed(changed))
+		assert.False(t, isClosed(other))
+	})
+
+	t.Run("wakes up all watch requests after a reconnect", func(t *testing.T) {
+		changed, other := s.notifier.changed("changed"), s.notifier.changed("other")
+		notifications <- nil
+		assert.True(t, isClosed(changed))
+		assert.True(t, isClosed(other))
+	})
+}
+
+func TestDBCentralSnapshotStore_GetFromOtherReplica(t *testing.T) {
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "central_watch_snapshots" WHERE cluster_id = $1 AND resource_version = $2`).
+		WithArgs(testClusterID, "version").
+		WithReply([]map[string]interface{}{{
+			"cluster_id":       testClusterID,
+			"resource_version": "version",
+			"centrals":         []byte(`{"a":"1","b":"2"}`),
+		}})
+	store := newDBCentralSnapshotStore(db.NewMockConnectionFactory(nil), 10)
+
+	snapshot, ok, err := store.get(testClusterID, "version")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, centralSnapshot{"a": "1", "b": "2"}, snapshot)
+
+	_, ok, err = store.get(testClusterID, "unknown")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"time"
 
@@ -53,6 +54,11 @@ func (d *dataPlaneClusterService) GetDataPlaneClusterConfig(ctx context.Context,
 		return nil, errors.BadRequest("Cluster agent with ID '%s' not found", clusterID)
 	}
 
+	centralOperators, svcErr := d.getCentralOperators(clusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
 	return &dbapi.DataPlaneClusterConfig{
 		Observability: dbapi.DataPlaneClusterConfigObservability{
 			AccessToken: d.ObservabilityConfig.ObservabilityConfigAccessToken,
@@ -60,9 +66,46 @@ func (d *dataPlaneClusterService) GetDataPlaneClusterConfig(ctx context.Context,
 			Repository:  d.ObservabilityConfig.ObservabilityConfigRepo,
 			Tag:         d.ObservabilityConfig.ObservabilityConfigTag,
 		},
+		CentralOperators: centralOperators,
 	}, nil
 }
 
+// getCentralOperators returns the configured ACS operator versions and the versions still used by Centrals on the
+// cluster, so that fleetshard-sync keeps an operator installed as long as a Central depends on it. Versions without
+// a configured image are left out, as their image cannot be derived from the version.
+func (d *dataPlaneClusterService) getCentralOperators(clusterID string) ([]dbapi.DataPlaneClusterConfigCentralOperator, *errors.ServiceError) {
+	usedVersions, svcErr := d.ClusterService.FindCentralOperatorVersions(clusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var operators []dbapi.DataPlaneClusterConfigCentralOperator
+	seen := map[string]bool{}
+	configuredVersions := make([]string, 0, len(d.DataplaneClusterConfig.CentralOperatorImages))
+	for version := range d.DataplaneClusterConfig.CentralOperatorImages {
+		configuredVersions = append(configuredVersions, version)
+	}
+	sort.Strings(configuredVersions)
+	for _, versions := range [][]string{configuredVersions, usedVersions} {
+		for _, version := range versions {
+			if seen[version] {
+				continue
+			}
+			seen[version] = true
+			image, ok := d.DataplaneClusterConfig.CentralOperatorImage(clusterID, version)
+			if !ok {
+				glog.Errorf("No image configured for ACS operator version %q on cluster %s", version, clusterID)
+				continue
+			}
+			operators = append(operators, dbapi.DataPlaneClusterConfigCentralOperator{
+				Version: version,
+				Image:   image,
+			})
+		}
+	}
+	return operators, nil
+}
+
 // UpdateDataPlaneClusterStatus ...
 func (d *dataPlaneClusterService) UpdateDataPlaneClusterStatus(ctx context.Context, clusterID string, status *dbapi.DataPlaneClusterStatus) *errors.ServiceError {
 	cluster, svcErr := d.ClusterService.FindClusterByID(clusterID)
@@ -113,7 +156,8 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 	if err != nil {
 		return fmt.Errorf("retrieving central operator versions: %w", err)
 	}
-	if len(status.AvailableDinosaurOperatorVersions) > 0 && !reflect.DeepEqual(prevAvailableDinosaurOperatorVersions, status.AvailableDinosaurOperatorVersions) {
+	if len(status.AvailableDinosaurOperatorVersions) > 0 && !d.operatorVersionsPinnedByConfig(cluster.ClusterID) &&
+		!reflect.DeepEqual(prevAvailableDinosaurOperatorVersions, status.AvailableDinosaurOperatorVersions) {
 		err := cluster.SetAvailableCentralOperatorVersions(status.AvailableDinosaurOperatorVersions)
 		if err != nil {
 			return fmt.Errorf("updating central operator versions: %w", err)
@@ -126,6 +170,22 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 		}
 	}
 
+	if status.Capacity != nil {
+		prevCapacity, err := cluster.GetCapacity()
+		if err != nil {
+			return fmt.Errorf("retrieving cluster capacity: %w", err)
+		}
+		if prevCapacity == nil || *prevCapacity != *status.Capacity {
+			if err := cluster.SetCapacity(*status.Capacity); err != nil {
+				return fmt.Errorf("updating cluster capacity: %w", err)
+			}
+			glog.V(10).Infof("Updating capacity for cluster ID '%s' to '%+v'", cluster.ClusterID, *status.Capacity)
+			if svcErr := d.ClusterService.Update(*cluster); svcErr != nil {
+				return fmt.Errorf("updating cluster: %w", svcErr)
+			}
+		}
+	}
+
 	if cluster.Status != api.ClusterReady {
 		clusterIsWaitingForFleetShardOperator := cluster.Status == api.ClusterWaitingForFleetShardOperator
 		err := d.ClusterService.UpdateStatus(*cluster, api.ClusterReady)
@@ -141,6 +201,16 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 	return nil
 }
 
+// operatorVersionsPinnedByConfig returns true if the available Central operator versions of the cluster
+// are set by the manual cluster configuration. Reported versions must not override them, otherwise
+// the cluster manager and the status reports would keep overwriting each other.
+func (d *dataPlaneClusterService) operatorVersionsPinnedByConfig(clusterID string) bool {
+	if d.DataplaneClusterConfig == nil || !d.DataplaneClusterConfig.IsDataPlaneManualScalingEnabled() {
+		return false
+	}
+	return d.DataplaneClusterConfig.ClusterConfig.HasAvailableCentralOperatorVersions(clusterID)
+}
+
 func (d *dataPlaneClusterService) clusterCanProcessStatusReports(cluster *api.Cluster) bool {
 	return cluster.Status == api.ClusterReady ||
 		cluster.Status == api.ClusterComputeNodeScalingUp ||
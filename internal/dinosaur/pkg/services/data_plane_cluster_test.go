@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/observatorium"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataPlaneClusterService_GetDataPlaneClusterConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		cluster       *api.Cluster
+		usedVersions  []string
+		wantErrCode   errors.ServiceErrorCode
+		wantOperators []dbapi.DataPlaneClusterConfigCentralOperator
+	}{
+		{
+			name:         "should return the configured operators and the operators used by centrals",
+			cluster:      &api.Cluster{ClusterID: "cluster-id"},
+			usedVersions: []string{"3.73.0", "3.74.0"},
+			wantOperators: []dbapi.DataPlaneClusterConfigCentralOperator{
+				{Version: "3.74.0", Image: "quay.io/rhacs-eng/stackrox-operator:3.74.0"},
+				{Version: "4.0.0", Image: "quay.io/rhacs-eng/stackrox-operator:4.0.0-rc.1"},
+				{Version: "3.73.0", Image: "quay.io/rhacs-eng/stackrox-operator:3.73.0-fips"},
+			},
+		},
+		{
+			name:         "should leave out operators without a configured image",
+			cluster:      &api.Cluster{ClusterID: "cluster-id"},
+			usedVersions: []string{"0.1.0"},
+			wantOperators: []dbapi.DataPlaneClusterConfigCentralOperator{
+				{Version: "3.74.0", Image: "quay.io/rhacs-eng/stackrox-operator:3.74.0"},
+				{Version: "4.0.0", Image: "quay.io/rhacs-eng/stackrox-operator:4.0.0-rc.1"},
+			},
+		},
+		{
+			name:        "should fail for an unknown cluster",
+			wantErrCode: errors.ErrorBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.CentralOperatorImages = map[string]string{
+				"3.74.0": "quay.io/rhacs-eng/stackrox-operator:3.74.0",
+				"4.0.0":  "quay.io/rhacs-eng/stackrox-operator:4.0.0-rc.1",
+			}
+			dataplaneClusterConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{{
+				ClusterID: "cluster-id",
+				AvailableCentralOperatorVersions: []api.CentralOperatorVersion{
+					{Version: "3.73.0", Image: "quay.io/rhacs-eng/stackrox-operator:3.73.0-fips"},
+				},
+			}})
+			s := NewDataPlaneClusterService(dataPlaneClusterService{
+				ClusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return tt.cluster, nil
+					},
+					FindCentralOperatorVersionsFunc: func(clusterID string) ([]string, *errors.ServiceError) {
+						return tt.usedVersions, nil
+					},
+				},
+				ObservabilityConfig:    &observatorium.ObservabilityConfiguration{},
+				DataplaneClusterConfig: dataplaneClusterConfig,
+			})
+
+			cfg, err := s.GetDataPlaneClusterConfig(context.Background(), "cluster-id")
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantOperators, cfg.CentralOperators)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -39,17 +40,19 @@ type DataPlaneCentralService interface {
 }
 
 type dataPlaneCentralService struct {
-	dinosaurService DinosaurService
-	clusterService  ClusterService
-	dinosaurConfig  *config.CentralConfig
+	dinosaurService     DinosaurService
+	clusterService      ClusterService
+	centralEventService CentralEventService
+	dinosaurConfig      *config.CentralConfig
 }
 
 // NewDataPlaneCentralService ...
-func NewDataPlaneCentralService(dinosaurSrv DinosaurService, clusterSrv ClusterService, dinosaurConfig *config.CentralConfig) *dataPlaneCentralService {
+func NewDataPlaneCentralService(dinosaurSrv DinosaurService, clusterSrv ClusterService, centralEventSrv CentralEventService, dinosaurConfig *config.CentralConfig) *dataPlaneCentralService {
 	return &dataPlaneCentralService{
-		dinosaurService: dinosaurSrv,
-		clusterService:  clusterSrv,
-		dinosaurConfig:  dinosaurConfig,
+		dinosaurService:     dinosaurSrv,
+		clusterService:      clusterSrv,
+		centralEventService: centralEventSrv,
+		dinosaurConfig:      dinosaurConfig,
 	}
 }
 
@@ -70,12 +73,27 @@ func (d *dataPlaneCentralService) UpdateDataPlaneCentralService(ctx context.Cont
 			glog.Error(errors.Wrapf(getErr, "failed to get central cluster by id %s", ks.CentralClusterID))
 			continue
 		}
+		if dinosaur.MigrationStatus != "" && dinosaur.ClusterID != clusterID {
+			if e := d.updateCentralMigration(dinosaur, ks, cluster); e != nil {
+				log.Error(errors.Wrapf(e, "Error updating central %s migration", ks.CentralClusterID))
+			}
+			continue
+		}
 		if dinosaur.ClusterID != clusterID {
 			log.Warningf("clusterId for central cluster %s does not match clusterId. central clusterId = %s :: clusterId = %s", dinosaur.ID, dinosaur.ClusterID, clusterID)
 			continue
 		}
+		s := getStatus(ks)
+		if e := d.centralEventService.RecordStatusReport(dinosaur.ID, clusterID, statusReportMessage(s, ks)); e != nil {
+			log.Error(errors.Wrapf(e, "Error recording central %s status report", ks.CentralClusterID))
+		}
+		if dinosaur.Status == constants2.CentralRequestStatusPendingDeletion.String() {
+			// the central is scaled down until it is restored or its deletion grace period is over
+			log.V(5).Infof("central cluster %s is pending deletion", ks.CentralClusterID)
+			continue
+		}
 		var e *serviceError.ServiceError
-		switch s := getStatus(ks); s {
+		switch s {
 		case statusReady:
 			// Only store the routes (and create them) when the Dinosaurs are ready, as by the time they are ready,
 			// the routes should definitely be there.
@@ -105,6 +123,11 @@ func (d *dataPlaneCentralService) UpdateDataPlaneCentralService(ctx context.Cont
 		if e != nil {
 			log.Error(errors.Wrapf(e, "Error updating central '%s' version fields", ks.CentralClusterID))
 		}
+
+		e = d.setCentralHealth(dinosaur, ks)
+		if e != nil {
+			log.Error(errors.Wrapf(e, "Error updating central '%s' health", ks.CentralClusterID))
+		}
 	}
 
 	return nil
@@ -200,6 +223,33 @@ func (d *dataPlaneCentralService) setCentralRequestVersionFields(centralRequest
 	return nil
 }
 
+// setCentralHealth stores the health conditions reported in addition to the Ready condition. The stored health is
+// kept if the status contains no health conditions, e.g. because it was reported by an older fleetshard-sync.
+func (d *dataPlaneCentralService) setCentralHealth(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
+	health, found := getHealth(status)
+	if !found {
+		return nil
+	}
+	currentHealth, err := centralRequest.GetHealth()
+	if err != nil {
+		logger.Logger.Warningf("failed to get health of central %s, overwriting it: %v", centralRequest.ID, err)
+	}
+	if currentHealth != nil && reflect.DeepEqual(*currentHealth, health) {
+		return nil
+	}
+
+	if currentHealth == nil || currentHealth.Status != health.Status {
+		logger.Logger.Infof("health of central %s changed to %s", centralRequest.ID, health.Status)
+	}
+	if err := centralRequest.SetHealth(health); err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set health of central %s", centralRequest.ID)
+	}
+	if err := d.dinosaurService.Updates(centralRequest, map[string]interface{}{"health": centralRequest.Health}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update health of central cluster %s", centralRequest.ID)
+	}
+	return nil
+}
+
 func (d *dataPlaneCentralService) setCentralClusterFailed(centralRequest *dbapi.CentralRequest, errMessage string) *serviceError.ServiceError {
 	// if dinosaur was already reported as failed we don't do anything
 	if centralRequest.Status == string(constants2.CentralRequestStatusFailed) {
@@ -255,6 +305,102 @@ func (d *dataPlaneCentralService) reassignCentralCluster(centralRequest *dbapi.C
 	return nil
 }
 
+// updateCentralMigration progresses the migration of a Central based on the status reported by the migration
+// source or target cluster.
+func (d *dataPlaneCentralService) updateCentralMigration(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus, cluster *api.Cluster) *serviceError.ServiceError {
+	switch {
+	case cluster.ClusterID == centralRequest.MigrationSourceClusterID &&
+		centralRequest.MigrationStatus == constants2.CentralMigrationStatusSourcePreparing.String():
+		return d.setCentralMigrationSourcePrepared(centralRequest, status)
+	case cluster.ClusterID == centralRequest.MigrationTargetClusterID &&
+		centralRequest.MigrationStatus == constants2.CentralMigrationStatusTargetProvisioning.String():
+		return d.setCentralMigrationTargetReady(centralRequest, status, cluster)
+	case cluster.ClusterID == centralRequest.MigrationSourceClusterID &&
+		centralRequest.MigrationStatus == constants2.CentralMigrationStatusSourceTeardown.String():
+		return d.setCentralMigrationCompleted(centralRequest, status)
+	default:
+		logger.Logger.V(10).Infof("ignoring status of central %s from cluster %s in migration status %s", centralRequest.ID, cluster.ClusterID, centralRequest.MigrationStatus)
+		return nil
+	}
+}
+
+func (d *dataPlaneCentralService) setCentralMigrationSourcePrepared(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
+	if !isCentralMigrationSourcePrepared(status) {
+		logger.Logger.V(5).Infof("central %s is still being prepared on migration source cluster %s", centralRequest.ID, centralRequest.MigrationSourceClusterID)
+		return nil
+	}
+
+	logger.Logger.Infof("central %s is prepared on migration source cluster %s, provisioning it on target cluster %s",
+		centralRequest.ID, centralRequest.MigrationSourceClusterID, centralRequest.MigrationTargetClusterID)
+	if err := d.dinosaurService.Updates(centralRequest, map[string]interface{}{
+		"migration_status": constants2.CentralMigrationStatusTargetProvisioning.String(),
+	}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update migration status for central %s", centralRequest.ID)
+	}
+	return nil
+}
+
+// isCentralMigrationSourcePrepared returns true if the migration source cluster reported that the Central no longer
+// uses the master credentials of its managed DB.
+func isCentralMigrationSourcePrepared(status *dbapi.DataPlaneCentralStatus) bool {
+	for _, c := range status.Conditions {
+		if strings.EqualFold(c.Type, constants2.CentralMigrationSourcePreparedCondition) && strings.EqualFold(c.Status, "True") {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *dataPlaneCentralService) setCentralMigrationTargetReady(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus, cluster *api.Cluster) *serviceError.ServiceError {
+	switch getStatus(status) {
+	case statusReady:
+	case statusError:
+		readyCondition, _ := status.GetReadyCondition()
+		logger.Logger.Warningf("central %s reported as failed on migration target cluster %s: %s", centralRequest.ID, cluster.ClusterID, readyCondition.Message)
+		return nil
+	default:
+		logger.Logger.V(5).Infof("central %s is still installing on migration target cluster %s", centralRequest.ID, cluster.ClusterID)
+		return nil
+	}
+
+	clusterDNS, err := d.clusterService.GetClusterDNS(cluster.ClusterID)
+	if err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to get DNS entry for cluster %s", cluster.ClusterID)
+	}
+	if routesErr := validateRouters(status.Routes, centralRequest, clusterDNS); routesErr != nil {
+		return serviceError.NewWithCause(serviceError.ErrorBadRequest, routesErr, "routes are not valid")
+	}
+	if err := centralRequest.SetRoutes(status.Routes); err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set routes for central %s", centralRequest.ID)
+	}
+
+	logger.Logger.Infof("central %s is ready on migration target cluster %s, switching routes", centralRequest.ID, cluster.ClusterID)
+	if err := d.dinosaurService.Updates(centralRequest, map[string]interface{}{
+		"routes":             centralRequest.Routes,
+		"routes_creation_id": "",
+		"migration_status":   constants2.CentralMigrationStatusSwitchingRoutes.String(),
+	}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update migration status for central %s", centralRequest.ID)
+	}
+	return nil
+}
+
+func (d *dataPlaneCentralService) setCentralMigrationCompleted(centralRequest *dbapi.CentralRequest, status *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
+	if getStatus(status) != statusDeleted {
+		return nil
+	}
+
+	logger.Logger.Infof("central %s is removed from migration source cluster %s, migration completed", centralRequest.ID, centralRequest.MigrationSourceClusterID)
+	if err := d.dinosaurService.Updates(centralRequest, map[string]interface{}{
+		"migration_status":            "",
+		"migration_source_cluster_id": "",
+		"migration_target_cluster_id": "",
+	}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to complete migration of central %s", centralRequest.ID)
+	}
+	return nil
+}
+
 func getStatus(status *dbapi.DataPlaneCentralStatus) centralStatus {
 	for _, c := range status.Conditions {
 		if strings.EqualFold(c.Type, "Ready") {
@@ -280,6 +426,43 @@ func getStatus(status *dbapi.DataPlaneCentralStatus) centralStatus {
 	}
 	return statusInstalling
 }
+
+// getHealth derives the health of a Central from the reported conditions other than the Ready condition. A Central is
+// degraded if any of these conditions is false, and its health is unknown if any other condition is not true.
+func getHealth(status *dbapi.DataPlaneCentralStatus) (dbapi.CentralHealth, bool) {
+	health := dbapi.CentralHealth{Status: constants2.CentralHealthStatusHealthy.String()}
+	for _, c := range status.Conditions {
+		if strings.EqualFold(c.Type, "Ready") {
+			continue
+		}
+		health.Conditions = append(health.Conditions, dbapi.CentralHealthCondition{
+			Type:    c.Type,
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+		switch {
+		case strings.EqualFold(c.Status, "False"):
+			health.Status = constants2.CentralHealthStatusDegraded.String()
+		case !strings.EqualFold(c.Status, "True") && health.Status == constants2.CentralHealthStatusHealthy.String():
+			health.Status = constants2.CentralHealthStatusUnknown.String()
+		}
+	}
+	return health, len(health.Conditions) > 0
+}
+
+// statusReportMessage describes the status reported by fleetshard-sync for the event history of the Central.
+func statusReportMessage(s centralStatus, status *dbapi.DataPlaneCentralStatus) string {
+	message := fmt.Sprintf("Reported status %s", s)
+	if readyCondition, ok := status.GetReadyCondition(); ok && readyCondition.Message != "" && (s == statusError || s == statusRejected) {
+		message = fmt.Sprintf("%s: %s", message, readyCondition.Message)
+	}
+	if status.CentralVersion != "" {
+		message = fmt.Sprintf("%s (Central version %s)", message, status.CentralVersion)
+	}
+	return message
+}
+
 func (d *dataPlaneCentralService) checkCentralRequestCurrentStatus(centralRequest *dbapi.CentralRequest, status constants2.CentralStatus) (bool, *serviceError.ServiceError) {
 	matchStatus := false
 	if currentInstance, err := d.dinosaurService.GetByID(centralRequest.ID); err != nil {
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"testing"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHealth(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []dbapi.DataPlaneCentralStatusCondition
+		wantFound  bool
+		wantStatus dinosaurConstants.CentralHealthStatus
+	}{
+		{
+			name:       "should not find health without health conditions",
+			conditions: []dbapi.DataPlaneCentralStatusCondition{{Type: "Ready", Status: "True"}},
+		},
+		{
+			name: "should be healthy if all health conditions are met",
+			conditions: []dbapi.DataPlaneCentralStatusCondition{
+				{Type: "Ready", Status: "True"},
+				{Type: "ScannerAvailable", Status: "True"},
+				{Type: "PodsStable", Status: "True"},
+			},
+			wantFound:  true,
+			wantStatus: dinosaurConstants.CentralHealthStatusHealthy,
+		},
+		{
+			name: "should be unknown if a health condition is unknown",
+			conditions: []dbapi.DataPlaneCentralStatusCondition{
+				{Type: "ScannerAvailable", Status: "True"},
+				{Type: "DatabaseAvailable", Status: "Unknown"},
+			},
+			wantFound:  true,
+			wantStatus: dinosaurConstants.CentralHealthStatusUnknown,
+		},
+		{
+			name: "should be degraded if a health condition is not met",
+			conditions: []dbapi.DataPlaneCentralStatusCondition{
+				{Type: "DatabaseAvailable", Status: "Unknown"},
+				{Type: "ScannerAvailable", Status: "False", Reason: "DeploymentUnavailable"},
+			},
+			wantFound:  true,
+			wantStatus: dinosaurConstants.CentralHealthStatusDegraded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, found := getHealth(&dbapi.DataPlaneCentralStatus{Conditions: tt.conditions})
+			assert.Equal(t, tt.wantFound, found)
+			if tt.wantFound {
+				assert.Equal(t, tt.wantStatus.String(), health.Status)
+			}
+		})
+	}
+}
+
+func TestDataPlaneCentralService_SetCentralHealth(t *testing.T) {
+	degraded := &dbapi.DataPlaneCentralStatus{
+		Conditions: []dbapi.DataPlaneCentralStatusCondition{
+			{Type: "Ready", Status: "True"},
+			{Type: "ScannerAvailable", Status: "False", Reason: "DeploymentUnavailable", Message: "0 of 1 replicas of deployment scanner are available"},
+		},
+	}
+	healthy := &dbapi.DataPlaneCentralStatus{
+		Conditions: []dbapi.DataPlaneCentralStatusCondition{
+			{Type: "Ready", Status: "True"},
+			{Type: "ScannerAvailable", Status: "True", Reason: "DeploymentAvailable"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		currentStatus *dbapi.DataPlaneCentralStatus
+		status        *dbapi.DataPlaneCentralStatus
+		wantUpdate    bool
+	}{
+		{
+			name:       "should store the first reported health",
+			status:     degraded,
+			wantUpdate: true,
+		},
+		{
+			name:          "should store a changed health",
+			currentStatus: degraded,
+			status:        healthy,
+			wantUpdate:    true,
+		},
+		{
+			name:          "should not store an unchanged health",
+			currentStatus: degraded,
+			status:        degraded,
+		},
+		{
+			name:          "should keep the health if no health conditions are reported",
+			currentStatus: degraded,
+			status:        &dbapi.DataPlaneCentralStatus{Conditions: []dbapi.DataPlaneCentralStatusCondition{{Type: "Ready", Status: "True"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centralRequest := buildCentralRequest(nil)
+			if tt.currentStatus != nil {
+				currentHealth, _ := getHealth(tt.currentStatus)
+				require.NoError(t, centralRequest.SetHealth(currentHealth))
+			}
+			var updatedFields map[string]interface{}
+			s := NewDataPlaneCentralService(&DinosaurServiceMock{
+				UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError {
+					updatedFields = values
+					return nil
+				},
+			}, nil, nil, nil)
+
+			err := s.setCentralHealth(centralRequest, tt.status)
+			require.Nil(t, err)
+			if !tt.wantUpdate {
+				assert.Nil(t, updatedFields)
+				return
+			}
+			require.Contains(t, updatedFields, "health")
+			health, getErr := centralRequest.GetHealth()
+			require.NoError(t, getErr)
+			wantHealth, _ := getHealth(tt.status)
+			assert.Equal(t, &wantHealth, health)
+		})
+	}
+}
+
+func TestDataPlaneCentralService_UpdateCentralMigrationSourcePreparing(t *testing.T) {
+	const sourceClusterID, targetClusterID = "source-cluster", "target-cluster"
+	readyStatus := &dbapi.DataPlaneCentralStatus{
+		Conditions: []dbapi.DataPlaneCentralStatusCondition{{Type: "Ready", Status: "True"}},
+	}
+	preparedStatus := &dbapi.DataPlaneCentralStatus{
+		Conditions: []dbapi.DataPlaneCentralStatusCondition{
+			{Type: "Ready", Status: "True"},
+			{Type: dinosaurConstants.CentralMigrationSourcePreparedCondition, Status: "True"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		clusterID  string
+		status     *dbapi.DataPlaneCentralStatus
+		wantUpdate bool
+	}{
+		{
+			name:      "should wait until the source cluster prepared the central",
+			clusterID: sourceClusterID,
+			status:    readyStatus,
+		},
+		{
+			name:      "should ignore the status of the target cluster",
+			clusterID: targetClusterID,
+			status:    preparedStatus,
+		},
+		{
+			name:       "should provision the central on the target cluster once the source cluster prepared it",
+			clusterID:  sourceClusterID,
+			status:     preparedStatus,
+			wantUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centralRequest := buildCentralRequest(func(c *dbapi.CentralRequest) {
+				c.MigrationStatus = dinosaurConstants.CentralMigrationStatusSourcePreparing.String()
+				c.MigrationSourceClusterID = sourceClusterID
+				c.MigrationTargetClusterID = targetClusterID
+			})
+			var updatedFields map[string]interface{}
+			s := &dataPlaneCentralService{
+				dinosaurService: &DinosaurServiceMock{
+					UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError {
+						updatedFields = values
+						return nil
+					},
+				},
+			}
+
+			err := s.updateCentralMigration(centralRequest, tt.status, &api.Cluster{ClusterID: tt.clusterID})
+			require.Nil(t, err)
+			if !tt.wantUpdate {
+				assert.Nil(t, updatedFields)
+				return
+			}
+			assert.Equal(t, dinosaurConstants.CentralMigrationStatusTargetProvisioning.String(), updatedFields["migration_status"])
+		})
+	}
+}
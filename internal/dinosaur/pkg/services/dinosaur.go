@@ -27,6 +27,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/logger"
 	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"gorm.io/gorm"
 )
 
 var (
@@ -40,6 +41,7 @@ var (
 		dinosaurConstants.CentralRequestStatusDeprovision.String(),
 		dinosaurConstants.CentralRequestStatusReady.String(),
 		dinosaurConstants.CentralRequestStatusFailed.String(),
+		dinosaurConstants.CentralRequestStatusPendingDeletion.String(),
 	}
 )
 
@@ -52,6 +54,9 @@ const DinosaurRoutesActionCreate DinosaurRoutesAction = "CREATE"
 // DinosaurRoutesActionDelete ...
 const DinosaurRoutesActionDelete DinosaurRoutesAction = "DELETE"
 
+// DinosaurRoutesActionUpsert ...
+const DinosaurRoutesActionUpsert DinosaurRoutesAction = "UPSERT"
+
 // CNameRecordStatus ...
 type CNameRecordStatus struct {
 	ID     *string
@@ -95,9 +100,18 @@ type DinosaurService interface {
 	ChangeDinosaurCNAMErecords(dinosaurRequest *dbapi.CentralRequest, action DinosaurRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
 	GetCNAMERecordStatus(dinosaurRequest *dbapi.CentralRequest) (*CNameRecordStatus, error)
 	DetectInstanceType(dinosaurRequest *dbapi.CentralRequest) types.DinosaurInstanceType
+	// RegisterDinosaurDeprovisionJob registers the deletion of a dinosaur. If a deletion grace period is configured,
+	// a ready dinosaur is moved to 'pending_deletion' and only deprovisioned after the grace period.
 	RegisterDinosaurDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
-	// DeprovisionDinosaurForUsers registers all dinosaurs for deprovisioning given the list of owners
+	// RestoreDinosaur moves a dinosaur in 'pending_deletion' back to 'ready' and cancels its deletion.
+	RestoreDinosaur(dinosaurRequest *dbapi.CentralRequest) *errors.ServiceError
+	// DeprovisionPendingDeletions registers all dinosaurs without deletion protection whose deletion grace period is
+	// over for deprovisioning
+	DeprovisionPendingDeletions() *errors.ServiceError
+	// DeprovisionDinosaurForUsers registers all dinosaurs without deletion protection for deprovisioning given the
+	// list of owners
 	DeprovisionDinosaurForUsers(users []string) *errors.ServiceError
+	// DeprovisionExpiredDinosaurs registers all expired eval dinosaurs without deletion protection for deprovisioning
 	DeprovisionExpiredDinosaurs(dinosaurAgeInHours int) *errors.ServiceError
 	CountByStatus(status []dinosaurConstants.CentralStatus) ([]DinosaurStatusCount, error)
 	CountByRegionAndInstanceType() ([]DinosaurRegionCount, error)
@@ -289,6 +303,7 @@ func (k *dinosaurService) AcceptCentralRequest(centralRequest *dbapi.CentralRequ
 		Meta: api.Meta{
 			ID: centralRequest.ID,
 		},
+		Version:     centralRequest.Version,
 		Host:        centralRequest.Host,
 		PlacementID: api.NewID(),
 		Status:      dinosaurConstants.CentralRequestStatusPreparing.String(),
@@ -297,6 +312,7 @@ func (k *dinosaurService) AcceptCentralRequest(centralRequest *dbapi.CentralRequ
 	if err := k.Update(updatedDinosaurRequest); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update central request")
 	}
+	centralRequest.Version = updatedDinosaurRequest.Version
 
 	return nil
 }
@@ -332,12 +348,14 @@ func (k *dinosaurService) PrepareDinosaurRequest(dinosaurRequest *dbapi.CentralR
 		Meta: api.Meta{
 			ID: dinosaurRequest.ID,
 		},
+		Version:          dinosaurRequest.Version,
 		OrganisationName: orgName,
 		Status:           dinosaurConstants.CentralRequestStatusProvisioning.String(),
 	}
 	if err := k.Update(updatedCentralRequest); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update central request")
 	}
+	dinosaurRequest.Version = updatedCentralRequest.Version
 
 	return nil
 }
@@ -442,8 +460,29 @@ func (k *dinosaurService) RegisterDinosaurDeprovisionJob(ctx context.Context, id
 	if err := dbConn.First(&dinosaurRequest).Error; err != nil {
 		return services.HandleGetError("CentralResource", "id", id, err)
 	}
+	if dinosaurRequest.Status == dinosaurConstants.CentralRequestStatusPendingDeletion.String() {
+		// the deletion was already requested, the dinosaur is deprovisioned once its grace period is over
+		return nil
+	}
+	if dinosaurRequest.DeletionProtection {
+		return errors.DeletionProtected("central %s is protected against deletion, deletion_protection must be cleared first", id)
+	}
 	metrics.IncreaseCentralTotalOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
 
+	gracePeriod := k.dinosaurConfig.CentralDeletionGracePeriod
+	if gracePeriod > 0 && dinosaurRequest.Status == dinosaurConstants.CentralRequestStatusReady.String() {
+		pendingDeletionUntil := time.Now().Add(gracePeriod)
+		if err := k.Updates(&dinosaurRequest, map[string]interface{}{
+			"status":                 dinosaurConstants.CentralRequestStatusPendingDeletion.String(),
+			"pending_deletion_until": pendingDeletionUntil,
+		}); err != nil {
+			return err
+		}
+		glog.Infof("central %s is pending deletion until %s", id, pendingDeletionUntil.Format(time.RFC3339))
+		metrics.IncreaseCentralSuccessOperationsCountMetric(dinosaurConstants.CentralOperationDeprovision)
+		return nil
+	}
+
 	deprovisionStatus := dinosaurConstants.CentralRequestStatusDeprovision
 
 	if executed, err := k.UpdateStatus(id, deprovisionStatus); executed {
@@ -457,16 +496,74 @@ func (k *dinosaurService) RegisterDinosaurDeprovisionJob(ctx context.Context, id
 	return nil
 }
 
-// DeprovisionDinosaurForUsers registers all dinosaurs for deprovisioning given the list of owners
+// RestoreDinosaur moves a dinosaur in 'pending_deletion' back to 'ready' and cancels its deletion
+func (k *dinosaurService) RestoreDinosaur(dinosaurRequest *dbapi.CentralRequest) *errors.ServiceError {
+	if dinosaurRequest.Status != dinosaurConstants.CentralRequestStatusPendingDeletion.String() {
+		return errors.BadRequest("central %s is not pending deletion", dinosaurRequest.ID)
+	}
+	metrics.IncreaseCentralTotalOperationsCountMetric(dinosaurConstants.CentralOperationRestore)
+
+	result := k.connectionFactory.New().
+		Model(&dbapi.CentralRequest{Meta: api.Meta{ID: dinosaurRequest.ID}}).
+		Where("status = ?", dinosaurConstants.CentralRequestStatusPendingDeletion.String()).
+		Updates(map[string]interface{}{
+			"status":                 dinosaurConstants.CentralRequestStatusReady.String(),
+			"pending_deletion_until": nil,
+			"version":                gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "unable to restore central %s", dinosaurRequest.ID)
+	}
+	if result.RowsAffected == 0 {
+		// the grace period ended while the restore was requested
+		return errors.Conflict("central %s is no longer pending deletion", dinosaurRequest.ID)
+	}
+
+	dinosaurRequest.Status = dinosaurConstants.CentralRequestStatusReady.String()
+	dinosaurRequest.PendingDeletionUntil = nil
+	dinosaurRequest.Version++
+	metrics.IncreaseCentralSuccessOperationsCountMetric(dinosaurConstants.CentralOperationRestore)
+	return nil
+}
+
+// DeprovisionPendingDeletions registers all dinosaurs whose deletion grace period is over for deprovisioning.
+// Dinosaurs whose deletion protection was enabled during the grace period are kept until it is disabled again.
+func (k *dinosaurService) DeprovisionPendingDeletions() *errors.ServiceError {
+	now := time.Now()
+	db := k.connectionFactory.New().
+		Model(&dbapi.CentralRequest{}).
+		Where("status = ?", dinosaurConstants.CentralRequestStatusPendingDeletion.String()).
+		Where("pending_deletion_until <= ?", now).
+		Where("deletion_protection = ?", false).
+		Updates(map[string]interface{}{
+			"status":             dinosaurConstants.CentralRequestStatusDeprovision,
+			"deletion_timestamp": now,
+			"version":            gorm.Expr("version + 1"),
+		})
+	if err := db.Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision centrals pending deletion")
+	}
+
+	if db.RowsAffected >= 1 {
+		glog.Infof("%v centrals are now deprovisioning after their deletion grace period", db.RowsAffected)
+	}
+
+	return nil
+}
+
+// DeprovisionDinosaurForUsers registers all dinosaurs without deletion protection for deprovisioning given the list
+// of owners
 func (k *dinosaurService) DeprovisionDinosaurForUsers(users []string) *errors.ServiceError {
 	now := time.Now()
 	dbConn := k.connectionFactory.New().
 		Model(&dbapi.CentralRequest{}).
 		Where("owner IN (?)", users).
 		Where("status NOT IN (?)", dinosaurDeletionStatuses).
+		Where("deletion_protection = ?", false).
 		Updates(map[string]interface{}{
 			"status":             dinosaurConstants.CentralRequestStatusDeprovision,
 			"deletion_timestamp": now,
+			"version":            gorm.Expr("version + 1"),
 		})
 
 	err := dbConn.Error
@@ -486,18 +583,20 @@ func (k *dinosaurService) DeprovisionDinosaurForUsers(users []string) *errors.Se
 	return nil
 }
 
-// DeprovisionExpiredDinosaurs cleaning up expired dinosaurs
+// DeprovisionExpiredDinosaurs cleaning up expired dinosaurs without deletion protection
 func (k *dinosaurService) DeprovisionExpiredDinosaurs(dinosaurAgeInHours int) *errors.ServiceError {
 	now := time.Now()
 	dbConn := k.connectionFactory.New().
 		Model(&dbapi.CentralRequest{}).
 		Where("instance_type = ?", types.EVAL.String()).
 		Where("created_at  <=  ?", now.Add(-1*time.Duration(dinosaurAgeInHours)*time.Hour)).
-		Where("status NOT IN (?)", dinosaurDeletionStatuses)
+		Where("status NOT IN (?)", dinosaurDeletionStatuses).
+		Where("deletion_protection = ?", false)
 
 	db := dbConn.Updates(map[string]interface{}{
 		"status":             dinosaurConstants.CentralRequestStatusDeprovision,
 		"deletion_timestamp": now,
+		"version":            gorm.Expr("version + 1"),
 	})
 	err := db.Error
 	if err != nil {
@@ -563,10 +662,6 @@ func (k *dinosaurService) Delete(centralRequest *dbapi.CentralRequest, force boo
 func (k *dinosaurService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.CentralList, *api.PagingMeta, *errors.ServiceError) {
 	var dinosaurRequestList dbapi.CentralList
 	dbConn := k.connectionFactory.New()
-	pagingMeta := &api.PagingMeta{
-		Page: listArgs.Page,
-		Size: listArgs.Size,
-	}
 
 	claims, err := auth.GetClaimsFromContext(ctx)
 	if err != nil {
@@ -592,46 +687,50 @@ func (k *dinosaurService) List(ctx context.Context, listArgs *services.ListArgum
 		}
 	}
 
-	// Apply search query
+	// Apply search query, admins can search additional columns
 	if len(listArgs.Search) > 0 {
-		searchDbQuery, err := coreServices.NewQueryParser().Parse(listArgs.Search)
+		searchColumns := coreServices.PublicColumns
+		if auth.GetIsAdminFromContext(ctx) {
+			searchColumns = coreServices.AdminColumns
+		}
+		searchDbQuery, err := coreServices.NewQueryParser(searchColumns...).Parse(listArgs.Search)
 		if err != nil {
-			return dinosaurRequestList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list central requests: %s", err.Error())
+			return nil, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list central requests: %s", err.Error())
 		}
 		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
 	}
 
-	if len(listArgs.OrderBy) == 0 {
-		// default orderBy name
-		dbConn = dbConn.Order("name")
-	}
-
-	// Set the order by arguments if any
-	for _, orderByArg := range listArgs.OrderBy {
-		dbConn = dbConn.Order(orderByArg)
+	if listArgs.LabelSelector != "" {
+		condition, values, err := services.LabelSelectorCondition("labels", listArgs.LabelSelector)
+		if err != nil {
+			return nil, nil, errors.NewWithCause(errors.ErrorBadRequest, err, "Unable to list central requests: %s", err.Error())
+		}
+		dbConn = dbConn.Where(condition, values...)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Model(&dinosaurRequestList).Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	orderBy := listArgs.OrderBy
+	if len(orderBy) == 0 {
+		// default orderBy name
+		orderBy = []string{"name"}
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
-	// execute query
-	if err := dbConn.Find(&dinosaurRequestList).Error; err != nil {
-		return dinosaurRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to list central requests")
+	pagingMeta, svcErr := services.ListPage(dbConn, listArgs, orderBy, &dinosaurRequestList)
+	if svcErr != nil {
+		return nil, nil, svcErr
 	}
 
 	return dinosaurRequestList, pagingMeta, nil
 }
 
-// ListByClusterID returns a list of CentralRequests with specified clusterID
+// ListByClusterID returns a list of CentralRequests with specified clusterID. This includes the CentralRequests
+// migrated from or to the cluster, unless the migration source cluster is still preparing the migration.
 func (k *dinosaurService) ListByClusterID(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
-	dbConn := k.connectionFactory.New().
+	clusterFilter := k.connectionFactory.New().
 		Where("cluster_id = ?", clusterID).
+		Or("migration_source_cluster_id = ?", clusterID).
+		Or("migration_target_cluster_id = ? AND migration_status != ?", clusterID, dinosaurConstants.CentralMigrationStatusSourcePreparing.String())
+	dbConn := k.connectionFactory.New().
+		Where(clusterFilter).
 		Where("status IN (?)", dinosaurManagedCRStatuses).
 		Where("host != ''")
 
@@ -645,12 +744,24 @@ func (k *dinosaurService) ListByClusterID(clusterID string) ([]*dbapi.CentralReq
 
 // Update ...
 func (k *dinosaurService) Update(dinosaurRequest *dbapi.CentralRequest) *errors.ServiceError {
+	version := dinosaurRequest.Version
 	dbConn := k.connectionFactory.New().
 		Model(dinosaurRequest).
 		Where("status not IN (?)", dinosaurDeletionStatuses) // ignore updates of dinosaur under deletion
+	if version > 0 {
+		// Only the non-zero fields of the struct are updated, so the version is incremented along with them.
+		dbConn = dbConn.Where("version = ?", version)
+		dinosaurRequest.Version = version + 1
+	}
 
-	if err := dbConn.Updates(dinosaurRequest).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update central")
+	result := dbConn.Updates(dinosaurRequest)
+	if result.Error != nil {
+		dinosaurRequest.Version = version
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "Failed to update central")
+	}
+	if version > 0 && result.RowsAffected == 0 {
+		dinosaurRequest.Version = version
+		return k.checkVersionConflict(dinosaurRequest.ID)
 	}
 
 	return nil
@@ -661,11 +772,42 @@ func (k *dinosaurService) Updates(dinosaurRequest *dbapi.CentralRequest, fields
 	dbConn := k.connectionFactory.New().
 		Model(dinosaurRequest).
 		Where("status not IN (?)", dinosaurDeletionStatuses) // ignore updates of dinosaur under deletion
+	if dinosaurRequest.Version > 0 {
+		dbConn = dbConn.Where("version = ?", dinosaurRequest.Version)
+	}
 
-	if err := dbConn.Updates(fields).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update central")
+	values := map[string]interface{}{"version": gorm.Expr("version + 1")}
+	for field, value := range fields {
+		values[field] = value
+	}
+	result := dbConn.Updates(values)
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "Failed to update central")
 	}
+	if dinosaurRequest.Version > 0 {
+		if result.RowsAffected == 0 {
+			return k.checkVersionConflict(dinosaurRequest.ID)
+		}
+		dinosaurRequest.Version++
+	}
+
+	return nil
+}
 
+// checkVersionConflict is called if an update of a central with a version did not affect any rows. Updates of
+// centrals under deletion are ignored, any other central was modified since it was read.
+func (k *dinosaurService) checkVersionConflict(id string) *errors.ServiceError {
+	var count int64
+	if err := k.connectionFactory.New().
+		Model(&dbapi.CentralRequest{}).
+		Where("id = ?", id).
+		Where("status NOT IN (?)", dinosaurDeletionStatuses).
+		Count(&count).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update central")
+	}
+	if count > 0 {
+		return errors.Conflict("central %s was modified concurrently", id)
+	}
 	return nil
 }
 
@@ -704,10 +846,12 @@ func (k *dinosaurService) UpdateStatus(id string, status dinosaurConstants.Centr
 		return false, errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
 	}
 
-	update := &dbapi.CentralRequest{Status: status.String()}
+	update := map[string]interface{}{
+		"status":  status.String(),
+		"version": gorm.Expr("version + 1"),
+	}
 	if status.String() == dinosaurConstants.CentralRequestStatusDeprovision.String() {
-		now := time.Now()
-		update.DeletionTimestamp = &now
+		update["deletion_timestamp"] = time.Now()
 	}
 
 	if err := dbConn.Model(&dbapi.CentralRequest{Meta: api.Meta{ID: id}}).Updates(update).Error; err != nil {
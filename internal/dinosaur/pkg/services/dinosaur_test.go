@@ -2,15 +2,22 @@ package services
 
 import (
 	"context"
+	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	mocket "github.com/selvatico/go-mocket"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/converters"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"github.com/stackrox/acs-fleet-manager/pkg/auth"
 	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
@@ -172,3 +179,193 @@ func Test_dinosaurService_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_dinosaurService_UpdateWithVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupFn     func()
+		wantErrCode errors.ServiceErrorCode
+		wantVersion int64
+	}{
+		{
+			name: "should increment the version of an unmodified central",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithRowsNum(1)
+			},
+			wantVersion: 4,
+		},
+		{
+			name: "should fail if the central was modified concurrently",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithRowsNum(0)
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(*) FROM "central_requests"`).
+					WithReply([]map[string]interface{}{{"count": 1}})
+			},
+			wantErrCode: errors.ErrorConflict,
+			wantVersion: 3,
+		},
+		{
+			name: "should ignore updates of centrals under deletion",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithRowsNum(0)
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(*) FROM "central_requests"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+			},
+			wantVersion: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &dinosaurService{connectionFactory: db.NewMockConnectionFactory(nil)}
+
+			for name, update := range map[string]func(*dbapi.CentralRequest) *errors.ServiceError{
+				"Update": func(centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+					return k.Update(centralRequest)
+				},
+				"Updates": func(centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+					return k.Updates(centralRequest, map[string]interface{}{"status": centralRequest.Status})
+				},
+			} {
+				tt.setupFn()
+				centralRequest := &dbapi.CentralRequest{Meta: api.Meta{ID: testID}, Status: "ready", Version: 3}
+				svcErr := update(centralRequest)
+				if tt.wantErrCode != 0 {
+					require.NotNil(t, svcErr, name)
+					assert.Equal(t, tt.wantErrCode, svcErr.Code, name)
+				} else {
+					require.Nil(t, svcErr, name)
+				}
+				assert.Equal(t, tt.wantVersion, centralRequest.Version, name)
+			}
+		})
+	}
+}
+
+func Test_dinosaurService_RestoreDinosaur(t *testing.T) {
+	pendingDeletionUntil := time.Now().Add(time.Hour)
+	tests := []struct {
+		name        string
+		status      string
+		setupFn     func()
+		wantErrCode errors.ServiceErrorCode
+		wantStatus  string
+	}{
+		{
+			name:        "should fail if the central is not pending deletion",
+			status:      dinosaurConstants.CentralRequestStatusReady.String(),
+			wantErrCode: errors.ErrorBadRequest,
+			wantStatus:  dinosaurConstants.CentralRequestStatusReady.String(),
+		},
+		{
+			name:   "should move a central pending deletion back to ready",
+			status: dinosaurConstants.CentralRequestStatusPendingDeletion.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithRowsNum(1)
+			},
+			wantStatus: dinosaurConstants.CentralRequestStatusReady.String(),
+		},
+		{
+			name:   "should fail if the grace period of the central ended",
+			status: dinosaurConstants.CentralRequestStatusPendingDeletion.String(),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithRowsNum(0)
+			},
+			wantErrCode: errors.ErrorConflict,
+			wantStatus:  dinosaurConstants.CentralRequestStatusPendingDeletion.String(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			k := &dinosaurService{connectionFactory: db.NewMockConnectionFactory(nil)}
+			centralRequest := &dbapi.CentralRequest{
+				Meta:                 api.Meta{ID: testID},
+				Status:               tt.status,
+				PendingDeletionUntil: &pendingDeletionUntil,
+				Version:              3,
+			}
+
+			svcErr := k.RestoreDinosaur(centralRequest)
+			assert.Equal(t, tt.wantStatus, centralRequest.Status)
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, svcErr)
+				assert.Equal(t, tt.wantErrCode, svcErr.Code)
+				assert.Equal(t, int64(3), centralRequest.Version)
+				return
+			}
+			require.Nil(t, svcErr)
+			assert.Nil(t, centralRequest.PendingDeletionUntil)
+			assert.Equal(t, int64(4), centralRequest.Version)
+		})
+	}
+}
+
+func Test_dinosaurService_RegisterDinosaurDeprovisionJob(t *testing.T) {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	require.NoError(t, err)
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	require.NoError(t, err)
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	require.NoError(t, err)
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
+	tests := []struct {
+		name        string
+		central     map[string]interface{}
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name: "should refuse to delete a central with deletion protection",
+			central: map[string]interface{}{
+				"id":                  testID,
+				"status":              dinosaurConstants.CentralRequestStatusReady.String(),
+				"deletion_protection": true,
+			},
+			wantErrCode: errors.ErrorDeletionProtected,
+		},
+		{
+			name: "should ignore the deletion of a central pending deletion",
+			central: map[string]interface{}{
+				"id":     testID,
+				"status": dinosaurConstants.CentralRequestStatusPendingDeletion.String(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset().NewMock().
+				WithQuery(`SELECT * FROM "central_requests" WHERE id = $1 AND owner = $2`).
+				WithReply([]map[string]interface{}{tt.central})
+			mocket.Catcher.NewMock().WithQuery("UPDATE").WithExecException()
+			k := &dinosaurService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				dinosaurConfig:    &config.CentralConfig{CentralDeletionGracePeriod: time.Hour},
+			}
+
+			svcErr := k.RegisterDinosaurDeprovisionJob(authenticatedCtx, testID)
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, svcErr)
+				assert.Equal(t, tt.wantErrCode, svcErr.Code)
+				assert.Equal(t, http.StatusConflict, svcErr.HTTPCode)
+			} else {
+				require.Nil(t, svcErr)
+			}
+		})
+	}
+}
+
+func Test_dinosaurService_DeprovisionPendingDeletions(t *testing.T) {
+	mocket.Catcher.Reset()
+	deprovisionQuery := mocket.Catcher.NewMock().
+		WithQuery(`UPDATE "central_requests" SET "deletion_timestamp"=$1,"status"=$2,"version"=version + 1,"updated_at"=$3 ` +
+			`WHERE status = $4 AND pending_deletion_until <= $5 AND deletion_protection = $6`).
+		WithRowsNum(1)
+	k := &dinosaurService{connectionFactory: db.NewMockConnectionFactory(nil)}
+
+	svcErr := k.DeprovisionPendingDeletions()
+
+	require.Nil(t, svcErr)
+	assert.True(t, deprovisionQuery.Triggered)
+}
@@ -46,6 +46,9 @@ var _ DinosaurService = &DinosaurServiceMock{}
 //			DeprovisionExpiredDinosaursFunc: func(dinosaurAgeInHours int) *serviceError.ServiceError {
 //				panic("mock out the DeprovisionExpiredDinosaurs method")
 //			},
+//			DeprovisionPendingDeletionsFunc: func() *serviceError.ServiceError {
+//				panic("mock out the DeprovisionPendingDeletions method")
+//			},
 //			DetectInstanceTypeFunc: func(dinosaurRequest *dbapi.CentralRequest) types.DinosaurInstanceType {
 //				panic("mock out the DetectInstanceType method")
 //			},
@@ -88,6 +91,9 @@ var _ DinosaurService = &DinosaurServiceMock{}
 //			RegisterDinosaurJobFunc: func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the RegisterDinosaurJob method")
 //			},
+//			RestoreDinosaurFunc: func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+//				panic("mock out the RestoreDinosaur method")
+//			},
 //			UpdateFunc: func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the Update method")
 //			},
@@ -128,6 +134,9 @@ type DinosaurServiceMock struct {
 	// DeprovisionExpiredDinosaursFunc mocks the DeprovisionExpiredDinosaurs method.
 	DeprovisionExpiredDinosaursFunc func(dinosaurAgeInHours int) *serviceError.ServiceError
 
+	// DeprovisionPendingDeletionsFunc mocks the DeprovisionPendingDeletions method.
+	DeprovisionPendingDeletionsFunc func() *serviceError.ServiceError
+
 	// DetectInstanceTypeFunc mocks the DetectInstanceType method.
 	DetectInstanceTypeFunc func(dinosaurRequest *dbapi.CentralRequest) types.DinosaurInstanceType
 
@@ -170,6 +179,9 @@ type DinosaurServiceMock struct {
 	// RegisterDinosaurJobFunc mocks the RegisterDinosaurJob method.
 	RegisterDinosaurJobFunc func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
+	// RestoreDinosaurFunc mocks the RestoreDinosaur method.
+	RestoreDinosaurFunc func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
@@ -221,6 +233,9 @@ type DinosaurServiceMock struct {
 			// DinosaurAgeInHours is the dinosaurAgeInHours argument value.
 			DinosaurAgeInHours int
 		}
+		// DeprovisionPendingDeletions holds details about calls to the DeprovisionPendingDeletions method.
+		DeprovisionPendingDeletions []struct {
+		}
 		// DetectInstanceType holds details about calls to the DetectInstanceType method.
 		DetectInstanceType []struct {
 			// DinosaurRequest is the dinosaurRequest argument value.
@@ -291,6 +306,11 @@ type DinosaurServiceMock struct {
 			// DinosaurRequest is the dinosaurRequest argument value.
 			DinosaurRequest *dbapi.CentralRequest
 		}
+		// RestoreDinosaur holds details about calls to the RestoreDinosaur method.
+		RestoreDinosaur []struct {
+			// DinosaurRequest is the dinosaurRequest argument value.
+			DinosaurRequest *dbapi.CentralRequest
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// DinosaurRequest is the dinosaurRequest argument value.
@@ -325,6 +345,7 @@ type DinosaurServiceMock struct {
 	lockDelete                            sync.RWMutex
 	lockDeprovisionDinosaurForUsers       sync.RWMutex
 	lockDeprovisionExpiredDinosaurs       sync.RWMutex
+	lockDeprovisionPendingDeletions       sync.RWMutex
 	lockDetectInstanceType                sync.RWMutex
 	lockGet                               sync.RWMutex
 	lockGetByID                           sync.RWMutex
@@ -339,6 +360,7 @@ type DinosaurServiceMock struct {
 	lockPrepareDinosaurRequest            sync.RWMutex
 	lockRegisterDinosaurDeprovisionJob    sync.RWMutex
 	lockRegisterDinosaurJob               sync.RWMutex
+	lockRestoreDinosaur                   sync.RWMutex
 	lockUpdate                            sync.RWMutex
 	lockUpdateStatus                      sync.RWMutex
 	lockUpdates                           sync.RWMutex
@@ -572,6 +594,33 @@ func (mock *DinosaurServiceMock) DeprovisionExpiredDinosaursCalls() []struct {
 	return calls
 }
 
+// DeprovisionPendingDeletions calls DeprovisionPendingDeletionsFunc.
+func (mock *DinosaurServiceMock) DeprovisionPendingDeletions() *serviceError.ServiceError {
+	if mock.DeprovisionPendingDeletionsFunc == nil {
+		panic("DinosaurServiceMock.DeprovisionPendingDeletionsFunc: method is nil but DinosaurService.DeprovisionPendingDeletions was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDeprovisionPendingDeletions.Lock()
+	mock.calls.DeprovisionPendingDeletions = append(mock.calls.DeprovisionPendingDeletions, callInfo)
+	mock.lockDeprovisionPendingDeletions.Unlock()
+	return mock.DeprovisionPendingDeletionsFunc()
+}
+
+// DeprovisionPendingDeletionsCalls gets all the calls that were made to DeprovisionPendingDeletions.
+// Check the length with:
+//
+//	len(mockedDinosaurService.DeprovisionPendingDeletionsCalls())
+func (mock *DinosaurServiceMock) DeprovisionPendingDeletionsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDeprovisionPendingDeletions.RLock()
+	calls = mock.calls.DeprovisionPendingDeletions
+	mock.lockDeprovisionPendingDeletions.RUnlock()
+	return calls
+}
+
 // DetectInstanceType calls DetectInstanceTypeFunc.
 func (mock *DinosaurServiceMock) DetectInstanceType(dinosaurRequest *dbapi.CentralRequest) types.DinosaurInstanceType {
 	if mock.DetectInstanceTypeFunc == nil {
@@ -1017,6 +1066,38 @@ func (mock *DinosaurServiceMock) RegisterDinosaurJobCalls() []struct {
 	return calls
 }
 
+// RestoreDinosaur calls RestoreDinosaurFunc.
+func (mock *DinosaurServiceMock) RestoreDinosaur(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+	if mock.RestoreDinosaurFunc == nil {
+		panic("DinosaurServiceMock.RestoreDinosaurFunc: method is nil but DinosaurService.RestoreDinosaur was just called")
+	}
+	callInfo := struct {
+		DinosaurRequest *dbapi.CentralRequest
+	}{
+		DinosaurRequest: dinosaurRequest,
+	}
+	mock.lockRestoreDinosaur.Lock()
+	mock.calls.RestoreDinosaur = append(mock.calls.RestoreDinosaur, callInfo)
+	mock.lockRestoreDinosaur.Unlock()
+	return mock.RestoreDinosaurFunc(dinosaurRequest)
+}
+
+// RestoreDinosaurCalls gets all the calls that were made to RestoreDinosaur.
+// Check the length with:
+//
+//	len(mockedDinosaurService.RestoreDinosaurCalls())
+func (mock *DinosaurServiceMock) RestoreDinosaurCalls() []struct {
+	DinosaurRequest *dbapi.CentralRequest
+} {
+	var calls []struct {
+		DinosaurRequest *dbapi.CentralRequest
+	}
+	mock.lockRestoreDinosaur.RLock()
+	calls = mock.calls.RestoreDinosaur
+	mock.lockRestoreDinosaur.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *DinosaurServiceMock) Update(dinosaurRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 	if mock.UpdateFunc == nil {
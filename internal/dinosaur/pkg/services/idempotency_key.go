@@ -0,0 +1,107 @@
+package services
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// idempotencyKeyLockTimeout is the time after which a request that did not record its response, e.g. because
+// fleet-manager was restarted while processing it, no longer blocks retries with the same key.
+const idempotencyKeyLockTimeout = time.Minute
+
+// IdempotencyKeyService records the responses of requests sent with an Idempotency-Key header.
+//
+//go:generate moq -out idempotency_key_moq.go . IdempotencyKeyService
+type IdempotencyKeyService interface {
+	// Begin reserves the key of a request. If the key was not used before, the reservation is returned with
+	// created set to true and the request must be executed, followed by Complete or Release. If the key was used
+	// with the same request before, the recorded response is returned with created set to false.
+	Begin(scope, endpoint, key, requestHash string) (record *dbapi.IdempotencyKey, created bool, svcErr *errors.ServiceError)
+	// Complete records the response of a reserved request.
+	Complete(record *dbapi.IdempotencyKey, statusCode int, responseBody []byte) *errors.ServiceError
+	// Release removes the reservation of a request that failed, so that it can be retried with the same key.
+	Release(record *dbapi.IdempotencyKey) *errors.ServiceError
+}
+
+type idempotencyKeyService struct {
+	connectionFactory    *db.ConnectionFactory
+	centralRequestConfig *config.CentralRequestConfig
+}
+
+var _ IdempotencyKeyService = &idempotencyKeyService{}
+
+// NewIdempotencyKeyService ...
+func NewIdempotencyKeyService(connectionFactory *db.ConnectionFactory, centralRequestConfig *config.CentralRequestConfig) IdempotencyKeyService {
+	return &idempotencyKeyService{
+		connectionFactory:    connectionFactory,
+		centralRequestConfig: centralRequestConfig,
+	}
+}
+
+// Begin ...
+func (s *idempotencyKeyService) Begin(scope, endpoint, key, requestHash string) (*dbapi.IdempotencyKey, bool, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+	now := time.Now()
+
+	// Expired keys are removed lazily, together with an abandoned reservation of the same key.
+	if err := dbConn.
+		Where("created_at < ?", now.Add(-s.centralRequestConfig.IdempotencyKeyTTL)).
+		Or("scope = ? AND endpoint = ? AND key = ? AND status_code = 0 AND created_at < ?",
+			scope, endpoint, key, now.Add(-idempotencyKeyLockTimeout)).
+		Delete(&dbapi.IdempotencyKey{}).Error; err != nil {
+		return nil, false, errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete expired idempotency keys")
+	}
+
+	record := &dbapi.IdempotencyKey{
+		Scope:       scope,
+		Endpoint:    endpoint,
+		Key:         key,
+		RequestHash: requestHash,
+	}
+	result := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return nil, false, errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to reserve idempotency key %q", key)
+	}
+	if result.RowsAffected > 0 {
+		return record, true, nil
+	}
+
+	var existing dbapi.IdempotencyKey
+	if err := dbConn.
+		Where("scope = ? AND endpoint = ? AND key = ?", scope, endpoint, key).
+		First(&existing).Error; err != nil {
+		return nil, false, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get idempotency key %q", key)
+	}
+	if existing.RequestHash != requestHash {
+		return nil, false, errors.New(errors.ErrorIdempotencyKeyReused,
+			"idempotency key %q was already used for a request with a different body", key)
+	}
+	if existing.InProgress() {
+		return nil, false, errors.Conflict("a request with idempotency key %q is still being processed", key)
+	}
+	return &existing, false, nil
+}
+
+// Complete ...
+func (s *idempotencyKeyService) Complete(record *dbapi.IdempotencyKey, statusCode int, responseBody []byte) *errors.ServiceError {
+	if err := s.connectionFactory.New().Model(record).Updates(map[string]interface{}{
+		"status_code":   statusCode,
+		"response_body": string(responseBody),
+	}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record response of idempotency key %q", record.Key)
+	}
+	return nil
+}
+
+// Release ...
+func (s *idempotencyKeyService) Release(record *dbapi.IdempotencyKey) *errors.ServiceError {
+	if err := s.connectionFactory.New().Delete(record).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to release idempotency key %q", record.Key)
+	}
+	return nil
+}
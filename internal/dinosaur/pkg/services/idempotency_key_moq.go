@@ -0,0 +1,194 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that IdempotencyKeyServiceMock does implement IdempotencyKeyService.
+// If this is not the case, regenerate this file with moq.
+var _ IdempotencyKeyService = &IdempotencyKeyServiceMock{}
+
+// IdempotencyKeyServiceMock is a mock implementation of IdempotencyKeyService.
+//
+//	func TestSomethingThatUsesIdempotencyKeyService(t *testing.T) {
+//
+//		// make and configure a mocked IdempotencyKeyService
+//		mockedIdempotencyKeyService := &IdempotencyKeyServiceMock{
+//			BeginFunc: func(scope string, endpoint string, key string, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceError.ServiceError) {
+//				panic("mock out the Begin method")
+//			},
+//			CompleteFunc: func(record *dbapi.IdempotencyKey, statusCode int, responseBody []byte) *serviceError.ServiceError {
+//				panic("mock out the Complete method")
+//			},
+//			ReleaseFunc: func(record *dbapi.IdempotencyKey) *serviceError.ServiceError {
+//				panic("mock out the Release method")
+//			},
+//		}
+//
+//		// use mockedIdempotencyKeyService in code that requires IdempotencyKeyService
+//		// and then make assertions.
+//
+//	}
+type IdempotencyKeyServiceMock struct {
+	// BeginFunc mocks the Begin method.
+	BeginFunc func(scope string, endpoint string, key string, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceError.ServiceError)
+
+	// CompleteFunc mocks the Complete method.
+	CompleteFunc func(record *dbapi.IdempotencyKey, statusCode int, responseBody []byte) *serviceError.ServiceError
+
+	// ReleaseFunc mocks the Release method.
+	ReleaseFunc func(record *dbapi.IdempotencyKey) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Begin holds details about calls to the Begin method.
+		Begin []struct {
+			// Scope is the scope argument value.
+			Scope string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Key is the key argument value.
+			Key string
+			// RequestHash is the requestHash argument value.
+			RequestHash string
+		}
+		// Complete holds details about calls to the Complete method.
+		Complete []struct {
+			// Record is the record argument value.
+			Record *dbapi.IdempotencyKey
+			// StatusCode is the statusCode argument value.
+			StatusCode int
+			// ResponseBody is the responseBody argument value.
+			ResponseBody []byte
+		}
+		// Release holds details about calls to the Release method.
+		Release []struct {
+			// Record is the record argument value.
+			Record *dbapi.IdempotencyKey
+		}
+	}
+	lockBegin    sync.RWMutex
+	lockComplete sync.RWMutex
+	lockRelease  sync.RWMutex
+}
+
+// Begin calls BeginFunc.
+func (mock *IdempotencyKeyServiceMock) Begin(scope string, endpoint string, key string, requestHash string) (*dbapi.IdempotencyKey, bool, *serviceError.ServiceError) {
+	if mock.BeginFunc == nil {
+		panic("IdempotencyKeyServiceMock.BeginFunc: method is nil but IdempotencyKeyService.Begin was just called")
+	}
+	callInfo := struct {
+		Scope       string
+		Endpoint    string
+		Key         string
+		RequestHash string
+	}{
+		Scope:       scope,
+		Endpoint:    endpoint,
+		Key:         key,
+		RequestHash: requestHash,
+	}
+	mock.lockBegin.Lock()
+	mock.calls.Begin = append(mock.calls.Begin, callInfo)
+	mock.lockBegin.Unlock()
+	return mock.BeginFunc(scope, endpoint, key, requestHash)
+}
+
+// BeginCalls gets all the calls that were made to Begin.
+// Check the length with:
+//
+//	len(mockedIdempotencyKeyService.BeginCalls())
+func (mock *IdempotencyKeyServiceMock) BeginCalls() []struct {
+	Scope       string
+	Endpoint    string
+	Key         string
+	RequestHash string
+} {
+	var calls []struct {
+		Scope       string
+		Endpoint    string
+		Key         string
+		RequestHash string
+	}
+	mock.lockBegin.RLock()
+	calls = mock.calls.Begin
+	mock.lockBegin.RUnlock()
+	return calls
+}
+
+// Complete calls CompleteFunc.
+func (mock *IdempotencyKeyServiceMock) Complete(record *dbapi.IdempotencyKey, statusCode int, responseBody []byte) *serviceError.ServiceError {
+	if mock.CompleteFunc == nil {
+		panic("IdempotencyKeyServiceMock.CompleteFunc: method is nil but IdempotencyKeyService.Complete was just called")
+	}
+	callInfo := struct {
+		Record       *dbapi.IdempotencyKey
+		StatusCode   int
+		ResponseBody []byte
+	}{
+		Record:       record,
+		StatusCode:   statusCode,
+		ResponseBody: responseBody,
+	}
+	mock.lockComplete.Lock()
+	mock.calls.Complete = append(mock.calls.Complete, callInfo)
+	mock.lockComplete.Unlock()
+	return mock.CompleteFunc(record, statusCode, responseBody)
+}
+
+// CompleteCalls gets all the calls that were made to Complete.
+// Check the length with:
+//
+//	len(mockedIdempotencyKeyService.CompleteCalls())
+func (mock *IdempotencyKeyServiceMock) CompleteCalls() []struct {
+	Record       *dbapi.IdempotencyKey
+	StatusCode   int
+	ResponseBody []byte
+} {
+	var calls []struct {
+		Record       *dbapi.IdempotencyKey
+		StatusCode   int
+		ResponseBody []byte
+	}
+	mock.lockComplete.RLock()
+	calls = mock.calls.Complete
+	mock.lockComplete.RUnlock()
+	return calls
+}
+
+// Release calls ReleaseFunc.
+func (mock *IdempotencyKeyServiceMock) Release(record *dbapi.IdempotencyKey) *serviceError.ServiceError {
+	if mock.ReleaseFunc == nil {
+		panic("IdempotencyKeyServiceMock.ReleaseFunc: method is nil but IdempotencyKeyService.Release was just called")
+	}
+	callInfo := struct {
+		Record *dbapi.IdempotencyKey
+	}{
+		Record: record,
+	}
+	mock.lockRelease.Lock()
+	mock.calls.Release = append(mock.calls.Release, callInfo)
+	mock.lockRelease.Unlock()
+	return mock.ReleaseFunc(record)
+}
+
+// ReleaseCalls gets all the calls that were made to Release.
+// Check the length with:
+//
+//	len(mockedIdempotencyKeyService.ReleaseCalls())
+func (mock *IdempotencyKeyServiceMock) ReleaseCalls() []struct {
+	Record *dbapi.IdempotencyKey
+} {
+	var calls []struct {
+		Record *dbapi.IdempotencyKey
+	}
+	mock.lockRelease.RLock()
+	calls = mock.calls.Release
+	mock.lockRelease.RUnlock()
+	return calls
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKeyService_Begin(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    map[string]interface{}
+		wantCreated bool
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name:        "should reserve an unused key",
+			wantCreated: true,
+		},
+		{
+			name:     "should return the recorded response of a completed request",
+			existing: map[string]interface{}{"key": "key", "request_hash": "hash", "status_code": http.StatusAccepted, "response_body": "{}"},
+		},
+		{
+			name:        "should reject a key reused with a different body",
+			existing:    map[string]interface{}{"key": "key", "request_hash": "other-hash", "status_code": http.StatusAccepted},
+			wantErrCode: errors.ErrorIdempotencyKeyReused,
+		},
+		{
+			name:        "should reject a retry while the request is being processed",
+			existing:    map[string]interface{}{"key": "key", "request_hash": "hash", "status_code": 0},
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			expiredDelete := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys"`)
+			// The insert returns the ID of the new row, or no row if the key exists.
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "idempotency_keys"`)
+			if tt.existing == nil {
+				insert.WithReply([]map[string]interface{}{{"id": 1}})
+			} else {
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "idempotency_keys" WHERE scope = $1 AND endpoint = $2 AND key = $3`).
+					WithReply([]map[string]interface{}{tt.existing})
+			}
+			s := NewIdempotencyKeyService(db.NewMockConnectionFactory(nil), config.NewCentralRequestConfig())
+
+			record, created, svcErr := s.Begin("org:org-id", "create-central", "key", "hash")
+			assert.True(t, expiredDelete.Triggered)
+			assert.True(t, insert.Triggered)
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, svcErr)
+				assert.Equal(t, tt.wantErrCode, svcErr.Code)
+				return
+			}
+			require.Nil(t, svcErr)
+			assert.Equal(t, tt.wantCreated, created)
+			if !tt.wantCreated {
+				assert.Equal(t, http.StatusAccepted, record.StatusCode)
+				assert.Equal(t, "{}", record.ResponseBody)
+			}
+		})
+	}
+}
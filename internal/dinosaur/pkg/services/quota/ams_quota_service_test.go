@@ -185,7 +185,7 @@ func Test_AMSCheckQuota(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			dinosaur := &dbapi.CentralRequest{
 				Meta: api.Meta{
@@ -646,7 +646,7 @@ func Test_AMSReserveQuota(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			dinosaur := &dbapi.CentralRequest{
 				Meta: api.Meta{
@@ -736,7 +736,7 @@ func Test_Delete_Quota(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			err := quotaService.DeleteQuota(tt.args.subscriptionID)
 			if (err != nil) != tt.wantErr {
@@ -920,7 +920,7 @@ func Test_amsQuotaService_CheckIfQuotaIsDefinedForInstanceType(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
-			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.ocmClient, nil, nil)
+			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.ocmClient, nil, nil, nil)
 			quotaService, _ := quotaServiceFactory.GetQuotaService(api.AMSQuotaType)
 			res, err := quotaService.CheckIfQuotaIsDefinedForInstanceType(tt.args.dinosaurRequest, tt.args.dinosaurInstanceType)
 			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
@@ -19,10 +19,15 @@ func NewDefaultQuotaServiceFactory(
 	amsClient ocm.AMSClient,
 	connectionFactory *db.ConnectionFactory,
 	quotaManagementListConfig *quotamanagement.QuotaManagementListConfig,
+	quotaOverrideService services.QuotaOverrideService,
 ) services.QuotaServiceFactory {
 	quoataServiceContainer := map[api.QuotaType]services.QuotaService{
-		api.AMSQuotaType:                 &amsQuotaService{amsClient: amsClient},
-		api.QuotaManagementListQuotaType: &QuotaManagementListService{connectionFactory: connectionFactory, quotaManagementList: quotaManagementListConfig},
+		api.AMSQuotaType: &amsQuotaService{amsClient: amsClient},
+		api.QuotaManagementListQuotaType: &QuotaManagementListService{
+			connectionFactory:    connectionFactory,
+			quotaManagementList:  quotaManagementListConfig,
+			quotaOverrideService: quotaOverrideService,
+		},
 	}
 	return &DefaultQuotaServiceFactory{quoataServiceContainer: quoataServiceContainer}
 }
@@ -7,21 +7,49 @@ import (
 
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/db"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 )
 
 // QuotaManagementListService ...
 type QuotaManagementListService struct {
-	connectionFactory   *db.ConnectionFactory
-	quotaManagementList *quotamanagement.QuotaManagementListConfig
+	connectionFactory    *db.ConnectionFactory
+	quotaManagementList  *quotamanagement.QuotaManagementListConfig
+	quotaOverrideService services.QuotaOverrideService
+}
+
+// getOrganisation returns the quota management list entry of an organisation. A quota override in the database takes
+// precedence over the configuration file: it replaces the maximum number of allowed instances of an organisation in
+// the file, and allows any user of an organisation that is not in the file.
+func (q QuotaManagementListService) getOrganisation(orgID string) (quotamanagement.Organisation, bool, *errors.ServiceError) {
+	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetByID(orgID)
+	if q.quotaOverrideService == nil || orgID == "" {
+		return org, orgFound, nil
+	}
+
+	override, svcErr := q.quotaOverrideService.Get(orgID)
+	if svcErr != nil {
+		if svcErr.Is404() {
+			return org, orgFound, nil
+		}
+		return quotamanagement.Organisation{}, false, svcErr
+	}
+	if !orgFound {
+		org = quotamanagement.Organisation{ID: orgID, AnyUser: true}
+	}
+	org.MaxAllowedInstances = override.MaxAllowedInstances
+	return org, true, nil
 }
 
 // CheckIfQuotaIsDefinedForInstanceType ...
 func (q QuotaManagementListService) CheckIfQuotaIsDefinedForInstanceType(dinosaur *dbapi.CentralRequest, instanceType types.DinosaurInstanceType) (bool, *errors.ServiceError) {
 	username := dinosaur.Owner
 	orgID := dinosaur.OrganisationID
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetByID(orgID)
+	org, orgFound, svcErr := q.getOrganisation(orgID)
+	if svcErr != nil {
+		return false, svcErr
+	}
 	userIsRegistered := false
 	if orgFound && org.IsUserRegistered(username) {
 		userIsRegistered = true
@@ -50,7 +78,10 @@ func (q QuotaManagementListService) ReserveQuota(dinosaur *dbapi.CentralRequest,
 	orgID := dinosaur.OrganisationID
 	var quotaManagementListItem quotamanagement.QuotaManagementListItem
 	message := fmt.Sprintf("User '%s' has reached a maximum number of %d allowed instances.", username, quotamanagement.GetDefaultMaxAllowedInstances())
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetByID(orgID)
+	org, orgFound, svcErr := q.getOrganisation(orgID)
+	if svcErr != nil {
+		return "", svcErr
+	}
 	filterByOrd := false
 	if orgFound && org.IsUserRegistered(username) {
 		quotaManagementListItem = org
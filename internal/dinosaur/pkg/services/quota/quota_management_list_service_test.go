@@ -10,6 +10,7 @@ import (
 	mocket "github.com/selvatico/go-mocket"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/dinosaurs/types"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"github.com/stackrox/acs-fleet-manager/pkg/db"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
@@ -19,6 +20,7 @@ func Test_QuotaManagementListCheckQuota(t *testing.T) {
 	type fields struct {
 		connectionFactory   *db.ConnectionFactory
 		QuotaManagementList *quotamanagement.QuotaManagementListConfig
+		quotaOverride       *dbapi.OrganisationQuotaOverride
 	}
 
 	type args struct {
@@ -114,6 +116,20 @@ func Test_QuotaManagementListCheckQuota(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "return true when the organisation of the user has a quota override and instance type is standard",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				QuotaManagementList: &quotamanagement.QuotaManagementListConfig{
+					EnableInstanceLimitControl: true,
+				},
+				quotaOverride: &dbapi.OrganisationQuotaOverride{OrganisationID: "org-id", MaxAllowedInstances: 3},
+			},
+			args: args{
+				instanceType: types.STANDARD,
+			},
+			want: true,
+		},
 		{
 			name: "return false when user is part of the quota list under an organisation and instance type is eval",
 			fields: fields{
@@ -142,7 +158,8 @@ func Test_QuotaManagementListCheckQuota(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
 
-			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList)
+			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList,
+				newQuotaOverrideServiceMock(tt.fields.quotaOverride))
 			quotaService, _ := factory.GetQuotaService(api.QuotaManagementListQuotaType)
 			dinosaur := &dbapi.CentralRequest{
 				Owner:          "username",
@@ -158,6 +175,7 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 	type fields struct {
 		connectionFactory   *db.ConnectionFactory
 		QuotaManagementList *quotamanagement.QuotaManagementListConfig
+		quotaOverride       *dbapi.OrganisationQuotaOverride
 	}
 
 	type args struct {
@@ -335,6 +353,63 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "return an error when an organisation exceeds the limit of its quota override",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				QuotaManagementList: &quotamanagement.QuotaManagementListConfig{
+					EnableInstanceLimitControl: true,
+					QuotaList: quotamanagement.RegisteredUsersListConfiguration{
+						Organisations: quotamanagement.OrganisationList{
+							quotamanagement.Organisation{
+								ID:                  "org-id",
+								MaxAllowedInstances: 4,
+								AnyUser:             true,
+							},
+						},
+					},
+				},
+				quotaOverride: &dbapi.OrganisationQuotaOverride{OrganisationID: "org-id", MaxAllowedInstances: 2},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT count(*) FROM "central_requests" WHERE instance_type = $1 AND organisation_id = $2 AND "central_requests"."deleted_at" IS NULL`).
+					WithArgs(types.STANDARD.String(), "org-id").
+					WithReply([]map[string]interface{}{{"count": "2"}})
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			wantErr: &errors.ServiceError{
+				HTTPCode: http.StatusForbidden,
+				Reason:   "Organization 'org-id' has reached a maximum number of 2 allowed instances.",
+				Code:     5,
+			},
+			args: args{
+				instanceType: types.STANDARD,
+			},
+		},
+		{
+			name: "do not return an error when an organisation that is not in the quota list is within the limit of its quota override",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				QuotaManagementList: &quotamanagement.QuotaManagementListConfig{
+					EnableInstanceLimitControl: true,
+				},
+				quotaOverride: &dbapi.OrganisationQuotaOverride{OrganisationID: "org-id", MaxAllowedInstances: 5},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT count(*) FROM "central_requests" WHERE instance_type = $1 AND organisation_id = $2 AND "central_requests"."deleted_at" IS NULL`).
+					WithArgs(types.STANDARD.String(), "org-id").
+					WithReply([]map[string]interface{}{{"count": "4"}})
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			args: args{
+				instanceType: types.STANDARD,
+			},
+			wantErr: nil,
+		},
 		{
 			name: "do not return an error when user who's not in the quota list can eval instances",
 			fields: fields{
@@ -364,7 +439,8 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			if tt.setupFn != nil {
 				tt.setupFn()
 			}
-			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList)
+			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList,
+				newQuotaOverrideServiceMock(tt.fields.quotaOverride))
 			quotaService, _ := factory.GetQuotaService(api.QuotaManagementListQuotaType)
 			dinosaur := &dbapi.CentralRequest{
 				Owner:          "username",
@@ -375,3 +451,14 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 		})
 	}
 }
+
+func newQuotaOverrideServiceMock(override *dbapi.OrganisationQuotaOverride) *services.QuotaOverrideServiceMock {
+	return &services.QuotaOverrideServiceMock{
+		GetFunc: func(orgID string) (*dbapi.OrganisationQuotaOverride, *errors.ServiceError) {
+			if override == nil || override.OrganisationID != orgID {
+				return nil, errors.NotFound("no quota override for organisation %s", orgID)
+			}
+			return override, nil
+		},
+	}
+}
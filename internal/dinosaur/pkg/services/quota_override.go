@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+const (
+	quotaOverrideActionCreated = "created"
+	quotaOverrideActionUpdated = "updated"
+	quotaOverrideActionDeleted = "deleted"
+)
+
+// QuotaOverrideService manages the per-organisation quota overrides stored in the database.
+//
+// Overrides take precedence over the quota management list configuration file, so that the limit of an
+// organisation can be changed without a deployment. Every change is recorded in the audit history of the organisation.
+//
+//go:generate moq -out quota_override_moq.go . QuotaOverrideService
+type QuotaOverrideService interface {
+	// Get returns the quota override of the organisation with the given ID.
+	Get(orgID string) (*dbapi.OrganisationQuotaOverride, *errors.ServiceError)
+	// List returns the quota overrides of all organisations.
+	List() (dbapi.OrganisationQuotaOverrideList, *errors.ServiceError)
+	// Create creates a quota override for an organisation that does not have one yet.
+	Create(ctx context.Context, override *dbapi.OrganisationQuotaOverride) *errors.ServiceError
+	// Update changes the maximum number of allowed instances of an existing quota override.
+	Update(ctx context.Context, orgID string, maxAllowedInstances int) (*dbapi.OrganisationQuotaOverride, *errors.ServiceError)
+	// Delete removes the quota override of an organisation, which falls back to the quota management list configuration.
+	Delete(ctx context.Context, orgID string) *errors.ServiceError
+	// ListChanges returns the audit history of the quota overrides of an organisation, most recent first.
+	ListChanges(orgID string) (dbapi.OrganisationQuotaOverrideChangeList, *errors.ServiceError)
+}
+
+type quotaOverrideService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ QuotaOverrideService = &quotaOverrideService{}
+
+// NewQuotaOverrideService ...
+func NewQuotaOverrideService(connectionFactory *db.ConnectionFactory) QuotaOverrideService {
+	return &quotaOverrideService{connectionFactory: connectionFactory}
+}
+
+// Get ...
+func (s *quotaOverrideService) Get(orgID string) (*dbapi.OrganisationQuotaOverride, *errors.ServiceError) {
+	var override dbapi.OrganisationQuotaOverride
+	if err := s.connectionFactory.New().
+		Where("organisation_id = ?", orgID).
+		First(&override).Error; err != nil {
+		return nil, services.HandleGetError("OrganisationQuotaOverride", "organisation_id", orgID, err)
+	}
+	return &override, nil
+}
+
+// List ...
+func (s *quotaOverrideService) List() (dbapi.OrganisationQuotaOverrideList, *errors.ServiceError) {
+	var overrides dbapi.OrganisationQuotaOverrideList
+	if err := s.connectionFactory.New().
+		Order("organisation_id").
+		Find(&overrides).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list quota overrides")
+	}
+	return overrides, nil
+}
+
+// Create ...
+func (s *quotaOverrideService) Create(ctx context.Context, override *dbapi.OrganisationQuotaOverride) *errors.ServiceError {
+	if svcErr := validateQuotaOverride(override.OrganisationID, override.MaxAllowedInstances); svcErr != nil {
+		return svcErr
+	}
+	if _, svcErr := s.Get(override.OrganisationID); svcErr == nil {
+		return errors.Conflict("organisation %s already has a quota override", override.OrganisationID)
+	} else if !svcErr.Is404() {
+		return svcErr
+	}
+
+	change := &dbapi.OrganisationQuotaOverrideChange{
+		OrganisationID:      override.OrganisationID,
+		Action:              quotaOverrideActionCreated,
+		MaxAllowedInstances: override.MaxAllowedInstances,
+		ChangedBy:           changedBy(ctx),
+	}
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(override).Error; err != nil {
+			return err
+		}
+		return tx.Create(change).Error
+	}); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create quota override of organisation %s", override.OrganisationID)
+	}
+
+	logger.NewUHCLogger(ctx).Infof("%s created quota override of organisation %s: %d max allowed instances",
+		change.ChangedBy, override.OrganisationID, override.MaxAllowedInstances)
+	return nil
+}
+
+// Update ...
+func (s *quotaOverrideService) Update(ctx context.Context, orgID string, maxAllowedInstances int) (*dbapi.OrganisationQuotaOverride, *errors.ServiceError) {
+	if svcErr := validateQuotaOverride(orgID, maxAllowedInstances); svcErr != nil {
+		return nil, svcErr
+	}
+	override, svcErr := s.Get(orgID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	change := &dbapi.OrganisationQuotaOverrideChange{
+		OrganisationID:              orgID,
+		Action:                      quotaOverrideActionUpdated,
+		MaxAllowedInstances:         maxAllowedInstances,
+		PreviousMaxAllowedInstances: override.MaxAllowedInstances,
+		ChangedBy:                   changedBy(ctx),
+	}
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(override).Update("max_allowed_instances", maxAllowedInstances).Error; err != nil {
+			return err
+		}
+		return tx.Create(change).Error
+	}); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update quota override of organisation %s", orgID)
+	}
+
+	logger.NewUHCLogger(ctx).Infof("%s updated quota override of organisation %s: %d -> %d max allowed instances",
+		change.ChangedBy, orgID, change.PreviousMaxAllowedInstances, maxAllowedInstances)
+	return override, nil
+}
+
+// Delete ...
+func (s *quotaOverrideService) Delete(ctx context.Context, orgID string) *errors.ServiceError {
+	override, svcErr := s.Get(orgID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	change := &dbapi.OrganisationQuotaOverrideChange{
+		OrganisationID:              orgID,
+		Action:                      quotaOverrideActionDeleted,
+		PreviousMaxAllowedInstances: override.MaxAllowedInstances,
+		ChangedBy:                   changedBy(ctx),
+	}
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(override).Error; err != nil {
+			return err
+		}
+		return tx.Create(change).Error
+	}); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete quota override of organisation %s", orgID)
+	}
+
+	logger.NewUHCLogger(ctx).Infof("%s deleted quota override of organisation %s", change.ChangedBy, orgID)
+	return nil
+}
+
+// ListChanges ...
+func (s *quotaOverrideService) ListChanges(orgID string) (dbapi.OrganisationQuotaOverrideChangeList, *errors.ServiceError) {
+	var changes dbapi.OrganisationQuotaOverrideChangeList
+	if err := s.connectionFactory.New().
+		Where("organisation_id = ?", orgID).
+		Order("id DESC").
+		Find(&changes).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list quota override changes of organisation %s", orgID)
+	}
+	return changes, nil
+}
+
+func validateQuotaOverride(orgID string, maxAllowedInstances int) *errors.ServiceError {
+	if orgID == "" {
+		return errors.BadRequest("organisation ID must not be empty")
+	}
+	// The quota management list treats values lower than one as unset, so they cannot override anything.
+	if maxAllowedInstances < 1 {
+		return errors.BadRequest("max allowed instances must be at least 1, got %d", maxAllowedInstances)
+	}
+	return nil
+}
+
+func changedBy(ctx context.Context) string {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+	username, _ := claims.GetUsername()
+	return username
+}
@@ -0,0 +1,314 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that QuotaOverrideServiceMock does implement QuotaOverrideService.
+// If this is not the case, regenerate this file with moq.
+var _ QuotaOverrideService = &QuotaOverrideServiceMock{}
+
+// QuotaOverrideServiceMock is a mock implementation of QuotaOverrideService.
+//
+//	func TestSomethingThatUsesQuotaOverrideService(t *testing.T) {
+//
+//		// make and configure a mocked QuotaOverrideService
+//		mockedQuotaOverrideService := &QuotaOverrideServiceMock{
+//			CreateFunc: func(ctx context.Context, override *dbapi.OrganisationQuotaOverride) *serviceError.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(ctx context.Context, orgID string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(orgID string) (*dbapi.OrganisationQuotaOverride, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func() (dbapi.OrganisationQuotaOverrideList, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListChangesFunc: func(orgID string) (dbapi.OrganisationQuotaOverrideChangeList, *serviceError.ServiceError) {
+//				panic("mock out the ListChanges method")
+//			},
+//			UpdateFunc: func(ctx context.Context, orgID string, maxAllowedInstances int) (*dbapi.OrganisationQuotaOverride, *serviceError.ServiceError) {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedQuotaOverrideService in code that requires QuotaOverrideService
+//		// and then make assertions.
+//
+//	}
+type QuotaOverrideServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, override *dbapi.OrganisationQuotaOverride) *serviceError.ServiceError
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, orgID string) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(orgID string) (*dbapi.OrganisationQuotaOverride, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func() (dbapi.OrganisationQuotaOverrideList, *serviceError.ServiceError)
+
+	// ListChangesFunc mocks the ListChanges method.
+	ListChangesFunc func(orgID string) (dbapi.OrganisationQuotaOverrideChangeList, *serviceError.ServiceError)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, orgID string, maxAllowedInstances int) (*dbapi.OrganisationQuotaOverride, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Override is the override argument value.
+			Override *dbapi.OrganisationQuotaOverride
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgID is the orgID argument value.
+			OrgID string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// OrgID is the orgID argument value.
+			OrgID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+		}
+		// ListChanges holds details about calls to the ListChanges method.
+		ListChanges []struct {
+			// OrgID is the orgID argument value.
+			OrgID string
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgID is the orgID argument value.
+			OrgID string
+			// MaxAllowedInstances is the maxAllowedInstances argument value.
+			MaxAllowedInstances int
+		}
+	}
+	lockCreate      sync.RWMutex
+	lockDelete      sync.RWMutex
+	lockGet         sync.RWMutex
+	lockList        sync.RWMutex
+	lockListChanges sync.RWMutex
+	lockUpdate      sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *QuotaOverrideServiceMock) Create(ctx context.Context, override *dbapi.OrganisationQuotaOverride) *serviceError.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("QuotaOverrideServiceMock.CreateFunc: method is nil but QuotaOverrideService.Create was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Override *dbapi.OrganisationQuotaOverride
+	}{
+		Ctx:      ctx,
+		Override: override,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, override)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedQuotaOverrideService.CreateCalls())
+func (mock *QuotaOverrideServiceMock) CreateCalls() []struct {
+	Ctx      context.Context
+	Override *dbapi.OrganisationQuotaOverride
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Override *dbapi.OrganisationQuotaOverride
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *QuotaOverrideServiceMock) Delete(ctx context.Context, orgID string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("QuotaOverrideServiceMock.DeleteFunc: method is nil but QuotaOverrideService.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		OrgID string
+	}{
+		Ctx:   ctx,
+		OrgID: orgID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, orgID)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedQuotaOverrideService.DeleteCalls())
+func (mock *QuotaOverrideServiceMock) DeleteCalls() []struct {
+	Ctx   context.Context
+	OrgID string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		OrgID string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *QuotaOverrideServiceMock) Get(orgID string) (*dbapi.OrganisationQuotaOverride, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("QuotaOverrideServiceMock.GetFunc: method is nil but QuotaOverrideService.Get was just called")
+	}
+	callInfo := struct {
+		OrgID string
+	}{
+		OrgID: orgID,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(orgID)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedQuotaOverrideService.GetCalls())
+func (mock *QuotaOverrideServiceMock) GetCalls() []struct {
+	OrgID string
+} {
+	var calls []struct {
+		OrgID string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *QuotaOverrideServiceMock) List() (dbapi.OrganisationQuotaOverrideList, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("QuotaOverrideServiceMock.ListFunc: method is nil but QuotaOverrideService.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedQuotaOverrideService.ListCalls())
+func (mock *QuotaOverrideServiceMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListChanges calls ListChangesFunc.
+func (mock *QuotaOverrideServiceMock) ListChanges(orgID string) (dbapi.OrganisationQuotaOverrideChangeList, *serviceError.ServiceError) {
+	if mock.ListChangesFunc == nil {
+		panic("QuotaOverrideServiceMock.ListChangesFunc: method is nil but QuotaOverrideService.ListChanges was just called")
+	}
+	callInfo := struct {
+		OrgID string
+	}{
+		OrgID: orgID,
+	}
+	mock.lockListChanges.Lock()
+	mock.calls.ListChanges = append(mock.calls.ListChanges, callInfo)
+	mock.lockListChanges.Unlock()
+	return mock.ListChangesFunc(orgID)
+}
+
+// ListChangesCalls gets all the calls that were made to ListChanges.
+// Check the length with:
+//
+//	len(mockedQuotaOverrideService.ListChangesCalls())
+func (mock *QuotaOverrideServiceMock) ListChangesCalls() []struct {
+	OrgID string
+} {
+	var calls []struct {
+		OrgID string
+	}
+	mock.lockListChanges.RLock()
+	calls = mock.calls.ListChanges
+	mock.lockListChanges.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *QuotaOverrideServiceMock) Update(ctx context.Context, orgID string, maxAllowedInstances int) (*dbapi.OrganisationQuotaOverride, *serviceError.ServiceError) {
+	if mock.UpdateFunc == nil {
+		panic("QuotaOverrideServiceMock.UpdateFunc: method is nil but QuotaOverrideService.Update was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		OrgID               string
+		MaxAllowedInstances int
+	}{
+		Ctx:                 ctx,
+		OrgID:               orgID,
+		MaxAllowedInstances: maxAllowedInstances,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, orgID, maxAllowedInstances)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedQuotaOverrideService.UpdateCalls())
+func (mock *QuotaOverrideServiceMock) UpdateCalls() []struct {
+	Ctx                 context.Context
+	OrgID               string
+	MaxAllowedInstances int
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		OrgID               string
+		MaxAllowedInstances int
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
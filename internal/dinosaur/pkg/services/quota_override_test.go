@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const quotaOverrideSelectQuery = `SELECT * FROM "organisation_quota_overrides" WHERE organisation_id = $1`
+
+func newAdminContext() context.Context {
+	return auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: jwt.MapClaims{"username": "admin"}})
+}
+
+func mockQuotaOverride(maxAllowedInstances int) {
+	mocket.Catcher.NewMock().
+		WithQuery(quotaOverrideSelectQuery).
+		WithReply([]map[string]interface{}{{"organisation_id": "org-id", "max_allowed_instances": maxAllowedInstances}})
+}
+
+// mockQuotaOverrideChangeInsert captures the values of the inserted audit history entry.
+func mockQuotaOverrideChangeInsert() (*mocket.FakeResponse, *[]interface{}) {
+	var args []interface{}
+	changeInsert := mocket.Catcher.NewMock().
+		WithQuery(`INSERT INTO "organisation_quota_override_changes"`).
+		WithCallback(func(_ string, namedValues []driver.NamedValue) {
+			args = nil
+			for _, namedValue := range namedValues {
+				args = append(args, namedValue.Value)
+			}
+		})
+	return changeInsert, &args
+}
+
+func TestQuotaOverrideService_Create(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxAllowedInstances int
+		existing            bool
+		wantErrCode         errors.ServiceErrorCode
+	}{
+		{
+			name:                "should create an override and record the change",
+			maxAllowedInstances: 3,
+		},
+		{
+			name:                "should fail when the organisation already has an override",
+			maxAllowedInstances: 3,
+			existing:            true,
+			wantErrCode:         errors.ErrorConflict,
+		},
+		{
+			name:                "should fail when the max allowed instances are lower than one",
+			maxAllowedInstances: 0,
+			wantErrCode:         errors.ErrorBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			if tt.existing {
+				mockQuotaOverride(1)
+			}
+			overrideInsert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "organisation_quota_overrides"`)
+			changeInsert, changeArgs := mockQuotaOverrideChangeInsert()
+			s := NewQuotaOverrideService(db.NewMockConnectionFactory(nil))
+
+			err := s.Create(newAdminContext(), &dbapi.OrganisationQuotaOverride{
+				OrganisationID:      "org-id",
+				MaxAllowedInstances: tt.maxAllowedInstances,
+			})
+			if tt.wantErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantErrCode, err.Code)
+				assert.False(t, overrideInsert.Triggered)
+				return
+			}
+			require.Nil(t, err)
+			assert.True(t, overrideInsert.Triggered)
+			assert.True(t, changeInsert.Triggered)
+			assert.Equal(t, []interface{}{"org-id", "created", int64(tt.maxAllowedInstances), int64(0), "admin"}, (*changeArgs)[1:])
+		})
+	}
+}
+
+func TestQuotaOverrideService_Update(t *testing.T) {
+	mocket.Catcher.Reset()
+	mockQuotaOverride(2)
+	overrideUpdate := mocket.Catcher.NewMock().WithQuery(`UPDATE "organisation_quota_overrides" SET "max_allowed_instances"=$1`)
+	changeInsert, changeArgs := mockQuotaOverrideChangeInsert()
+	s := NewQuotaOverrideService(db.NewMockConnectionFactory(nil))
+
+	override, err := s.Update(newAdminContext(), "org-id", 5)
+	require.Nil(t, err)
+	assert.Equal(t, 5, override.MaxAllowedInstances)
+	assert.True(t, overrideUpdate.Triggered)
+	assert.True(t, changeInsert.Triggered)
+	assert.Equal(t, []interface{}{"org-id", "updated", int64(5), int64(2), "admin"}, (*changeArgs)[1:])
+}
+
+func TestQuotaOverrideService_DeleteNotFound(t *testing.T) {
+	mocket.Catcher.Reset()
+	overrideDelete := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "organisation_quota_overrides"`)
+	s := NewQuotaOverrideService(db.NewMockConnectionFactory(nil))
+
+	err := s.Delete(newAdminContext(), "org-id")
+	require.NotNil(t, err)
+	assert.True(t, err.Is404())
+	assert.False(t, overrideDelete.Triggered)
+}
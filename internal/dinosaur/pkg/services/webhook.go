@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// webhookSecretMinLength is the minimum length of a user provided signing secret.
+const webhookSecretMinLength = 16
+
+// nonPublicWebhookNetworks are the networks webhook notifications must not be sent to in addition to the loopback,
+// private, link-local, multicast and unspecified addresses recognised by net.IP.
+var nonPublicWebhookNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"), // carrier-grade NAT
+	mustParseCIDR("192.0.0.0/24"),  // IETF protocol assignments
+	mustParseCIDR("198.18.0.0/15"), // benchmarking
+	mustParseCIDR("240.0.0.0/4"),   // reserved
+}
+
+// WebhookService manages the webhook subscriptions of organisations and their pending deliveries.
+//
+// Deliveries are enqueued by a trigger on the central_requests table when a Central becomes ready, fails, is
+// deprovisioned or deleted. The subscription methods are scoped to the organisation of the user in the context,
+// the delivery methods are used by the webhook delivery worker.
+//
+//go:generate moq -out webhook_moq.go . WebhookService
+type WebhookService interface {
+	// Create creates a subscription for the organisation of the user in the context. A signing secret is
+	// generated if the subscription does not have one.
+	Create(ctx context.Context, subscription *dbapi.WebhookSubscription) *errors.ServiceError
+	// Get returns a subscription of the organisation of the user in the context.
+	Get(ctx context.Context, id string) (*dbapi.WebhookSubscription, *errors.ServiceError)
+	// List returns a page of the subscriptions of the organisation of the user in the context.
+	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.WebhookSubscriptionList, *api.PagingMeta, *errors.ServiceError)
+	// Delete removes a subscription of the organisation of the user in the context and its pending deliveries.
+	Delete(ctx context.Context, id string) *errors.ServiceError
+
+	// GetSubscription returns a subscription regardless of the organisation.
+	GetSubscription(id string) (*dbapi.WebhookSubscription, *errors.ServiceError)
+	// ListDueDeliveries returns up to limit deliveries whose next attempt is due, oldest first.
+	ListDueDeliveries(limit int) (dbapi.WebhookDeliveryList, *errors.ServiceError)
+	// CountPendingDeliveries returns the number of deliveries that were not delivered yet.
+	CountPendingDeliveries() (int64, *errors.ServiceError)
+	// CompleteDelivery removes a delivery that was accepted by the receiver.
+	CompleteDelivery(delivery *dbapi.WebhookDelivery) *errors.ServiceError
+	// RetryDelivery records a failed attempt of a delivery and schedules the next one.
+	RetryDelivery(delivery *dbapi.WebhookDelivery, lastError string, nextAttemptAt time.Time) *errors.ServiceError
+	// DeadLetterDelivery records the last failed attempt of a delivery and moves it to the dead letters.
+	DeadLetterDelivery(delivery *dbapi.WebhookDelivery, lastError string) *errors.ServiceError
+}
+
+type webhookService struct {
+	connectionFactory *db.ConnectionFactory
+	webhookConfig     *config.WebhookConfig
+	lookupIPAddr      func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+var _ WebhookService = &webhookService{}
+
+// NewWebhookService ...
+func NewWebhookService(connectionFactory *db.ConnectionFactory, webhookConfig *config.WebhookConfig) WebhookService {
+	return &webhookService{
+		connectionFactory: connectionFactory,
+		webhookConfig:     webhookConfig,
+		lookupIPAddr:      net.DefaultResolver.LookupIPAddr,
+	}
+}
+
+// Create ...
+func (s *webhookService) Create(ctx context.Context, subscription *dbapi.WebhookSubscription) *errors.ServiceError {
+	orgID, svcErr := webhookOrgID(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+	if svcErr := s.validateSubscription(ctx, subscription); svcErr != nil {
+		return svcErr
+	}
+	if subscription.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to generate webhook secret")
+		}
+		subscription.Secret = secret
+	}
+	subscription.ID = api.NewID()
+	subscription.OrganisationID = orgID
+	subscription.Owner = changedBy(ctx)
+
+	if err := s.connectionFactory.New().Create(subscription).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create webhook subscription")
+	}
+
+	logger.NewUHCLogger(ctx).Infof("%s created webhook subscription %s of organisation %s for %q",
+		subscription.Owner, subscription.ID, orgID, subscription.URL)
+	return nil
+}
+
+// Get ...
+func (s *webhookService) Get(ctx context.Context, id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+	orgID, svcErr := webhookOrgID(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	var subscription dbapi.WebhookSubscription
+	if err := s.connectionFactory.New().
+		Where("id = ?", id).
+		Where("organisation_id = ?", orgID).
+		First(&subscription).Error; err != nil {
+		return nil, services.HandleGetError("WebhookSubscription", "id", id, err)
+	}
+	return &subscription, nil
+}
+
+// List ...
+func (s *webhookService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.WebhookSubscriptionList, *api.PagingMeta, *errors.ServiceError) {
+	orgID, svcErr := webhookOrgID(ctx)
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+	var subscriptions dbapi.WebhookSubscriptionList
+	dbConn := s.connectionFactory.New().Where("organisation_id = ?", orgID)
+
+	pagingMeta, svcErr := services.ListPage(dbConn, listArgs, []string{"created_at"}, &subscriptions)
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
+	return subscriptions, pagingMeta, nil
+}
+
+// Delete ...
+func (s *webhookService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	subscription, svcErr := s.Get(ctx, id)
+	if svcErr != nil {
+		return svcErr
+	}
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&dbapi.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(subscription).Error
+	}); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete webhook subscription %s", id)
+	}
+
+	logger.NewUHCLogger(ctx).Infof("%s deleted webhook subscription %s of organisation %s",
+		changedBy(ctx), id, subscription.OrganisationID)
+	return nil
+}
+
+// GetSubscription ...
+func (s *webhookService) GetSubscription(id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+	var subscription dbapi.WebhookSubscription
+	if err := s.connectionFactory.New().
+		Where("id = ?", id).
+		First(&subscription).Error; err != nil {
+		return nil, services.HandleGetError("WebhookSubscription", "id", id, err)
+	}
+	return &subscription, nil
+}
+
+// ListDueDeliveries ...
+func (s *webhookService) ListDueDeliveries(limit int) (dbapi.WebhookDeliveryList, *errors.ServiceError) {
+	var deliveries dbapi.WebhookDeliveryList
+	if err := s.connectionFactory.New().
+		Where("next_attempt_at <= ?", time.Now()).
+		Order("id").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list due webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// CountPendingDeliveries ...
+func (s *webhookService) CountPendingDeliveries() (int64, *errors.ServiceError) {
+	var count int64
+	if err := s.connectionFactory.New().Model(&dbapi.WebhookDelivery{}).Count(&count).Error; err != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count pending webhook deliveries")
+	}
+	return count, nil
+}
+
+// CompleteDelivery ...
+func (s *webhookService) CompleteDelivery(delivery *dbapi.WebhookDelivery) *errors.ServiceError {
+	if err := s.connectionFactory.New().Delete(delivery).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete webhook delivery %d", delivery.ID)
+	}
+	return nil
+}
+
+// RetryDelivery ...
+func (s *webhookService) RetryDelivery(delivery *dbapi.WebhookDelivery, lastError string, nextAttemptAt time.Time) *errors.ServiceError {
+	if err := s.connectionFactory.New().Model(delivery).Updates(map[string]interface{}{
+		"attempts":        delivery.Attempts + 1,
+		"last_error":      lastError,
+		"next_attempt_at": nextAttemptAt,
+	}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to schedule retry of webhook delivery %d", delivery.ID)
+	}
+	return nil
+}
+
+// DeadLetterDelivery ...
+func (s *webhookService) DeadLetterDelivery(delivery *dbapi.WebhookDelivery, lastError string) *errors.ServiceError {
+	deadLetter := &dbapi.WebhookDeadLetter{
+		ID:             delivery.ID,
+		EnqueuedAt:     delivery.CreatedAt,
+		SubscriptionID: delivery.SubscriptionID,
+		EventType:      delivery.EventType,
+		CentralID:      delivery.CentralID,
+		Payload:        delivery.Payload,
+		Attempts:       delivery.Attempts + 1,
+		LastError:      lastError,
+	}
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(deadLetter).Error; err != nil {
+			return err
+		}
+		return tx.Delete(delivery).Error
+	}); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to move webhook delivery %d to the dead letters", delivery.ID)
+	}
+	return nil
+}
+
+func (s *webhookService) validateSubscription(ctx context.Context, subscription *dbapi.WebhookSubscription) *errors.ServiceError {
+	u, err := url.Parse(subscription.URL)
+	if err != nil || u.Host == "" {
+		return errors.BadRequest("invalid webhook URL %q", subscription.URL)
+	}
+	if u.Scheme != "https" && !(u.Scheme == "http" && s.webhookConfig.AllowInsecureURLs) {
+		return errors.BadRequest("webhook URL %q must use https", subscription.URL)
+	}
+	if !s.webhookConfig.AllowPrivateNetworks {
+		// the delivery worker checks the address again when connecting, in case the DNS records change
+		addrs, err := s.lookupIPAddr(ctx, u.Hostname())
+		if err != nil {
+			return errors.BadRequest("unable to resolve the host of webhook URL %q", subscription.URL)
+		}
+		for _, addr := range addrs {
+			if !IsPublicWebhookIP(addr.IP) {
+				return errors.BadRequest("webhook URL %q must not resolve to a non-public address", subscription.URL)
+			}
+		}
+	}
+	if subscription.Secret != "" && len(subscription.Secret) < webhookSecretMinLength {
+		return errors.BadRequest("webhook secret must have at least %d characters", webhookSecretMinLength)
+	}
+	if subscription.EventTypes == "" {
+		return nil
+	}
+	for _, eventType := range strings.Split(subscription.EventTypes, ",") {
+		if !isWebhookEventType(eventType) {
+			return errors.BadRequest("unsupported webhook event type %q, supported event types are %v",
+				eventType, dinosaurConstants.WebhookEventTypes)
+		}
+	}
+	return nil
+}
+
+func isWebhookEventType(eventType string) bool {
+	for _, t := range dinosaurConstants.WebhookEventTypes {
+		if t.String() == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookOrgID returns the organisation of the user in the context. Subscriptions belong to organisations,
+// so users without an organisation cannot manage them.
+func webhookOrgID(ctx context.Context) (string, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	orgID, _ := claims.GetOrgID()
+	if orgID == "" {
+		return "", errors.Unauthenticated("user is not part of an organisation")
+	}
+	return orgID, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 signature of a webhook notification. The signed message is
+// the unix timestamp of the delivery and the payload joined by a dot, so that receivers can reject replayed
+// notifications.
+func SignWebhookPayload(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsPublicWebhookIP returns true if webhook notifications may be sent to the IP address. Notifications are only sent
+// to public addresses, so that subscriptions cannot reach fleet manager's own network.
+func IsPublicWebhookIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, network := range nonPublicWebhookNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
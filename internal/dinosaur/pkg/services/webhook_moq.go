@@ -0,0 +1,511 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"sync"
+	"time"
+)
+
+// Ensure, that WebhookServiceMock does implement WebhookService.
+// If this is not the case, regenerate this file with moq.
+var _ WebhookService = &WebhookServiceMock{}
+
+// WebhookServiceMock is a mock implementation of WebhookService.
+//
+//	func TestSomethingThatUsesWebhookService(t *testing.T) {
+//
+//		// make and configure a mocked WebhookService
+//		mockedWebhookService := &WebhookServiceMock{
+//			CompleteDeliveryFunc: func(delivery *dbapi.WebhookDelivery) *serviceError.ServiceError {
+//				panic("mock out the CompleteDelivery method")
+//			},
+//			CountPendingDeliveriesFunc: func() (int64, *serviceError.ServiceError) {
+//				panic("mock out the CountPendingDeliveries method")
+//			},
+//			CreateFunc: func(ctx context.Context, subscription *dbapi.WebhookSubscription) *serviceError.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			DeadLetterDeliveryFunc: func(delivery *dbapi.WebhookDelivery, lastError string) *serviceError.ServiceError {
+//				panic("mock out the DeadLetterDelivery method")
+//			},
+//			DeleteFunc: func(ctx context.Context, id string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(ctx context.Context, id string) (*dbapi.WebhookSubscription, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			GetSubscriptionFunc: func(id string) (*dbapi.WebhookSubscription, *serviceError.ServiceError) {
+//				panic("mock out the GetSubscription method")
+//			},
+//			ListFunc: func(ctx context.Context, listArgs *services.ListArguments) (dbapi.WebhookSubscriptionList, *api.PagingMeta, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListDueDeliveriesFunc: func(limit int) (dbapi.WebhookDeliveryList, *serviceError.ServiceError) {
+//				panic("mock out the ListDueDeliveries method")
+//			},
+//			RetryDeliveryFunc: func(delivery *dbapi.WebhookDelivery, lastError string, nextAttemptAt time.Time) *serviceError.ServiceError {
+//				panic("mock out the RetryDelivery method")
+//			},
+//		}
+//
+//		// use mockedWebhookService in code that requires WebhookService
+//		// and then make assertions.
+//
+//	}
+type WebhookServiceMock struct {
+	// CompleteDeliveryFunc mocks the CompleteDelivery method.
+	CompleteDeliveryFunc func(delivery *dbapi.WebhookDelivery) *serviceError.ServiceError
+
+	// CountPendingDeliveriesFunc mocks the CountPendingDeliveries method.
+	CountPendingDeliveriesFunc func() (int64, *serviceError.ServiceError)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, subscription *dbapi.WebhookSubscription) *serviceError.ServiceError
+
+	// DeadLetterDeliveryFunc mocks the DeadLetterDelivery method.
+	DeadLetterDeliveryFunc func(delivery *dbapi.WebhookDelivery, lastError string) *serviceError.ServiceError
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*dbapi.WebhookSubscription, *serviceError.ServiceError)
+
+	// GetSubscriptionFunc mocks the GetSubscription method.
+	GetSubscriptionFunc func(id string) (*dbapi.WebhookSubscription, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, listArgs *services.ListArguments) (dbapi.WebhookSubscriptionList, *api.PagingMeta, *serviceError.ServiceError)
+
+	// ListDueDeliveriesFunc mocks the ListDueDeliveries method.
+	ListDueDeliveriesFunc func(limit int) (dbapi.WebhookDeliveryList, *serviceError.ServiceError)
+
+	// RetryDeliveryFunc mocks the RetryDelivery method.
+	RetryDeliveryFunc func(delivery *dbapi.WebhookDelivery, lastError string, nextAttemptAt time.Time) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CompleteDelivery holds details about calls to the CompleteDelivery method.
+		CompleteDelivery []struct {
+			// Delivery is the delivery argument value.
+			Delivery *dbapi.WebhookDelivery
+		}
+		// CountPendingDeliveries holds details about calls to the CountPendingDeliveries method.
+		CountPendingDeliveries []struct {
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Subscription is the subscription argument value.
+			Subscription *dbapi.WebhookSubscription
+		}
+		// DeadLetterDelivery holds details about calls to the DeadLetterDelivery method.
+		DeadLetterDelivery []struct {
+			// Delivery is the delivery argument value.
+			Delivery *dbapi.WebhookDelivery
+			// LastError is the lastError argument value.
+			LastError string
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetSubscription holds details about calls to the GetSubscription method.
+		GetSubscription []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
+		}
+		// ListDueDeliveries holds details about calls to the ListDueDeliveries method.
+		ListDueDeliveries []struct {
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// RetryDelivery holds details about calls to the RetryDelivery method.
+		RetryDelivery []struct {
+			// Delivery is the delivery argument value.
+			Delivery *dbapi.WebhookDelivery
+			// LastError is the lastError argument value.
+			LastError string
+			// NextAttemptAt is the nextAttemptAt argument value.
+			NextAttemptAt time.Time
+		}
+	}
+	lockCompleteDelivery       sync.RWMutex
+	lockCountPendingDeliveries sync.RWMutex
+	lockCreate                 sync.RWMutex
+	lockDeadLetterDelivery     sync.RWMutex
+	lockDelete                 sync.RWMutex
+	lockGet                    sync.RWMutex
+	lockGetSubscription        sync.RWMutex
+	lockList                   sync.RWMutex
+	lockListDueDeliveries      sync.RWMutex
+	lockRetryDelivery          sync.RWMutex
+}
+
+// CompleteDelivery calls CompleteDeliveryFunc.
+func (mock *WebhookServiceMock) CompleteDelivery(delivery *dbapi.WebhookDelivery) *serviceError.ServiceError {
+	if mock.CompleteDeliveryFunc == nil {
+		panic("WebhookServiceMock.CompleteDeliveryFunc: method is nil but WebhookService.CompleteDelivery was just called")
+	}
+	callInfo := struct {
+		Delivery *dbapi.WebhookDelivery
+	}{
+		Delivery: delivery,
+	}
+	mock.lockCompleteDelivery.Lock()
+	mock.calls.CompleteDelivery = append(mock.calls.CompleteDelivery, callInfo)
+	mock.lockCompleteDelivery.Unlock()
+	return mock.CompleteDeliveryFunc(delivery)
+}
+
+// CompleteDeliveryCalls gets all the calls that were made to CompleteDelivery.
+// Check the length with:
+//
+//	len(mockedWebhookService.CompleteDeliveryCalls())
+func (mock *WebhookServiceMock) CompleteDeliveryCalls() []struct {
+	Delivery *dbapi.WebhookDelivery
+} {
+	var calls []struct {
+		Delivery *dbapi.WebhookDelivery
+	}
+	mock.lockCompleteDelivery.RLock()
+	calls = mock.calls.CompleteDelivery
+	mock.lockCompleteDelivery.RUnlock()
+	return calls
+}
+
+// CountPendingDeliveries calls CountPendingDeliveriesFunc.
+func (mock *WebhookServiceMock) CountPendingDeliveries() (int64, *serviceError.ServiceError) {
+	if mock.CountPendingDeliveriesFunc == nil {
+		panic("WebhookServiceMock.CountPendingDeliveriesFunc: method is nil but WebhookService.CountPendingDeliveries was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockCountPendingDeliveries.Lock()
+	mock.calls.CountPendingDeliveries = append(mock.calls.CountPendingDeliveries, callInfo)
+	mock.lockCountPendingDeliveries.Unlock()
+	return mock.CountPendingDeliveriesFunc()
+}
+
+// CountPendingDeliveriesCalls gets all the calls that were made to CountPendingDeliveries.
+// Check the length with:
+//
+//	len(mockedWebhookService.CountPendingDeliveriesCalls())
+func (mock *WebhookServiceMock) CountPendingDeliveriesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockCountPendingDeliveries.RLock()
+	calls = mock.calls.CountPendingDeliveries
+	mock.lockCountPendingDeliveries.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *WebhookServiceMock) Create(ctx context.Context, subscription *dbapi.WebhookSubscription) *serviceError.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("WebhookServiceMock.CreateFunc: method is nil but WebhookService.Create was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Subscription *dbapi.WebhookSubscription
+	}{
+		Ctx:          ctx,
+		Subscription: subscription,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, subscription)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedWebhookService.CreateCalls())
+func (mock *WebhookServiceMock) CreateCalls() []struct {
+	Ctx          context.Context
+	Subscription *dbapi.WebhookSubscription
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Subscription *dbapi.WebhookSubscription
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// DeadLetterDelivery calls DeadLetterDeliveryFunc.
+func (mock *WebhookServiceMock) DeadLetterDelivery(delivery *dbapi.WebhookDelivery, lastError string) *serviceError.ServiceError {
+	if mock.DeadLetterDeliveryFunc == nil {
+		panic("WebhookServiceMock.DeadLetterDeliveryFunc: method is nil but WebhookService.DeadLetterDelivery was just called")
+	}
+	callInfo := struct {
+		Delivery  *dbapi.WebhookDelivery
+		LastError string
+	}{
+		Delivery:  delivery,
+		LastError: lastError,
+	}
+	mock.lockDeadLetterDelivery.Lock()
+	mock.calls.DeadLetterDelivery = append(mock.calls.DeadLetterDelivery, callInfo)
+	mock.lockDeadLetterDelivery.Unlock()
+	return mock.DeadLetterDeliveryFunc(delivery, lastError)
+}
+
+// DeadLetterDeliveryCalls gets all the calls that were made to DeadLetterDelivery.
+// Check the length with:
+//
+//	len(mockedWebhookService.DeadLetterDeliveryCalls())
+func (mock *WebhookServiceMock) DeadLetterDeliveryCalls() []struct {
+	Delivery  *dbapi.WebhookDelivery
+	LastError string
+} {
+	var calls []struct {
+		Delivery  *dbapi.WebhookDelivery
+		LastError string
+	}
+	mock.lockDeadLetterDelivery.RLock()
+	calls = mock.calls.DeadLetterDelivery
+	mock.lockDeadLetterDelivery.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *WebhookServiceMock) Delete(ctx context.Context, id string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("WebhookServiceMock.DeleteFunc: method is nil but WebhookService.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedWebhookService.DeleteCalls())
+func (mock *WebhookServiceMock) DeleteCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *WebhookServiceMock) Get(ctx context.Context, id string) (*dbapi.WebhookSubscription, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("WebhookServiceMock.GetFunc: method is nil but WebhookService.Get was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedWebhookService.GetCalls())
+func (mock *WebhookServiceMock) GetCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetSubscription calls GetSubscriptionFunc.
+func (mock *WebhookServiceMock) GetSubscription(id string) (*dbapi.WebhookSubscription, *serviceError.ServiceError) {
+	if mock.GetSubscriptionFunc == nil {
+		panic("WebhookServiceMock.GetSubscriptionFunc: method is nil but WebhookService.GetSubscription was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockGetSubscription.Lock()
+	mock.calls.GetSubscription = append(mock.calls.GetSubscription, callInfo)
+	mock.lockGetSubscription.Unlock()
+	return mock.GetSubscriptionFunc(id)
+}
+
+// GetSubscriptionCalls gets all the calls that were made to GetSubscription.
+// Check the length with:
+//
+//	len(mockedWebhookService.GetSubscriptionCalls())
+func (mock *WebhookServiceMock) GetSubscriptionCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockGetSubscription.RLock()
+	calls = mock.calls.GetSubscription
+	mock.lockGetSubscription.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *WebhookServiceMock) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.WebhookSubscriptionList, *api.PagingMeta, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("WebhookServiceMock.ListFunc: method is nil but WebhookService.List was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ListArgs *services.ListArguments
+	}{
+		Ctx:      ctx,
+		ListArgs: listArgs,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, listArgs)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedWebhookService.ListCalls())
+func (mock *WebhookServiceMock) ListCalls() []struct {
+	Ctx      context.Context
+	ListArgs *services.ListArguments
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ListArgs *services.ListArguments
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListDueDeliveries calls ListDueDeliveriesFunc.
+func (mock *WebhookServiceMock) ListDueDeliveries(limit int) (dbapi.WebhookDeliveryList, *serviceError.ServiceError) {
+	if mock.ListDueDeliveriesFunc == nil {
+		panic("WebhookServiceMock.ListDueDeliveriesFunc: method is nil but WebhookService.ListDueDeliveries was just called")
+	}
+	callInfo := struct {
+		Limit int
+	}{
+		Limit: limit,
+	}
+	mock.lockListDueDeliveries.Lock()
+	mock.calls.ListDueDeliveries = append(mock.calls.ListDueDeliveries, callInfo)
+	mock.lockListDueDeliveries.Unlock()
+	return mock.ListDueDeliveriesFunc(limit)
+}
+
+// ListDueDeliveriesCalls gets all the calls that were made to ListDueDeliveries.
+// Check the length with:
+//
+//	len(mockedWebhookService.ListDueDeliveriesCalls())
+func (mock *WebhookServiceMock) ListDueDeliveriesCalls() []struct {
+	Limit int
+} {
+	var calls []struct {
+		Limit int
+	}
+	mock.lockListDueDeliveries.RLock()
+	calls = mock.calls.ListDueDeliveries
+	mock.lockListDueDeliveries.RUnlock()
+	return calls
+}
+
+// RetryDelivery calls RetryDeliveryFunc.
+func (mock *WebhookServiceMock) RetryDelivery(delivery *dbapi.WebhookDelivery, lastError string, nextAttemptAt time.Time) *serviceError.ServiceError {
+	if mock.RetryDeliveryFunc == nil {
+		panic("WebhookServiceMock.RetryDeliveryFunc: method is nil but WebhookService.RetryDelivery was just called")
+	}
+	callInfo := struct {
+		Delivery      *dbapi.WebhookDelivery
+		LastError     string
+		NextAttemptAt time.Time
+	}{
+		Delivery:      delivery,
+		LastError:     lastError,
+		NextAttemptAt: nextAttemptAt,
+	}
+	mock.lockRetryDelivery.Lock()
+	mock.calls.RetryDelivery = append(mock.calls.RetryDelivery, callInfo)
+	mock.lockRetryDelivery.Unlock()
+	return mock.RetryDeliveryFunc(delivery, lastError, nextAttemptAt)
+}
+
+// RetryDeliveryCalls gets all the calls that were made to RetryDelivery.
+// Check the length with:
+//
+//	len(mockedWebhookService.RetryDeliveryCalls())
+func (mock *WebhookServiceMock) RetryDeliveryCalls() []struct {
+	Delivery      *dbapi.WebhookDelivery
+	LastError     string
+	NextAttemptAt time.Time
+} {
+	var calls []struct {
+		Delivery      *dbapi.WebhookDelivery
+		LastError     string
+		NextAttemptAt time.Time
+	}
+	mock.lockRetryDelivery.RLock()
+	calls = mock.calls.RetryDelivery
+	mock.lockRetryDelivery.RUnlock()
+	return calls
+}
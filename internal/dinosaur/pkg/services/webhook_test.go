@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrgContext() context.Context {
+	return auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: jwt.MapClaims{"username": "user", "org_id": "org-id"}})
+}
+
+func lookupTestIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	addrs := map[string]string{
+		"example.com":          "93.184.216.34",
+		"internal.example.com": "10.0.0.1",
+		"localhost":            "127.0.0.1",
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IPAddr{{IP: ip}}, nil
+	}
+	if addr, ok := addrs[host]; ok {
+		return []net.IPAddr{{IP: net.ParseIP(addr)}}, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		subscription dbapi.WebhookSubscription
+		allowHTTP    bool
+		allowPrivate bool
+		wantErr      bool
+	}{
+		{
+			name:         "should create subscription for all event types",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://example.com/hook"},
+		},
+		{
+			name:         "should create subscription for selected event types",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://example.com/hook", EventTypes: "central.ready,central.failed"},
+		},
+		{
+			name:         "should reject unknown event types",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://example.com/hook", EventTypes: "central.ready,central.updated"},
+			wantErr:      true,
+		},
+		{
+			name:         "should reject http URLs",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "http://localhost:8080/hook"},
+			wantErr:      true,
+		},
+		{
+			name:         "should reject URLs resolving to private addresses",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://internal.example.com/hook"},
+			wantErr:      true,
+		},
+		{
+			name:         "should reject private IP addresses",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://169.254.169.254/latest/meta-data"},
+			wantErr:      true,
+		},
+		{
+			name:         "should reject URLs which cannot be resolved",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://unknown.example.com/hook"},
+			wantErr:      true,
+		},
+		{
+			name:         "should reject local URLs if private networks are not allowed",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "http://localhost:8080/hook"},
+			allowHTTP:    true,
+			wantErr:      true,
+		},
+		{
+			name:         "should accept local http URLs if insecure URLs and private networks are allowed",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "http://localhost:8080/hook"},
+			allowHTTP:    true,
+			allowPrivate: true,
+		},
+		{
+			name:         "should reject invalid URLs",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "example.com"},
+			wantErr:      true,
+		},
+		{
+			name:         "should reject short secrets",
+			ctx:          newOrgContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://example.com/hook", Secret: "secret"},
+			wantErr:      true,
+		},
+		{
+			name:         "should reject users without organisation",
+			ctx:          newAdminContext(),
+			subscription: dbapi.WebhookSubscription{URL: "https://example.com/hook"},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "webhook_subscriptions"`)
+			webhookConfig := config.NewWebhookConfig()
+			webhookConfig.AllowInsecureURLs = tt.allowHTTP
+			webhookConfig.AllowPrivateNetworks = tt.allowPrivate
+			s := &webhookService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				webhookConfig:     webhookConfig,
+				lookupIPAddr:      lookupTestIPAddr,
+			}
+
+			subscription := tt.subscription
+			svcErr := s.Create(tt.ctx, &subscription)
+			if tt.wantErr {
+				require.NotNil(t, svcErr)
+				assert.False(t, insert.Triggered)
+				return
+			}
+			require.Nil(t, svcErr)
+			assert.True(t, insert.Triggered)
+			assert.NotEmpty(t, subscription.ID)
+			assert.Equal(t, "org-id", subscription.OrganisationID)
+			assert.Equal(t, "user", subscription.Owner)
+			assert.Len(t, subscription.Secret, 64)
+		})
+	}
+}
+
+func TestWebhookService_DeadLetterDelivery(t *testing.T) {
+	mocket.Catcher.Reset()
+	deadLetterInsert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "webhook_dead_letters"`)
+	deliveryDelete := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "webhook_deliveries" WHERE "webhook_deliveries"."id" = $1`)
+	s := NewWebhookService(db.NewMockConnectionFactory(nil), config.NewWebhookConfig())
+
+	svcErr := s.DeadLetterDelivery(&dbapi.WebhookDelivery{ID: 1, Attempts: 7}, "connection refused")
+	require.Nil(t, svcErr)
+	assert.True(t, deadLetterInsert.Triggered)
+	assert.True(t, deliveryDelete.Triggered)
+}
+
+func TestIsPublicWebhookIP(t *testing.T) {
+	for ip, want := range map[string]bool{
+		"93.184.216.34":        true,
+		"2606:2800:220:1::248": true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"fd00::1":              false,
+		"fe80::1":              false,
+		"::ffff:127.0.0.1":     false,
+	} {
+		assert.Equal(t, want, IsPublicWebhookIP(net.ParseIP(ip)), ip)
+	}
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	// echo -n '1683590400.{"type":"central.ready"}' | openssl dgst -sha256 -hmac 0123456789abcdef
+	signature := SignWebhookPayload("0123456789abcdef", "1683590400", []byte(`{"type":"central.ready"}`))
+	assert.Equal(t, "da4ecbc4e63d0d705f46c2437a0c8d59a02ce0debebaf80d613506771abdb1ab", signature)
+	assert.NotEqual(t, signature, SignWebhookPayload("0123456789abcdef", "1683590401", []byte(`{"type":"central.ready"}`)))
+}
@@ -191,7 +191,9 @@ func (c *ClusterManager) processMetrics() []error {
 		return []error{errors.Wrapf(err, "failed to set central per cluster count metrics")}
 	}
 
-	c.setClusterStatusMaxCapacityMetrics()
+	if err := c.setClusterStatusMaxCapacityMetrics(); err != nil {
+		return []error{errors.Wrapf(err, "failed to set cluster status max capacity metrics")}
+	}
 
 	return []error{}
 }
@@ -370,6 +372,16 @@ func (c *ClusterManager) reconcileDeprovisioningCluster(cluster *api.Cluster) er
 		}
 	}
 
+	// never delete a cluster which still hosts tenants, e.g. while it is being drained
+	nonEmptyCluster, findNonEmptyErr := c.ClusterService.FindNonEmptyClusterByID(cluster.ClusterID)
+	if findNonEmptyErr != nil {
+		return findNonEmptyErr
+	}
+	if nonEmptyCluster != nil {
+		glog.Infof("Deprovisioning of cluster %s is blocked until it is empty.", cluster.ClusterID)
+		return nil
+	}
+
 	deleted, deleteClusterErr := c.ClusterService.Delete(cluster)
 	if deleteClusterErr != nil {
 		return deleteClusterErr
@@ -1038,16 +1050,60 @@ func (c *ClusterManager) buildDinosaurSREClusterRoleBindingResource() *authv1.Cl
 	}
 }
 
-func (c *ClusterManager) setClusterStatusMaxCapacityMetrics() {
-	for _, cluster := range c.DataplaneClusterConfig.ClusterConfig.GetManualClusters() {
-		supportedInstanceTypes := strings.Split(cluster.SupportedInstanceType, ",")
+func (c *ClusterManager) setClusterStatusMaxCapacityMetrics() error {
+	manualClusters := c.DataplaneClusterConfig.ClusterConfig.GetManualClusters()
+	if len(manualClusters) == 0 {
+		return nil
+	}
+
+	counters, err := c.ClusterService.FindDinosaurInstanceCount([]string{})
+	if err != nil {
+		return err
+	}
+	instanceCounts := make(map[string]int, len(counters))
+	for _, counter := range counters {
+		instanceCounts[counter.Clusterid] = counter.Count
+	}
+
+	for _, manualCluster := range manualClusters {
+		capacity := manualCluster.CentralInstanceLimit
+		reportedCapacity, err := c.reportedCentralCapacity(manualCluster.ClusterID, instanceCounts[manualCluster.ClusterID])
+		if err != nil {
+			return err
+		}
+		// The reported capacity can only lower the configured limit. A negative limit means "unlimited".
+		if reportedCapacity >= 0 && (capacity < 0 || reportedCapacity < capacity) {
+			capacity = reportedCapacity
+		}
+
+		supportedInstanceTypes := strings.Split(manualCluster.SupportedInstanceType, ",")
 		for _, instanceType := range supportedInstanceTypes {
 			if instanceType != "" {
-				capacity := float64(cluster.CentralInstanceLimit)
-				metrics.UpdateClusterStatusCapacityMaxCount(cluster.Region, instanceType, cluster.ClusterID, capacity)
+				metrics.UpdateClusterStatusCapacityMaxCount(manualCluster.Region, instanceType, manualCluster.ClusterID, float64(capacity))
 			}
 		}
 	}
+	return nil
+}
+
+// reportedCentralCapacity returns the total number of Centrals the cluster can host according to the
+// capacity last reported by fleetshard, or -1 if the cluster never reported its capacity.
+func (c *ClusterManager) reportedCentralCapacity(clusterID string, instanceCount int) (int, error) {
+	cluster, svcErr := c.ClusterService.FindClusterByID(clusterID)
+	if svcErr != nil {
+		return 0, svcErr
+	}
+	if cluster == nil {
+		return -1, nil
+	}
+	capacity, err := cluster.GetCapacity()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get capacity of cluster %s", clusterID)
+	}
+	if capacity == nil {
+		return -1, nil
+	}
+	return instanceCount + capacity.RemainingCentrals, nil
 }
 
 func (c *ClusterManager) setClusterStatusCountMetrics() error {
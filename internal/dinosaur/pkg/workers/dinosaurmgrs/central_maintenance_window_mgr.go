@@ -0,0 +1,98 @@
+package dinosaurmgrs
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const centralMaintenanceWindowWorkerType = "central_maintenance_window"
+
+// CentralMaintenanceWindowManager rolls out upgrades of Centrals and their ACS operator with a maintenance window
+// to the data plane once the maintenance window has started.
+type CentralMaintenanceWindowManager struct {
+	workers.BaseWorker
+	centralService services.DinosaurService
+	now            func() time.Time
+}
+
+var _ workers.Worker = &CentralMaintenanceWindowManager{}
+
+// NewCentralMaintenanceWindowManager ...
+func NewCentralMaintenanceWindowManager(centralService services.DinosaurService) *CentralMaintenanceWindowManager {
+	metrics.InitReconcilerMetricsForType(centralMaintenanceWindowWorkerType)
+	return &CentralMaintenanceWindowManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: centralMaintenanceWindowWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		centralService: centralService,
+		now:            time.Now,
+	}
+}
+
+// Start ...
+func (k *CentralMaintenanceWindowManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *CentralMaintenanceWindowManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralMaintenanceWindowManager) Reconcile() []error {
+	var errs []error
+
+	centrals, listErr := k.centralService.ListByStatus(
+		dinosaurConstants.CentralRequestStatusProvisioning,
+		dinosaurConstants.CentralRequestStatusReady,
+		dinosaurConstants.CentralRequestStatusFailed,
+	)
+	if listErr != nil {
+		errs = append(errs, errors.Wrap(listErr, "failed to list centrals"))
+	}
+
+	now := k.now()
+	for _, central := range centrals {
+		if err := k.reconcileCentral(central, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func (k *CentralMaintenanceWindowManager) reconcileCentral(central *dbapi.CentralRequest, now time.Time) error {
+	if central.MaintenanceWindowDay == "" || central.IsRolledOut() {
+		return nil
+	}
+	window, err := central.GetMaintenanceWindow()
+	if err != nil {
+		return errors.Wrapf(err, "invalid maintenance window of central %s", central.ID)
+	}
+	if !window.Contains(now) {
+		glog.V(10).Infof("upgrade of central %s to version %s with operator version %s is pending until its maintenance window",
+			central.ID, central.DesiredCentralVersion, central.DesiredCentralOperatorVersion)
+		return nil
+	}
+
+	glog.Infof("rolling out version %s with operator version %s of central %s within its maintenance window",
+		central.DesiredCentralVersion, central.DesiredCentralOperatorVersion, central.ID)
+	if svcErr := k.centralService.Updates(central, map[string]interface{}{
+		"rolled_out_central_version":          central.DesiredCentralVersion,
+		"rolled_out_central_operator_version": central.DesiredCentralOperatorVersion,
+	}); svcErr != nil {
+		return errors.Wrapf(svcErr, "failed to roll out version %s of central %s", central.DesiredCentralVersion, central.ID)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package dinosaurmgrs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const centralMigrationWorkerType = "central_migration"
+
+// CentralMigrationManager switches the CNAME records of migrated Centrals to the routers of the migration target
+// cluster and hands the Centrals over to the target cluster once the records are in sync.
+type CentralMigrationManager struct {
+	workers.BaseWorker
+	migrationService services.CentralMigrationService
+	dinosaurService  services.DinosaurService
+	centralConfig    *config.CentralConfig
+}
+
+var _ workers.Worker = &CentralMigrationManager{}
+
+// NewCentralMigrationManager ...
+func NewCentralMigrationManager(migrationService services.CentralMigrationService, dinosaurService services.DinosaurService, centralConfig *config.CentralConfig) *CentralMigrationManager {
+	metrics.InitReconcilerMetricsForType(centralMigrationWorkerType)
+	return &CentralMigrationManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: centralMigrationWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		migrationService: migrationService,
+		dinosaurService:  dinosaurService,
+		centralConfig:    centralConfig,
+	}
+}
+
+// Start ...
+func (k *CentralMigrationManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *CentralMigrationManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralMigrationManager) Reconcile() []error {
+	var errs []error
+
+	centrals, listErr := k.migrationService.ListByMigrationStatus(dinosaurConstants.CentralMigrationStatusSwitchingRoutes)
+	if listErr != nil {
+		errs = append(errs, errors.Wrap(listErr, "failed to list centrals switching routes"))
+	}
+
+	for _, central := range centrals {
+		if err := k.reconcileSwitchingRoutes(central); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to switch routes of central %s", central.ID))
+		}
+	}
+
+	return errs
+}
+
+func (k *CentralMigrationManager) reconcileSwitchingRoutes(central *dbapi.CentralRequest) error {
+	switched := true
+	if k.centralConfig.EnableCentralExternalCertificate {
+		if central.RoutesCreationID == "" {
+			glog.Infof("switching CNAME records of central %s to cluster %s", central.ID, central.MigrationTargetClusterID)
+			changeOutput, err := k.dinosaurService.ChangeDinosaurCNAMErecords(central, services.DinosaurRoutesActionUpsert)
+			if err != nil {
+				return err
+			}
+			if changeOutput == nil || changeOutput.ChangeInfo == nil || aws.StringValue(changeOutput.ChangeInfo.Id) == "" {
+				return errors.New("switching CNAME records failed with nil result")
+			}
+			central.RoutesCreationID = aws.StringValue(changeOutput.ChangeInfo.Id)
+			switched = aws.StringValue(changeOutput.ChangeInfo.Status) == "INSYNC"
+		} else {
+			recordStatus, err := k.dinosaurService.GetCNAMERecordStatus(central)
+			if err != nil {
+				return err
+			}
+			if recordStatus == nil {
+				return errors.New("getting the status of the CNAME records failed with nil result")
+			}
+			switched = aws.StringValue(recordStatus.Status) == "INSYNC"
+		}
+	} else {
+		glog.Infof("external certificate is disabled, skip switching CNAME records for central %s", central.ID)
+	}
+
+	updates := map[string]interface{}{
+		"routes_creation_id": central.RoutesCreationID,
+	}
+	if switched {
+		glog.Infof("CNAME records of central %s are switched, handing it over to cluster %s", central.ID, central.MigrationTargetClusterID)
+		updates["cluster_id"] = central.MigrationTargetClusterID
+		updates["migration_status"] = dinosaurConstants.CentralMigrationStatusSourceTeardown.String()
+	}
+
+	if err := k.dinosaurService.Updates(central, updates); err != nil {
+		return err
+	}
+	return nil
+}
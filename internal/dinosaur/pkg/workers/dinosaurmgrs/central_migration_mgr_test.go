@@ -0,0 +1,105 @@
+package dinosaurmgrs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralMigrationManager_SwitchingRoutes(t *testing.T) {
+	tests := []struct {
+		name             string
+		routesCreationID string
+		changeInfo       *route53.ChangeInfo
+		recordStatus     *services.CNameRecordStatus
+		wantErr          bool
+		wantSwitched     bool
+	}{
+		{
+			name:         "should hand over the central once the CNAME records are in sync",
+			changeInfo:   &route53.ChangeInfo{Id: aws.String("change-id"), Status: aws.String("INSYNC")},
+			wantSwitched: true,
+		},
+		{
+			name:       "should wait for pending CNAME records",
+			changeInfo: &route53.ChangeInfo{Id: aws.String("change-id"), Status: aws.String("PENDING")},
+		},
+		{
+			name:       "should wait for CNAME records without status",
+			changeInfo: &route53.ChangeInfo{Id: aws.String("change-id")},
+		},
+		{
+			name:       "should fail for CNAME changes without ID",
+			changeInfo: &route53.ChangeInfo{Status: aws.String("INSYNC")},
+			wantErr:    true,
+		},
+		{
+			name:             "should hand over the central once the CNAME record status is in sync",
+			routesCreationID: "change-id",
+			recordStatus:     &services.CNameRecordStatus{ID: aws.String("change-id"), Status: aws.String("INSYNC")},
+			wantSwitched:     true,
+		},
+		{
+			name:             "should wait for CNAME record status without status",
+			routesCreationID: "change-id",
+			recordStatus:     &services.CNameRecordStatus{ID: aws.String("change-id")},
+		},
+		{
+			name:             "should fail for missing CNAME record status",
+			routesCreationID: "change-id",
+			wantErr:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates map[string]interface{}
+			dinosaurService := &services.DinosaurServiceMock{
+				ChangeDinosaurCNAMErecordsFunc: func(dinosaurRequest *dbapi.CentralRequest, action services.DinosaurRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: tt.changeInfo}, nil
+				},
+				GetCNAMERecordStatusFunc: func(dinosaurRequest *dbapi.CentralRequest) (*services.CNameRecordStatus, error) {
+					return tt.recordStatus, nil
+				},
+				UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError {
+					updates = values
+					return nil
+				},
+			}
+			migrationService := &services.CentralMigrationServiceMock{
+				ListByMigrationStatusFunc: func(status dinosaurConstants.CentralMigrationStatus) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+					return []*dbapi.CentralRequest{{
+						MigrationStatus:          status.String(),
+						MigrationTargetClusterID: "target-cluster",
+						RoutesCreationID:         tt.routesCreationID,
+					}}, nil
+				},
+			}
+			mgr := NewCentralMigrationManager(migrationService, dinosaurService, &config.CentralConfig{EnableCentralExternalCertificate: true})
+
+			errs := mgr.Reconcile()
+
+			if tt.wantErr {
+				require.Len(t, errs, 1)
+				assert.Nil(t, updates)
+				return
+			}
+			require.Empty(t, errs)
+			assert.Equal(t, "change-id", updates["routes_creation_id"])
+			if tt.wantSwitched {
+				assert.Equal(t, "target-cluster", updates["cluster_id"])
+				assert.Equal(t, dinosaurConstants.CentralMigrationStatusSourceTeardown.String(), updates["migration_status"])
+			} else {
+				assert.NotContains(t, updates, "cluster_id")
+			}
+		})
+	}
+}
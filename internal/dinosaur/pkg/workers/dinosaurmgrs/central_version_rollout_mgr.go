@@ -0,0 +1,206 @@
+package dinosaurmgrs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const centralVersionRolloutWorkerType = "central_version_rollout"
+
+// CentralVersionRolloutManager carries out the Central version rollouts in progress wave by wave.
+//
+// A wave sets the desired Central version of the next batch of Centrals matching the selector of the rollout. The
+// wave is healthy once fleetshard-sync reports all its Centrals as ready and not upgrading with the new version, or
+// the wave timed out. Centrals with a maintenance window are upgraded within the window and do not gate the wave.
+// The next wave starts after the Centrals stayed healthy for the soak time. The rollout is paused as soon as more
+// Centrals of a wave failed than tolerated, and completed once no Central is left to upgrade.
+type CentralVersionRolloutManager struct {
+	workers.BaseWorker
+	rolloutService services.CentralVersionRolloutService
+	centralService services.DinosaurService
+	now            func() time.Time
+}
+
+var _ workers.Worker = &CentralVersionRolloutManager{}
+
+// NewCentralVersionRolloutManager ...
+func NewCentralVersionRolloutManager(rolloutService services.CentralVersionRolloutService, centralService services.DinosaurService) *CentralVersionRolloutManager {
+	metrics.InitReconcilerMetricsForType(centralVersionRolloutWorkerType)
+	return &CentralVersionRolloutManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: centralVersionRolloutWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		rolloutService: rolloutService,
+		centralService: centralService,
+		now:            time.Now,
+	}
+}
+
+// Start ...
+func (k *CentralVersionRolloutManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *CentralVersionRolloutManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralVersionRolloutManager) Reconcile() []error {
+	var errs []error
+
+	rollouts, listErr := k.rolloutService.ListInProgress()
+	if listErr != nil {
+		errs = append(errs, errors.Wrap(listErr, "failed to list central version rollouts in progress"))
+	}
+
+	now := k.now()
+	for _, rollout := range rollouts {
+		errs = append(errs, k.reconcileRollout(rollout, now)...)
+	}
+
+	return errs
+}
+
+func (k *CentralVersionRolloutManager) reconcileRollout(rollout *dbapi.CentralVersionRollout, now time.Time) []error {
+	if rollout.WaveCentralIDs == "" {
+		return k.startWave(rollout, now)
+	}
+
+	centrals, svcErr := k.rolloutService.ListWaveCentrals(rollout)
+	if svcErr != nil {
+		return []error{errors.Wrapf(svcErr, "failed to list centrals of wave %d of central version rollout %s", rollout.CurrentWave, rollout.ID)}
+	}
+	health := getWaveHealth(centrals, rollout.CentralVersion)
+
+	if rollout.WaveHealthyAt == nil {
+		timedOut := rollout.WaveStartedAt == nil || now.After(rollout.WaveStartedAt.Add(rollout.WaveTimeout))
+		switch {
+		case health.failed > rollout.MaxFailures, timedOut && health.unhealthy() > rollout.MaxFailures:
+			return k.pauseRollout(rollout, health)
+		case health.pending > 0 && !timedOut:
+			glog.V(10).Infof("waiting for %d centrals of wave %d of central version rollout %s", health.pending, rollout.CurrentWave, rollout.ID)
+			return nil
+		}
+		glog.Infof("wave %d of central version rollout %s is healthy, soaking for %s", rollout.CurrentWave, rollout.ID, rollout.SoakTime)
+		rollout.WaveHealthyAt = &now
+		return k.updateProgress(rollout)
+	}
+
+	if health.unhealthy() > rollout.MaxFailures {
+		return k.pauseRollout(rollout, health)
+	}
+	if now.Before(rollout.WaveHealthyAt.Add(rollout.SoakTime)) {
+		return nil
+	}
+	glog.Infof("wave %d of central version rollout %s completed: %d centrals upgraded, %d failed", rollout.CurrentWave, rollout.ID, health.healthy, health.unhealthy())
+	completeWave(rollout, health)
+	return k.updateProgress(rollout)
+}
+
+func (k *CentralVersionRolloutManager) startWave(rollout *dbapi.CentralVersionRollout, now time.Time) []error {
+	candidates, svcErr := k.rolloutService.ListWaveCandidates(rollout)
+	if svcErr != nil {
+		return []error{errors.Wrapf(svcErr, "failed to list centrals of the next wave of central version rollout %s", rollout.ID)}
+	}
+	if len(candidates) == 0 {
+		glog.Infof("central version rollout %s completed: %d centrals upgraded, %d failed", rollout.ID, rollout.UpgradedCentrals, rollout.FailedCentrals)
+		rollout.Status = dinosaurConstants.CentralVersionRolloutStatusCompleted.String()
+		return k.updateProgress(rollout)
+	}
+
+	var errs []error
+	var waveCentralIDs []string
+	for _, central := range candidates {
+		if svcErr := k.centralService.Updates(central, map[string]interface{}{
+			"desired_central_version": rollout.CentralVersion,
+		}); svcErr != nil {
+			errs = append(errs, errors.Wrapf(svcErr, "failed to set desired version %s of central %s", rollout.CentralVersion, central.ID))
+			continue
+		}
+		waveCentralIDs = append(waveCentralIDs, central.ID)
+	}
+	if len(waveCentralIDs) == 0 {
+		return errs
+	}
+
+	rollout.CurrentWave++
+	rollout.WaveCentralIDs = strings.Join(waveCentralIDs, ",")
+	rollout.WaveStartedAt = &now
+	rollout.WaveHealthyAt = nil
+	glog.Infof("started wave %d of central version rollout %s with %d centrals", rollout.CurrentWave, rollout.ID, len(waveCentralIDs))
+	return append(errs, k.updateProgress(rollout)...)
+}
+
+func (k *CentralVersionRolloutManager) pauseRollout(rollout *dbapi.CentralVersionRollout, health waveHealth) []error {
+	rollout.Status = dinosaurConstants.CentralVersionRolloutStatusPaused.String()
+	rollout.PauseReason = fmt.Sprintf("%d of %d centrals of wave %d are unhealthy, %d are tolerated",
+		health.unhealthy(), health.total(), rollout.CurrentWave, rollout.MaxFailures)
+	glog.Warningf("pausing central version rollout %s: %s", rollout.ID, rollout.PauseReason)
+	completeWave(rollout, health)
+	return k.updateProgress(rollout)
+}
+
+func (k *CentralVersionRolloutManager) updateProgress(rollout *dbapi.CentralVersionRollout) []error {
+	if svcErr := k.rolloutService.UpdateProgress(rollout); svcErr != nil {
+		return []error{errors.Wrapf(svcErr, "failed to update progress of central version rollout %s", rollout.ID)}
+	}
+	return nil
+}
+
+func completeWave(rollout *dbapi.CentralVersionRollout, health waveHealth) {
+	rollout.UpgradedCentrals += health.healthy
+	rollout.FailedCentrals += health.unhealthy()
+	rollout.WaveCentralIDs = ""
+	rollout.WaveStartedAt = nil
+	rollout.WaveHealthyAt = nil
+}
+
+// waveHealth counts the Centrals of a wave by their health. Centrals which are being deleted or wait for their
+// maintenance window to be upgraded are not counted.
+type waveHealth struct {
+	healthy int
+	failed  int
+	pending int
+}
+
+func (h waveHealth) unhealthy() int {
+	return h.failed + h.pending
+}
+
+func (h waveHealth) total() int {
+	return h.healthy + h.failed + h.pending
+}
+
+func getWaveHealth(centrals []*dbapi.CentralRequest, version string) waveHealth {
+	var health waveHealth
+	for _, central := range centrals {
+		switch {
+		case central.Status == dinosaurConstants.CentralRequestStatusFailed.String():
+			health.failed++
+		case dinosaurConstants.CentralStatus(central.Status).CompareTo(dinosaurConstants.CentralRequestStatusReady) > 0:
+			// the central is being deleted
+		case central.GetRolledOutCentralVersion() != version:
+			// the central is upgraded within its maintenance window and is not part of the wave
+		case central.Status == dinosaurConstants.CentralRequestStatusReady.String() &&
+			!central.CentralUpgrading && central.ActualCentralVersion == version:
+			health.healthy++
+		default:
+			health.pending++
+		}
+	}
+	return health
+}
@@ -0,0 +1,271 @@
+package dinosaurmgrs
+
+import (
+	"testing"
+	"time"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRolloutVersion = "4.0.0"
+
+func testRolloutCentral(id string, status dinosaurConstants.CentralStatus, actualVersion string, upgrading bool) *dbapi.CentralRequest {
+	return &dbapi.CentralRequest{
+		Meta:                  api.Meta{ID: id},
+		Status:                status.String(),
+		DesiredCentralVersion: testRolloutVersion,
+		ActualCentralVersion:  actualVersion,
+		CentralUpgrading:      upgrading,
+	}
+}
+
+func TestCentralVersionRolloutManager(t *testing.T) {
+	now := time.Date(2023, 5, 15, 12, 0, 0, 0, time.UTC)
+	waveStartedAt := now.Add(-10 * time.Minute)
+	waveHealthyAt := now.Add(-10 * time.Minute)
+	heldBackCentral := testRolloutCentral("c2", dinosaurConstants.CentralRequestStatusReady, "3.0.0", false)
+	heldBackCentral.MaintenanceWindowDay = "sunday"
+	heldBackCentral.RolledOutCentralVersion = "3.0.0"
+
+	tests := []struct {
+		name          string
+		rollout       dbapi.CentralVersionRollout
+		candidates    []*dbapi.CentralRequest
+		waveCentrals  []*dbapi.CentralRequest
+		wantProgress  bool
+		wantUpdated   []string
+		wantRollout   dbapi.CentralVersionRollout
+		wantErrsCount int
+	}{
+		{
+			name:       "should start the next wave with the candidates",
+			rollout:    dbapi.CentralVersionRollout{BatchSize: 2, CurrentWave: 1, UpgradedCentrals: 2},
+			candidates: []*dbapi.CentralRequest{{Meta: api.Meta{ID: "c1"}}, {Meta: api.Meta{ID: "c2"}}},
+			wantUpdated: []string{
+				"c1", "c2",
+			},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				BatchSize:        2,
+				CurrentWave:      2,
+				WaveCentralIDs:   "c1,c2",
+				WaveStartedAt:    &now,
+				UpgradedCentrals: 2,
+			},
+		},
+		{
+			name:         "should complete the rollout when no candidates are left",
+			rollout:      dbapi.CentralVersionRollout{BatchSize: 2, CurrentWave: 3, UpgradedCentrals: 5},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				Status:           dinosaurConstants.CentralVersionRolloutStatusCompleted.String(),
+				BatchSize:        2,
+				CurrentWave:      3,
+				UpgradedCentrals: 5,
+			},
+		},
+		{
+			name: "should wait for upgrading centrals of the wave",
+			rollout: dbapi.CentralVersionRollout{
+				WaveCentralIDs: "c1,c2",
+				WaveStartedAt:  &waveStartedAt,
+				WaveTimeout:    time.Hour,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, false),
+				testRolloutCentral("c2", dinosaurConstants.CentralRequestStatusReady, "3.0.0", true),
+			},
+		},
+		{
+			name: "should start the soak time once all centrals of the wave are healthy",
+			rollout: dbapi.CentralVersionRollout{
+				WaveCentralIDs: "c1,c2",
+				WaveStartedAt:  &waveStartedAt,
+				WaveTimeout:    time.Hour,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, false),
+				testRolloutCentral("c2", dinosaurConstants.CentralRequestStatusDeprovision, "3.0.0", false),
+			},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				WaveCentralIDs: "c1,c2",
+				WaveStartedAt:  &waveStartedAt,
+				WaveHealthyAt:  &now,
+				WaveTimeout:    time.Hour,
+			},
+		},
+		{
+			name: "should not count centrals waiting for their maintenance window",
+			rollout: dbapi.CentralVersionRollout{
+				CurrentWave:      1,
+				WaveCentralIDs:   "c1,c2",
+				WaveStartedAt:    &waveStartedAt,
+				WaveHealthyAt:    &waveHealthyAt,
+				SoakTime:         5 * time.Minute,
+				UpgradedCentrals: 1,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, false),
+				heldBackCentral,
+			},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				CurrentWave:      1,
+				SoakTime:         5 * time.Minute,
+				UpgradedCentrals: 2,
+			},
+		},
+		{
+			name: "should pause the rollout when too many centrals of the wave failed",
+			rollout: dbapi.CentralVersionRollout{
+				CurrentWave:    2,
+				WaveCentralIDs: "c1,c2,c3",
+				WaveStartedAt:  &waveStartedAt,
+				WaveTimeout:    time.Hour,
+				MaxFailures:    1,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusFailed, "3.0.0", false),
+				testRolloutCentral("c2", dinosaurConstants.CentralRequestStatusFailed, "3.0.0", false),
+				testRolloutCentral("c3", dinosaurConstants.CentralRequestStatusReady, "3.0.0", true),
+			},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				Status:         dinosaurConstants.CentralVersionRolloutStatusPaused.String(),
+				PauseReason:    "3 of 3 centrals of wave 2 are unhealthy, 1 are tolerated",
+				CurrentWave:    2,
+				WaveTimeout:    time.Hour,
+				MaxFailures:    1,
+				FailedCentrals: 3,
+			},
+		},
+		{
+			name: "should tolerate failed centrals up to the maximum after the wave timeout",
+			rollout: dbapi.CentralVersionRollout{
+				WaveCentralIDs: "c1,c2",
+				WaveStartedAt:  &waveStartedAt,
+				WaveTimeout:    5 * time.Minute,
+				MaxFailures:    1,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, false),
+				testRolloutCentral("c2", dinosaurConstants.CentralRequestStatusReady, "3.0.0", true),
+			},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				WaveCentralIDs: "c1,c2",
+				WaveStartedAt:  &waveStartedAt,
+				WaveHealthyAt:  &now,
+				WaveTimeout:    5 * time.Minute,
+				MaxFailures:    1,
+			},
+		},
+		{
+			name: "should soak the wave",
+			rollout: dbapi.CentralVersionRollout{
+				WaveCentralIDs: "c1",
+				WaveStartedAt:  &waveStartedAt,
+				WaveHealthyAt:  &waveHealthyAt,
+				SoakTime:       time.Hour,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, false),
+			},
+		},
+		{
+			name: "should complete the wave after the soak time",
+			rollout: dbapi.CentralVersionRollout{
+				CurrentWave:      1,
+				WaveCentralIDs:   "c1,c2",
+				WaveStartedAt:    &waveStartedAt,
+				WaveHealthyAt:    &waveHealthyAt,
+				SoakTime:         5 * time.Minute,
+				UpgradedCentrals: 1,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, false),
+				testRolloutCentral("c2", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, false),
+			},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				CurrentWave:      1,
+				SoakTime:         5 * time.Minute,
+				UpgradedCentrals: 3,
+			},
+		},
+		{
+			name: "should pause the rollout when centrals become unhealthy during the soak time",
+			rollout: dbapi.CentralVersionRollout{
+				CurrentWave:    1,
+				WaveCentralIDs: "c1",
+				WaveStartedAt:  &waveStartedAt,
+				WaveHealthyAt:  &waveHealthyAt,
+				SoakTime:       time.Hour,
+			},
+			waveCentrals: []*dbapi.CentralRequest{
+				testRolloutCentral("c1", dinosaurConstants.CentralRequestStatusReady, testRolloutVersion, true),
+			},
+			wantProgress: true,
+			wantRollout: dbapi.CentralVersionRollout{
+				Status:         dinosaurConstants.CentralVersionRolloutStatusPaused.String(),
+				PauseReason:    "1 of 1 centrals of wave 1 are unhealthy, 0 are tolerated",
+				CurrentWave:    1,
+				SoakTime:       time.Hour,
+				FailedCentrals: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rollout := tt.rollout
+			rollout.CentralVersion = testRolloutVersion
+			var progress *dbapi.CentralVersionRollout
+			rolloutService := &services.CentralVersionRolloutServiceMock{
+				ListInProgressFunc: func() (dbapi.CentralVersionRolloutList, *errors.ServiceError) {
+					return dbapi.CentralVersionRolloutList{&rollout}, nil
+				},
+				ListWaveCandidatesFunc: func(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+					return tt.candidates, nil
+				},
+				ListWaveCentralsFunc: func(rollout *dbapi.CentralVersionRollout) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+					return tt.waveCentrals, nil
+				},
+				UpdateProgressFunc: func(rollout *dbapi.CentralVersionRollout) *errors.ServiceError {
+					progress = rollout
+					return nil
+				},
+			}
+			var updated []string
+			centralService := &services.DinosaurServiceMock{
+				UpdatesFunc: func(dinosaurRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError {
+					assert.Equal(t, map[string]interface{}{"desired_central_version": testRolloutVersion}, values)
+					updated = append(updated, dinosaurRequest.ID)
+					return nil
+				},
+			}
+			manager := NewCentralVersionRolloutManager(rolloutService, centralService)
+			manager.now = func() time.Time { return now }
+
+			errs := manager.Reconcile()
+
+			assert.Len(t, errs, tt.wantErrsCount)
+			assert.Equal(t, tt.wantUpdated, updated)
+			if !tt.wantProgress {
+				assert.Nil(t, progress)
+				return
+			}
+			require.NotNil(t, progress)
+			want := tt.wantRollout
+			want.CentralVersion = testRolloutVersion
+			assert.Equal(t, want, *progress)
+		})
+	}
+}
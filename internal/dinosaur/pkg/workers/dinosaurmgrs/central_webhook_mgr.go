@@ -0,0 +1,223 @@
+package dinosaurmgrs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const (
+	webhookDeliveryWorkerType = "webhook_delivery"
+
+	// Headers of the webhook notifications. The signature is computed by services.SignWebhookPayload.
+	webhookSignatureHeader = "X-Webhook-Signature"
+	webhookTimestampHeader = "X-Webhook-Timestamp"
+	webhookEventHeader     = "X-Webhook-Event"
+	webhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// CentralWebhookManager delivers the webhook notifications enqueued on Central status changes to the endpoints
+// of the subscriptions. Failed deliveries are retried with exponential backoff and moved to the dead letters
+// after the configured number of attempts. Notifications are only sent to public addresses unless private networks
+// are allowed.
+type CentralWebhookManager struct {
+	workers.BaseWorker
+	webhookService services.WebhookService
+	webhookConfig  *config.WebhookConfig
+	httpClient     *http.Client
+	now            func() time.Time
+}
+
+var _ workers.Worker = &CentralWebhookManager{}
+
+// NewCentralWebhookManager ...
+func NewCentralWebhookManager(webhookService services.WebhookService, webhookConfig *config.WebhookConfig) *CentralWebhookManager {
+	metrics.InitReconcilerMetricsForType(webhookDeliveryWorkerType)
+	return &CentralWebhookManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: webhookDeliveryWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		webhookService: webhookService,
+		webhookConfig:  webhookConfig,
+		httpClient:     newWebhookHTTPClient(webhookConfig),
+		now:            time.Now,
+	}
+}
+
+// Start ...
+func (k *CentralWebhookManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *CentralWebhookManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralWebhookManager) Reconcile() []error {
+	var errs []error
+
+	deliveries, svcErr := k.webhookService.ListDueDeliveries(k.webhookConfig.BatchSize)
+	if svcErr != nil {
+		errs = append(errs, errors.Wrap(svcErr, "failed to list due webhook deliveries"))
+	}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, k.webhookConfig.Concurrency)
+	for _, delivery := range deliveries {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(delivery *dbapi.WebhookDelivery) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := k.reconcileDelivery(delivery); err != nil {
+				mutex.Lock()
+				errs = append(errs, err)
+				mutex.Unlock()
+			}
+		}(delivery)
+	}
+	wg.Wait()
+
+	if pending, svcErr := k.webhookService.CountPendingDeliveries(); svcErr != nil {
+		errs = append(errs, errors.Wrap(svcErr, "failed to count pending webhook deliveries"))
+	} else {
+		metrics.UpdateWebhookDeliveriesPendingMetric(pending)
+	}
+
+	return errs
+}
+
+func (k *CentralWebhookManager) reconcileDelivery(delivery *dbapi.WebhookDelivery) error {
+	subscription, svcErr := k.webhookService.GetSubscription(delivery.SubscriptionID)
+	if svcErr != nil {
+		if !svcErr.Is404() {
+			return errors.Wrapf(svcErr, "failed to get webhook subscription %s", delivery.SubscriptionID)
+		}
+		glog.Infof("dropping webhook delivery %d of deleted subscription %s", delivery.ID, delivery.SubscriptionID)
+		if svcErr := k.webhookService.CompleteDelivery(delivery); svcErr != nil {
+			return errors.Wrapf(svcErr, "failed to drop webhook delivery %d", delivery.ID)
+		}
+		return nil
+	}
+
+	start := k.now()
+	deliverErr := k.deliver(subscription, delivery)
+	metrics.UpdateWebhookDeliveryDurationMetric(delivery.EventType, time.Since(start))
+
+	if deliverErr == nil {
+		metrics.IncreaseWebhookDeliveriesTotal(delivery.EventType, metrics.WebhookDeliveryResultSuccess)
+		if svcErr := k.webhookService.CompleteDelivery(delivery); svcErr != nil {
+			return errors.Wrapf(svcErr, "failed to complete webhook delivery %d", delivery.ID)
+		}
+		return nil
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= k.webhookConfig.MaxAttempts {
+		glog.Warningf("moving webhook delivery %d of central %s to the dead letters after %d attempts: %v",
+			delivery.ID, delivery.CentralID, attempts, deliverErr)
+		metrics.IncreaseWebhookDeliveriesTotal(delivery.EventType, metrics.WebhookDeliveryResultDeadLetter)
+		if svcErr := k.webhookService.DeadLetterDelivery(delivery, deliverErr.Error()); svcErr != nil {
+			return errors.Wrapf(svcErr, "failed to move webhook delivery %d to the dead letters", delivery.ID)
+		}
+		return nil
+	}
+
+	glog.V(5).Infof("webhook delivery %d of central %s failed on attempt %d: %v", delivery.ID, delivery.CentralID, attempts, deliverErr)
+	metrics.IncreaseWebhookDeliveriesTotal(delivery.EventType, metrics.WebhookDeliveryResultFailure)
+	nextAttemptAt := k.now().Add(k.backoff(attempts))
+	if svcErr := k.webhookService.RetryDelivery(delivery, deliverErr.Error(), nextAttemptAt); svcErr != nil {
+		return errors.Wrapf(svcErr, "failed to schedule retry of webhook delivery %d", delivery.ID)
+	}
+	return nil
+}
+
+// deliver posts the signed notification to the endpoint of the subscription. Any 2xx response is a success.
+func (k *CentralWebhookManager) deliver(subscription *dbapi.WebhookSubscription, delivery *dbapi.WebhookDelivery) error {
+	payload := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(k.now().Unix(), 10)
+
+	req, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookSignatureHeader, "sha256="+services.SignWebhookPayload(subscription.Secret, timestamp, payload))
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookEventHeader, delivery.EventType)
+	req.Header.Set(webhookDeliveryHeader, strconv.FormatUint(delivery.ID, 10))
+
+	resp, err := k.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "sending request")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// the response body is not stored, receivers could use it to read the responses of internal services
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// newWebhookHTTPClient returns the client sending the notifications. It refuses to connect to non-public addresses
+// when the connection is established, so that DNS records changed after the subscription was validated and
+// redirects cannot reach internal services.
+func newWebhookHTTPClient(webhookConfig *config.WebhookConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: webhookConfig.Timeout}
+	if !webhookConfig.AllowPrivateNetworks {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return errors.Wrapf(err, "parsing address %q", address)
+			}
+			if ip := net.ParseIP(host); ip == nil || !services.IsPublicWebhookIP(ip) {
+				return fmt.Errorf("refusing to connect to non-public address %s", host)
+			}
+			return nil
+		}
+	}
+	return &http.Client{
+		Timeout: webhookConfig.Timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookConfig.Timeout,
+			MaxIdleConns:        webhookConfig.Concurrency,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// backoff returns the delay before the next attempt after the given number of failed attempts.
+func (k *CentralWebhookManager) backoff(attempts int) time.Duration {
+	delay := k.webhookConfig.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= k.webhookConfig.MaxBackoff {
+			return k.webhookConfig.MaxBackoff
+		}
+	}
+	return delay
+}
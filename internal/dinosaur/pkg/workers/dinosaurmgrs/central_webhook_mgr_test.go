@@ -0,0 +1,220 @@
+package dinosaurmgrs
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookPayload = `{"type":"central.ready","central_id":"central-id"}`
+
+func newWebhookServiceMock(url string, attempts int) *services.WebhookServiceMock {
+	return &services.WebhookServiceMock{
+		ListDueDeliveriesFunc: func(limit int) (dbapi.WebhookDeliveryList, *errors.ServiceError) {
+			return dbapi.WebhookDeliveryList{{
+				ID:             42,
+				SubscriptionID: "subscription-id",
+				EventType:      "central.ready",
+				CentralID:      "central-id",
+				Payload:        testWebhookPayload,
+				Attempts:       attempts,
+			}}, nil
+		},
+		CountPendingDeliveriesFunc: func() (int64, *errors.ServiceError) {
+			return 1, nil
+		},
+		GetSubscriptionFunc: func(id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+			return &dbapi.WebhookSubscription{URL: url, Secret: "0123456789abcdef"}, nil
+		},
+		CompleteDeliveryFunc: func(delivery *dbapi.WebhookDelivery) *errors.ServiceError {
+			return nil
+		},
+		RetryDeliveryFunc: func(delivery *dbapi.WebhookDelivery, lastError string, nextAttemptAt time.Time) *errors.ServiceError {
+			return nil
+		},
+		DeadLetterDeliveryFunc: func(delivery *dbapi.WebhookDelivery, lastError string) *errors.ServiceError {
+			return nil
+		},
+	}
+}
+
+// newTestWebhookConfig allows deliveries to the loopback address of the test receivers.
+func newTestWebhookConfig() *config.WebhookConfig {
+	webhookConfig := config.NewWebhookConfig()
+	webhookConfig.AllowPrivateNetworks = true
+	return webhookConfig
+}
+
+func TestCentralWebhookManager_DeliversSignedNotification(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	webhookService := newWebhookServiceMock(receiver.URL, 0)
+	mgr := NewCentralWebhookManager(webhookService, newTestWebhookConfig())
+	mgr.now = func() time.Time { return time.Unix(1683590400, 0) }
+
+	errs := mgr.Reconcile()
+	require.Empty(t, errs)
+
+	require.NotNil(t, received)
+	assert.Equal(t, testWebhookPayload, string(body))
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "central.ready", received.Header.Get(webhookEventHeader))
+	assert.Equal(t, "42", received.Header.Get(webhookDeliveryHeader))
+	assert.Equal(t, "1683590400", received.Header.Get(webhookTimestampHeader))
+	assert.Equal(t, "sha256="+services.SignWebhookPayload("0123456789abcdef", "1683590400", []byte(testWebhookPayload)),
+		received.Header.Get(webhookSignatureHeader))
+	assert.Len(t, webhookService.CompleteDeliveryCalls(), 1)
+	assert.Empty(t, webhookService.RetryDeliveryCalls())
+}
+
+func TestCentralWebhookManager_RetriesFailedDelivery(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	tests := []struct {
+		name           string
+		attempts       int
+		wantBackoff    time.Duration
+		wantDeadLetter bool
+	}{
+		{
+			name:        "should retry after the initial backoff",
+			attempts:    0,
+			wantBackoff: 30 * time.Second,
+		},
+		{
+			name:        "should double the backoff with every attempt",
+			attempts:    2,
+			wantBackoff: 2 * time.Minute,
+		},
+		{
+			name:        "should cap the backoff",
+			attempts:    6,
+			wantBackoff: 10 * time.Minute,
+		},
+		{
+			name:           "should move the delivery to the dead letters after the last attempt",
+			attempts:       7,
+			wantDeadLetter: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookService := newWebhookServiceMock(receiver.URL, tt.attempts)
+			webhookConfig := newTestWebhookConfig()
+			webhookConfig.MaxBackoff = 10 * time.Minute
+			mgr := NewCentralWebhookManager(webhookService, webhookConfig)
+			now := time.Now()
+			mgr.now = func() time.Time { return now }
+
+			errs := mgr.Reconcile()
+			require.Empty(t, errs)
+
+			assert.Empty(t, webhookService.CompleteDeliveryCalls())
+			if tt.wantDeadLetter {
+				require.Len(t, webhookService.DeadLetterDeliveryCalls(), 1)
+				assert.Equal(t, "unexpected response status 503", webhookService.DeadLetterDeliveryCalls()[0].LastError)
+				assert.Empty(t, webhookService.RetryDeliveryCalls())
+				return
+			}
+			require.Len(t, webhookService.RetryDeliveryCalls(), 1)
+			assert.Equal(t, now.Add(tt.wantBackoff), webhookService.RetryDeliveryCalls()[0].NextAttemptAt)
+			assert.Empty(t, webhookService.DeadLetterDeliveryCalls())
+		})
+	}
+}
+
+func TestCentralWebhookManager_DropsDeliveriesOfDeletedSubscriptions(t *testing.T) {
+	webhookService := newWebhookServiceMock("", 0)
+	webhookService.GetSubscriptionFunc = func(id string) (*dbapi.WebhookSubscription, *errors.ServiceError) {
+		return nil, errors.NotFound("WebhookSubscription with id='%s' not found", id)
+	}
+	mgr := NewCentralWebhookManager(webhookService, config.NewWebhookConfig())
+
+	errs := mgr.Reconcile()
+	require.Empty(t, errs)
+	assert.Len(t, webhookService.CompleteDeliveryCalls(), 1)
+}
+
+func TestCentralWebhookManager_RefusesNonPublicAddresses(t *testing.T) {
+	var called bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	webhookService := newWebhookServiceMock(receiver.URL, 0)
+	mgr := NewCentralWebhookManager(webhookService, config.NewWebhookConfig())
+
+	errs := mgr.Reconcile()
+	require.Empty(t, errs)
+	assert.False(t, called)
+	assert.Empty(t, webhookService.CompleteDeliveryCalls())
+	require.Len(t, webhookService.RetryDeliveryCalls(), 1)
+	assert.Contains(t, webhookService.RetryDeliveryCalls()[0].LastError, "refusing to connect to non-public address 127.0.0.1")
+}
+
+func TestCentralWebhookManager_DeliversConcurrently(t *testing.T) {
+	var mutex sync.Mutex
+	var inFlight, maxInFlight int
+	full := make(chan struct{})
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		if inFlight == 2 {
+			close(full)
+		}
+		mutex.Unlock()
+		// the deliveries only succeed if two of them are sent at the same time
+		select {
+		case <-full:
+			w.WriteHeader(http.StatusNoContent)
+		case <-time.After(5 * time.Second):
+			w.WriteHeader(http.StatusGatewayTimeout)
+		}
+		mutex.Lock()
+		inFlight--
+		mutex.Unlock()
+	}))
+	defer receiver.Close()
+
+	webhookService := newWebhookServiceMock(receiver.URL, 0)
+	webhookService.ListDueDeliveriesFunc = func(limit int) (dbapi.WebhookDeliveryList, *errors.ServiceError) {
+		return dbapi.WebhookDeliveryList{
+			{ID: 1, SubscriptionID: "subscription-id", Payload: testWebhookPayload},
+			{ID: 2, SubscriptionID: "subscription-id", Payload: testWebhookPayload},
+		}, nil
+	}
+	webhookConfig := newTestWebhookConfig()
+	webhookConfig.Concurrency = 2
+	mgr := NewCentralWebhookManager(webhookService, webhookConfig)
+
+	errs := mgr.Reconcile()
+	require.Empty(t, errs)
+	assert.Len(t, webhookService.CompleteDeliveryCalls(), 2)
+	assert.Equal(t, 2, maxInFlight)
+}
@@ -0,0 +1,166 @@
+package dinosaurmgrs
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const clusterDrainWorkerType = "cluster_drain"
+
+// ClusterDrainManager starts the migration of the Centrals of draining clusters to other clusters in the same region.
+// At most ClusterDrainBatchSize Centrals are migrated away from a cluster at the same time.
+//
+// Centrals which are still being created are picked up in later reconciliations, as they either become ready or
+// fail once their creation timeout is exceeded. Failed Centrals are not migrated but deprovisioned, unless they
+// are protected against deletion.
+type ClusterDrainManager struct {
+	workers.BaseWorker
+	drainService      services.ClusterDrainService
+	migrationService  services.CentralMigrationService
+	dinosaurService   services.DinosaurService
+	placementStrategy services.ClusterPlacementStrategy
+	batchSize         int
+}
+
+var _ workers.Worker = &ClusterDrainManager{}
+
+// NewClusterDrainManager ...
+func NewClusterDrainManager(drainService services.ClusterDrainService, migrationService services.CentralMigrationService,
+	dinosaurService services.DinosaurService, placementStrategy services.ClusterPlacementStrategy,
+	dataplaneClusterConfig *config.DataplaneClusterConfig) *ClusterDrainManager {
+	metrics.InitReconcilerMetricsForType(clusterDrainWorkerType)
+	return &ClusterDrainManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: clusterDrainWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		drainService:      drainService,
+		migrationService:  migrationService,
+		dinosaurService:   dinosaurService,
+		placementStrategy: placementStrategy,
+		batchSize:         dataplaneClusterConfig.ClusterDrainBatchSize,
+	}
+}
+
+// Start ...
+func (k *ClusterDrainManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *ClusterDrainManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *ClusterDrainManager) Reconcile() []error {
+	var errs []error
+
+	clusters, listErr := k.drainService.ListDrainingClusters()
+	if listErr != nil {
+		errs = append(errs, errors.Wrap(listErr, "failed to list draining clusters"))
+	}
+
+	for _, cluster := range clusters {
+		errs = append(errs, k.reconcileDrainingCluster(cluster)...)
+	}
+
+	return errs
+}
+
+func (k *ClusterDrainManager) reconcileDrainingCluster(cluster *api.Cluster) []error {
+	centrals, svcErr := k.drainService.ListCentrals(cluster.ClusterID)
+	if svcErr != nil {
+		return []error{errors.Wrapf(svcErr, "failed to list centrals of draining cluster %s", cluster.ClusterID)}
+	}
+	if len(centrals) == 0 {
+		glog.V(10).Infof("draining cluster %s is empty", cluster.ClusterID)
+		return nil
+	}
+
+	migrating := 0
+	for _, central := range centrals {
+		if central.MigrationStatus != "" && central.MigrationSourceClusterID == cluster.ClusterID {
+			migrating++
+		}
+	}
+
+	var errs []error
+	for _, central := range centrals {
+		if isFailedCentralToBeRemoved(central, cluster.ClusterID) {
+			if err := k.deprovisionFailedCentral(central); err != nil {
+				errs = append(errs, errors.Wrapf(err, "failed to deprovision failed central %s of draining cluster %s", central.ID, cluster.ClusterID))
+			}
+			continue
+		}
+		if !isCentralReadyToBeDrained(central, cluster.ClusterID) {
+			continue
+		}
+		if migrating >= k.batchSize {
+			glog.V(10).Infof("%d centrals are being drained from cluster %s, waiting for them to finish", migrating, cluster.ClusterID)
+			continue
+		}
+		started, err := k.migrateCentral(central)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to drain central %s from cluster %s", central.ID, cluster.ClusterID))
+		}
+		if started {
+			migrating++
+		}
+	}
+	return errs
+}
+
+// isCentralReadyToBeDrained returns true for ready Centrals on the given cluster that are not being migrated yet.
+func isCentralReadyToBeDrained(central *dbapi.CentralRequest, clusterID string) bool {
+	return central.ClusterID == clusterID &&
+		central.MigrationStatus == "" &&
+		central.Status == dinosaurConstants.CentralRequestStatusReady.String()
+}
+
+// isFailedCentralToBeRemoved returns true for failed Centrals on the given cluster that can be deprovisioned.
+func isFailedCentralToBeRemoved(central *dbapi.CentralRequest, clusterID string) bool {
+	return central.ClusterID == clusterID &&
+		central.MigrationStatus == "" &&
+		central.Status == dinosaurConstants.CentralRequestStatusFailed.String()
+}
+
+func (k *ClusterDrainManager) deprovisionFailedCentral(central *dbapi.CentralRequest) error {
+	if central.DeletionProtection {
+		glog.Warningf("failed central %s on draining cluster %s is protected against deletion, it must be removed manually", central.ID, central.ClusterID)
+		return nil
+	}
+	if _, svcErr := k.dinosaurService.UpdateStatus(central.ID, dinosaurConstants.CentralRequestStatusDeprovision); svcErr != nil {
+		return svcErr
+	}
+	glog.Infof("deprovisioning failed central %s of draining cluster %s", central.ID, central.ClusterID)
+	return nil
+}
+
+func (k *ClusterDrainManager) migrateCentral(central *dbapi.CentralRequest) (bool, error) {
+	targetCluster, err := k.placementStrategy.FindCluster(central)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to find migration target cluster")
+	}
+	if targetCluster == nil {
+		glog.Warningf("no cluster available to migrate central %s to, retrying later", central.ID)
+		return false, nil
+	}
+
+	if _, svcErr := k.migrationService.StartMigration(context.Background(), central.ID, targetCluster.ClusterID); svcErr != nil {
+		return false, svcErr
+	}
+	glog.Infof("draining central %s from cluster %s to cluster %s", central.ID, central.ClusterID, targetCluster.ClusterID)
+	return true, nil
+}
@@ -0,0 +1,183 @@
+package dinosaurmgrs
+
+import (
+	"context"
+	"testing"
+
+	dinosaurConstants "github.com/stackrox/acs-fleet-manager/internal/dinosaur/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/dinosaur/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	drainingClusterID = "draining-cluster"
+	targetClusterID   = "target-cluster"
+)
+
+func drainingCentral(id string, status dinosaurConstants.CentralStatus) *dbapi.CentralRequest {
+	central := &dbapi.CentralRequest{ClusterID: drainingClusterID, Status: status.String()}
+	central.ID = id
+	return central
+}
+
+func migratingCentral(id string) *dbapi.CentralRequest {
+	central := drainingCentral(id, dinosaurConstants.CentralRequestStatusReady)
+	central.MigrationStatus = dinosaurConstants.CentralMigrationStatusTargetProvisioning.String()
+	central.MigrationSourceClusterID = drainingClusterID
+	central.MigrationTargetClusterID = targetClusterID
+	return central
+}
+
+func TestClusterDrainManager_Reconcile(t *testing.T) {
+	protectedCentral := drainingCentral("protected", dinosaurConstants.CentralRequestStatusFailed)
+	protectedCentral.DeletionProtection = true
+
+	tests := []struct {
+		name              string
+		centrals          []*dbapi.CentralRequest
+		batchSize         int
+		targetCluster     *api.Cluster
+		wantMigrated      []string
+		wantDeprovisioned []string
+	}{
+		{
+			name: "should migrate ready centrals",
+			centrals: []*dbapi.CentralRequest{
+				drainingCentral("ready-1", dinosaurConstants.CentralRequestStatusReady),
+				drainingCentral("ready-2", dinosaurConstants.CentralRequestStatusReady),
+			},
+			batchSize:     5,
+			targetCluster: &api.Cluster{ClusterID: targetClusterID},
+			wantMigrated:  []string{"ready-1", "ready-2"},
+		},
+		{
+			name: "should not migrate more centrals than the batch size",
+			centrals: []*dbapi.CentralRequest{
+				drainingCentral("ready-1", dinosaurConstants.CentralRequestStatusReady),
+				drainingCentral("ready-2", dinosaurConstants.CentralRequestStatusReady),
+				drainingCentral("ready-3", dinosaurConstants.CentralRequestStatusReady),
+			},
+			batchSize:     2,
+			targetCluster: &api.Cluster{ClusterID: targetClusterID},
+			wantMigrated:  []string{"ready-1", "ready-2"},
+		},
+		{
+			name: "should count the centrals which are already being migrated",
+			centrals: []*dbapi.CentralRequest{
+				migratingCentral("migrating"),
+				drainingCentral("ready-1", dinosaurConstants.CentralRequestStatusReady),
+				drainingCentral("ready-2", dinosaurConstants.CentralRequestStatusReady),
+			},
+			batchSize:     2,
+			targetCluster: &api.Cluster{ClusterID: targetClusterID},
+			wantMigrated:  []string{"ready-1"},
+		},
+		{
+			name: "should wait for centrals which are still being created",
+			centrals: []*dbapi.CentralRequest{
+				drainingCentral("accepted", dinosaurConstants.CentralRequestStatusAccepted),
+				drainingCentral("provisioning", dinosaurConstants.CentralRequestStatusProvisioning),
+			},
+			batchSize:     5,
+			targetCluster: &api.Cluster{ClusterID: targetClusterID},
+		},
+		{
+			name: "should deprovision failed centrals",
+			centrals: []*dbapi.CentralRequest{
+				drainingCentral("failed", dinosaurConstants.CentralRequestStatusFailed),
+				protectedCentral,
+			},
+			batchSize:         5,
+			targetCluster:     &api.Cluster{ClusterID: targetClusterID},
+			wantDeprovisioned: []string{"failed"},
+		},
+		{
+			name: "should retry later without a target cluster",
+			centrals: []*dbapi.CentralRequest{
+				drainingCentral("ready-1", dinosaurConstants.CentralRequestStatusReady),
+			},
+			batchSize: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var migrated, deprovisioned []string
+			drainService := &services.ClusterDrainServiceMock{
+				ListDrainingClustersFunc: func() ([]*api.Cluster, *errors.ServiceError) {
+					return []*api.Cluster{{ClusterID: drainingClusterID}}, nil
+				},
+				ListCentralsFunc: func(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+					return tt.centrals, nil
+				},
+			}
+			migrationService := &services.CentralMigrationServiceMock{
+				StartMigrationFunc: func(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralRequest, *errors.ServiceError) {
+					migrated = append(migrated, centralID)
+					return &dbapi.CentralRequest{}, nil
+				},
+			}
+			dinosaurService := &services.DinosaurServiceMock{
+				UpdateStatusFunc: func(id string, status dinosaurConstants.CentralStatus) (bool, *errors.ServiceError) {
+					require.Equal(t, dinosaurConstants.CentralRequestStatusDeprovision, status)
+					deprovisioned = append(deprovisioned, id)
+					return true, nil
+				},
+			}
+			placementStrategy := &services.ClusterPlacementStrategyMock{
+				FindClusterFunc: func(central *dbapi.CentralRequest) (*api.Cluster, error) {
+					return tt.targetCluster, nil
+				},
+			}
+			mgr := NewClusterDrainManager(drainService, migrationService, dinosaurService, placementStrategy,
+				&config.DataplaneClusterConfig{ClusterDrainBatchSize: tt.batchSize})
+
+			errs := mgr.Reconcile()
+
+			require.Empty(t, errs)
+			assert.Equal(t, tt.wantMigrated, migrated)
+			assert.Equal(t, tt.wantDeprovisioned, deprovisioned)
+		})
+	}
+}
+
+func TestClusterDrainManager_ReconcileContinuesAfterErrors(t *testing.T) {
+	var migrated []string
+	drainService := &services.ClusterDrainServiceMock{
+		ListDrainingClustersFunc: func() ([]*api.Cluster, *errors.ServiceError) {
+			return []*api.Cluster{{ClusterID: drainingClusterID}}, nil
+		},
+		ListCentralsFunc: func(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+			return []*dbapi.CentralRequest{
+				drainingCentral("ready-1", dinosaurConstants.CentralRequestStatusReady),
+				drainingCentral("ready-2", dinosaurConstants.CentralRequestStatusReady),
+			}, nil
+		},
+	}
+	migrationService := &services.CentralMigrationServiceMock{
+		StartMigrationFunc: func(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralRequest, *errors.ServiceError) {
+			if centralID == "ready-1" {
+				return nil, errors.GeneralError("test error")
+			}
+			migrated = append(migrated, centralID)
+			return &dbapi.CentralRequest{}, nil
+		},
+	}
+	placementStrategy := &services.ClusterPlacementStrategyMock{
+		FindClusterFunc: func(central *dbapi.CentralRequest) (*api.Cluster, error) {
+			return &api.Cluster{ClusterID: targetClusterID}, nil
+		},
+	}
+	mgr := NewClusterDrainManager(drainService, migrationService, &services.DinosaurServiceMock{}, placementStrategy,
+		&config.DataplaneClusterConfig{ClusterDrainBatchSize: 1})
+
+	errs := mgr.Reconcile()
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, []string{"ready-2"}, migrated)
+}
@@ -18,6 +18,7 @@ var dinosaurMetricsStatuses = []constants2.CentralStatus{
 	constants2.CentralRequestStatusPreparing,
 	constants2.CentralRequestStatusProvisioning,
 	constants2.CentralRequestStatusReady,
+	constants2.CentralRequestStatusPendingDeletion,
 	constants2.CentralRequestStatusDeprovision,
 	constants2.CentralRequestStatusDeleting,
 	constants2.CentralRequestStatusFailed,
@@ -91,6 +92,13 @@ func (k *DinosaurManager) Reconcile() []error {
 		}
 	}
 
+	// deprovisioning centrals whose deletion grace period is over. This also runs without a configured grace period
+	// to not leave centrals pending deletion behind when the grace period is disabled.
+	if pendingDeletionsError := k.dinosaurService.DeprovisionPendingDeletions(); pendingDeletionsError != nil {
+		wrappedError := errors.Wrap(pendingDeletionsError, "failed to deprovision Central instances pending deletion")
+		encounteredErrors = append(encounteredErrors, wrappedError)
+	}
+
 	return encounteredErrors
 }
 
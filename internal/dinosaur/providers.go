@@ -46,6 +46,9 @@ func ConfigProviders() di.Option {
 		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewFleetshardConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewCentralRequestConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewCentralWatchConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewWebhookConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewRateLimitConfig, di.As(new(environments2.ConfigModule))),
 
 		di.Provide(environments2.Func(ServiceProviders)),
 		di.Provide(migrations.New),
@@ -65,6 +68,15 @@ func ServiceProviders() di.Option {
 		di.Provide(services.NewClusterPlacementStrategy),
 		di.Provide(services.NewDataPlaneClusterService, di.As(new(services.DataPlaneClusterService))),
 		di.Provide(services.NewDataPlaneCentralService, di.As(new(services.DataPlaneCentralService))),
+		di.Provide(services.NewDataPlaneCentralWatchService, di.As(new(environments2.BootService))),
+		di.Provide(services.NewCentralMigrationService),
+		di.Provide(services.NewCentralBackupService),
+		di.Provide(services.NewCentralEventService),
+		di.Provide(services.NewWebhookService),
+		di.Provide(services.NewIdempotencyKeyService),
+		di.Provide(services.NewQuotaOverrideService),
+		di.Provide(services.NewClusterDrainService),
+		di.Provide(services.NewCentralVersionRolloutService),
 		di.Provide(handlers.NewAuthenticationBuilder),
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
@@ -78,6 +90,11 @@ func ServiceProviders() di.Option {
 		di.Provide(dinosaurmgrs.NewReadyDinosaurManager, di.As(new(workers.Worker))),
 		di.Provide(dinosaurmgrs.NewDinosaurCNAMEManager, di.As(new(workers.Worker))),
 		di.Provide(dinosaurmgrs.NewCentralAuthConfigManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewCentralMigrationManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewClusterDrainManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewCentralMaintenanceWindowManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewCentralWebhookManager, di.As(new(workers.Worker))),
+		di.Provide(dinosaurmgrs.NewCentralVersionRolloutManager, di.As(new(workers.Worker))),
 		di.Provide(presenters.NewManagedCentralPresenter),
 	)
 }
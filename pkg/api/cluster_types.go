@@ -172,6 +172,34 @@ type Cluster struct {
 	SupportedInstanceType string `json:"supported_instance_type"`
 	// The cluster is "schedulable" if tenants can be placed there.
 	Schedulable bool `json:"schedulable"`
+	// Cordoned is set by admins to stop placing tenants on the cluster, independent of the configured
+	// Schedulable flag.
+	Cordoned bool `json:"cordoned"`
+	// Draining is set by admins to migrate all tenants of a cordoned cluster to other clusters in the same region.
+	Draining bool `json:"draining"`
+	// Capacity holds the compute capacity of the cluster as last reported by fleetshard. See the
+	// ClusterCapacity data type for the format of JSON stored. Use the `SetCapacity` helper method
+	// to set it.
+	Capacity JSON `json:"capacity"`
+}
+
+// ClusterCapacity describes the compute capacity of a data plane cluster as reported by fleetshard.
+type ClusterCapacity struct {
+	// AllocatableCPU is the sum of allocatable CPU of all schedulable worker nodes, e.g. "24".
+	AllocatableCPU string `json:"allocatableCpu"`
+	// AllocatableMemory is the sum of allocatable memory of all schedulable worker nodes, e.g. "96Gi".
+	AllocatableMemory string `json:"allocatableMemory"`
+	// RequestedCPU is the sum of CPU requests of all running pods on schedulable worker nodes.
+	RequestedCPU string `json:"requestedCpu"`
+	// RequestedMemory is the sum of memory requests of all running pods on schedulable worker nodes.
+	RequestedMemory string `json:"requestedMemory"`
+	// RemainingCentrals is the estimated number of additional Centrals that fit onto the cluster.
+	RemainingCentrals int `json:"remainingCentrals"`
+}
+
+// IsSchedulable returns true if new tenants can be placed on the cluster.
+func (cluster *Cluster) IsSchedulable() bool {
+	return cluster.Schedulable && !cluster.Cordoned
 }
 
 // ClusterList ...
@@ -209,6 +237,7 @@ func (cluster *Cluster) BeforeCreate(tx *gorm.DB) error {
 // CentralOperatorVersion ...
 type CentralOperatorVersion struct {
 	Version         string           `json:"version"`
+	Image           string           `json:"image,omitempty" yaml:"image"`
 	Ready           bool             `json:"ready"`
 	CentralVersions []CentralVersion `json:"centralVersions" yaml:"central_versions"`
 }
@@ -385,3 +414,27 @@ func (cluster *Cluster) SetAvailableCentralOperatorVersions(availableCentralOper
 	cluster.AvailableCentralOperatorVersions = v
 	return nil
 }
+
+// GetCapacity returns the cluster's last reported capacity or an error.
+// nil is returned if the capacity has never been reported.
+func (cluster *Cluster) GetCapacity() (*ClusterCapacity, error) {
+	if len(cluster.Capacity) == 0 || string(cluster.Capacity) == "null" {
+		return nil, nil
+	}
+
+	var capacity ClusterCapacity
+	if err := json.Unmarshal(cluster.Capacity, &capacity); err != nil {
+		return nil, fmt.Errorf("getting cluster capacity: %w", err)
+	}
+	return &capacity, nil
+}
+
+// SetCapacity sets the cluster's reported capacity.
+func (cluster *Cluster) SetCapacity(capacity ClusterCapacity) error {
+	v, err := json.Marshal(capacity)
+	if err != nil {
+		return fmt.Errorf("marshalling cluster capacity: %w", err)
+	}
+	cluster.Capacity = v
+	return nil
+}
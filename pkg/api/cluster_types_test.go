@@ -608,3 +608,59 @@ func TestCompareSemanticVersionsMajorAndMinor(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCapacity(t *testing.T) {
+	capacity := ClusterCapacity{
+		AllocatableCPU:    "24",
+		AllocatableMemory: "96Gi",
+		RequestedCPU:      "8",
+		RequestedMemory:   "32Gi",
+		RemainingCentrals: 16,
+	}
+
+	tests := []struct {
+		name    string
+		cluster func() *Cluster
+		want    *ClusterCapacity
+		wantErr bool
+	}{
+		{
+			name: "When the capacity has been set it is returned",
+			cluster: func() *Cluster {
+				res := Cluster{}
+				if err := res.SetCapacity(capacity); err != nil {
+					panic(err)
+				}
+				return &res
+			},
+			want: &capacity,
+		},
+		{
+			name: "When the capacity has never been reported nil is returned",
+			cluster: func() *Cluster {
+				return &Cluster{}
+			},
+			want: nil,
+		},
+		{
+			name: "When cluster has an invalid JSON an error is returned",
+			cluster: func() *Cluster {
+				return &Cluster{Capacity: []byte(`"keyone": valueone`)}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.cluster().GetCapacity()
+			gotErr := err != nil
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("wantErr: %v got: %v", tt.wantErr, err)
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("want: %v got: %v", tt.want, res)
+			}
+		})
+	}
+}
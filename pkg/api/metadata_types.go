@@ -61,4 +61,6 @@ type PagingMeta struct {
 	Page  int
 	Size  int
 	Total int
+	// NextPageToken selects the page following this one, it is empty on the last page.
+	NextPageToken string
 }
@@ -256,15 +256,27 @@ var _ PrivateAPI = &PrivateAPIMock{}
 //
 //		// make and configure a mocked PrivateAPI
 //		mockedPrivateAPI := &PrivateAPIMock{
+//			GetCentralBackupRequestsFunc: func(ctx context.Context, id string) (private.ManagedCentralBackupRequestList, *http.Response, error) {
+//				panic("mock out the GetCentralBackupRequests method")
+//			},
 //			GetCentralsFunc: func(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error) {
 //				panic("mock out the GetCentrals method")
 //			},
 //			GetDataPlaneClusterAgentConfigFunc: func(ctx context.Context, id string) (private.DataplaneClusterAgentConfig, *http.Response, error) {
 //				panic("mock out the GetDataPlaneClusterAgentConfig method")
 //			},
+//			UpdateAgentClusterStatusFunc: func(ctx context.Context, id string, dataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest) (*http.Response, error) {
+//				panic("mock out the UpdateAgentClusterStatus method")
+//			},
+//			UpdateCentralBackupRequestStatusFunc: func(ctx context.Context, id string, backupRequestId string, managedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest) (*http.Response, error) {
+//				panic("mock out the UpdateCentralBackupRequestStatus method")
+//			},
 //			UpdateCentralClusterStatusFunc: func(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error) {
 //				panic("mock out the UpdateCentralClusterStatus method")
 //			},
+//			WatchCentralsFunc: func(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchEventList, *http.Response, error) {
+//				panic("mock out the WatchCentrals method")
+//			},
 //		}
 //
 //		// use mockedPrivateAPI in code that requires PrivateAPI
@@ -272,17 +284,36 @@ var _ PrivateAPI = &PrivateAPIMock{}
 //
 //	}
 type PrivateAPIMock struct {
+	// GetCentralBackupRequestsFunc mocks the GetCentralBackupRequests method.
+	GetCentralBackupRequestsFunc func(ctx context.Context, id string) (private.ManagedCentralBackupRequestList, *http.Response, error)
+
 	// GetCentralsFunc mocks the GetCentrals method.
 	GetCentralsFunc func(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error)
 
 	// GetDataPlaneClusterAgentConfigFunc mocks the GetDataPlaneClusterAgentConfig method.
 	GetDataPlaneClusterAgentConfigFunc func(ctx context.Context, id string) (private.DataplaneClusterAgentConfig, *http.Response, error)
 
+	// UpdateAgentClusterStatusFunc mocks the UpdateAgentClusterStatus method.
+	UpdateAgentClusterStatusFunc func(ctx context.Context, id string, dataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest) (*http.Response, error)
+
+	// UpdateCentralBackupRequestStatusFunc mocks the UpdateCentralBackupRequestStatus method.
+	UpdateCentralBackupRequestStatusFunc func(ctx context.Context, id string, backupRequestId string, managedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest) (*http.Response, error)
+
 	// UpdateCentralClusterStatusFunc mocks the UpdateCentralClusterStatus method.
 	UpdateCentralClusterStatusFunc func(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error)
 
+	// WatchCentralsFunc mocks the WatchCentrals method.
+	WatchCentralsFunc func(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchEventList, *http.Response, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetCentralBackupRequests holds details about calls to the GetCentralBackupRequests method.
+		GetCentralBackupRequests []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
 		// GetCentrals holds details about calls to the GetCentrals method.
 		GetCentrals []struct {
 			// Ctx is the ctx argument value.
@@ -297,6 +328,26 @@ type PrivateAPIMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// UpdateAgentClusterStatus holds details about calls to the UpdateAgentClusterStatus method.
+		UpdateAgentClusterStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// DataPlaneClusterUpdateStatusRequest is the dataPlaneClusterUpdateStatusRequest argument value.
+			DataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest
+		}
+		// UpdateCentralBackupRequestStatus holds details about calls to the UpdateCentralBackupRequestStatus method.
+		UpdateCentralBackupRequestStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// BackupRequestId is the backupRequestId argument value.
+			BackupRequestId string
+			// ManagedCentralBackupRequestStatusUpdateRequest is the managedCentralBackupRequestStatusUpdateRequest argument value.
+			ManagedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest
+		}
 		// UpdateCentralClusterStatus holds details about calls to the UpdateCentralClusterStatus method.
 		UpdateCentralClusterStatus []struct {
 			// Ctx is the ctx argument value.
@@ -306,10 +357,59 @@ type PrivateAPIMock struct {
 			// RequestBody is the requestBody argument value.
 			RequestBody map[string]private.DataPlaneCentralStatus
 		}
+		// WatchCentrals holds details about calls to the WatchCentrals method.
+		WatchCentrals []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *private.WatchCentralsOpts
+		}
+	}
+	lockGetCentralBackupRequests         sync.RWMutex
+	lockGetCentrals                      sync.RWMutex
+	lockGetDataPlaneClusterAgentConfig   sync.RWMutex
+	lockUpdateAgentClusterStatus         sync.RWMutex
+	lockUpdateCentralBackupRequestStatus sync.RWMutex
+	lockUpdateCentralClusterStatus       sync.RWMutex
+	lockWatchCentrals                    sync.RWMutex
+}
+
+// GetCentralBackupRequests calls GetCentralBackupRequestsFunc.
+func (mock *PrivateAPIMock) GetCentralBackupRequests(ctx context.Context, id string) (private.ManagedCentralBackupRequestList, *http.Response, error) {
+	if mock.GetCentralBackupRequestsFunc == nil {
+		panic("PrivateAPIMock.GetCentralBackupRequestsFunc: method is nil but PrivateAPI.GetCentralBackupRequests was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
 	}
-	lockGetCentrals                    sync.RWMutex
-	lockGetDataPlaneClusterAgentConfig sync.RWMutex
-	lockUpdateCentralClusterStatus     sync.RWMutex
+	mock.lockGetCentralBackupRequests.Lock()
+	mock.calls.GetCentralBackupRequests = append(mock.calls.GetCentralBackupRequests, callInfo)
+	mock.lockGetCentralBackupRequests.Unlock()
+	return mock.GetCentralBackupRequestsFunc(ctx, id)
+}
+
+// GetCentralBackupRequestsCalls gets all the calls that were made to GetCentralBackupRequests.
+// Check the length with:
+//
+//	len(mockedPrivateAPI.GetCentralBackupRequestsCalls())
+func (mock *PrivateAPIMock) GetCentralBackupRequestsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetCentralBackupRequests.RLock()
+	calls = mock.calls.GetCentralBackupRequests
+	mock.lockGetCentralBackupRequests.RUnlock()
+	return calls
 }
 
 // GetCentrals calls GetCentralsFunc.
@@ -384,6 +484,90 @@ func (mock *PrivateAPIMock) GetDataPlaneClusterAgentConfigCalls() []struct {
 	return calls
 }
 
+// UpdateAgentClusterStatus calls UpdateAgentClusterStatusFunc.
+func (mock *PrivateAPIMock) UpdateAgentClusterStatus(ctx context.Context, id string, dataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest) (*http.Response, error) {
+	if mock.UpdateAgentClusterStatusFunc == nil {
+		panic("PrivateAPIMock.UpdateAgentClusterStatusFunc: method is nil but PrivateAPI.UpdateAgentClusterStatus was just called")
+	}
+	callInfo := struct {
+		Ctx                                 context.Context
+		ID                                  string
+		DataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest
+	}{
+		Ctx:                                 ctx,
+		ID:                                  id,
+		DataPlaneClusterUpdateStatusRequest: dataPlaneClusterUpdateStatusRequest,
+	}
+	mock.lockUpdateAgentClusterStatus.Lock()
+	mock.calls.UpdateAgentClusterStatus = append(mock.calls.UpdateAgentClusterStatus, callInfo)
+	mock.lockUpdateAgentClusterStatus.Unlock()
+	return mock.UpdateAgentClusterStatusFunc(ctx, id, dataPlaneClusterUpdateStatusRequest)
+}
+
+// UpdateAgentClusterStatusCalls gets all the calls that were made to UpdateAgentClusterStatus.
+// Check the length with:
+//
+//	len(mockedPrivateAPI.UpdateAgentClusterStatusCalls())
+func (mock *PrivateAPIMock) UpdateAgentClusterStatusCalls() []struct {
+	Ctx                                 context.Context
+	ID                                  string
+	DataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest
+} {
+	var calls []struct {
+		Ctx                                 context.Context
+		ID                                  string
+		DataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest
+	}
+	mock.lockUpdateAgentClusterStatus.RLock()
+	calls = mock.calls.UpdateAgentClusterStatus
+	mock.lockUpdateAgentClusterStatus.RUnlock()
+	return calls
+}
+
+// UpdateCentralBackupRequestStatus calls UpdateCentralBackupRequestStatusFunc.
+func (mock *PrivateAPIMock) UpdateCentralBackupRequestStatus(ctx context.Context, id string, backupRequestId string, managedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest) (*http.Response, error) {
+	if mock.UpdateCentralBackupRequestStatusFunc == nil {
+		panic("PrivateAPIMock.UpdateCentralBackupRequestStatusFunc: method is nil but PrivateAPI.UpdateCentralBackupRequestStatus was just called")
+	}
+	callInfo := struct {
+		Ctx                                            context.Context
+		ID                                             string
+		BackupRequestId                                string
+		ManagedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest
+	}{
+		Ctx:             ctx,
+		ID:              id,
+		BackupRequestId: backupRequestId,
+		ManagedCentralBackupRequestStatusUpdateRequest: managedCentralBackupRequestStatusUpdateRequest,
+	}
+	mock.lockUpdateCentralBackupRequestStatus.Lock()
+	mock.calls.UpdateCentralBackupRequestStatus = append(mock.calls.UpdateCentralBackupRequestStatus, callInfo)
+	mock.lockUpdateCentralBackupRequestStatus.Unlock()
+	return mock.UpdateCentralBackupRequestStatusFunc(ctx, id, backupRequestId, managedCentralBackupRequestStatusUpdateRequest)
+}
+
+// UpdateCentralBackupRequestStatusCalls gets all the calls that were made to UpdateCentralBackupRequestStatus.
+// Check the length with:
+//
+//	len(mockedPrivateAPI.UpdateCentralBackupRequestStatusCalls())
+func (mock *PrivateAPIMock) UpdateCentralBackupRequestStatusCalls() []struct {
+	Ctx                                            context.Context
+	ID                                             string
+	BackupRequestId                                string
+	ManagedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest
+} {
+	var calls []struct {
+		Ctx                                            context.Context
+		ID                                             string
+		BackupRequestId                                string
+		ManagedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest
+	}
+	mock.lockUpdateCentralBackupRequestStatus.RLock()
+	calls = mock.calls.UpdateCentralBackupRequestStatus
+	mock.lockUpdateCentralBackupRequestStatus.RUnlock()
+	return calls
+}
+
 // UpdateCentralClusterStatus calls UpdateCentralClusterStatusFunc.
 func (mock *PrivateAPIMock) UpdateCentralClusterStatus(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error) {
 	if mock.UpdateCentralClusterStatusFunc == nil {
@@ -424,6 +608,46 @@ func (mock *PrivateAPIMock) UpdateCentralClusterStatusCalls() []struct {
 	return calls
 }
 
+// WatchCentrals calls WatchCentralsFunc.
+func (mock *PrivateAPIMock) WatchCentrals(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchEventList, *http.Response, error) {
+	if mock.WatchCentralsFunc == nil {
+		panic("PrivateAPIMock.WatchCentralsFunc: method is nil but PrivateAPI.WatchCentrals was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *private.WatchCentralsOpts
+	}{
+		Ctx:               ctx,
+		ID:                id,
+		LocalVarOptionals: localVarOptionals,
+	}
+	mock.lockWatchCentrals.Lock()
+	mock.calls.WatchCentrals = append(mock.calls.WatchCentrals, callInfo)
+	mock.lockWatchCentrals.Unlock()
+	return mock.WatchCentralsFunc(ctx, id, localVarOptionals)
+}
+
+// WatchCentralsCalls gets all the calls that were made to WatchCentrals.
+// Check the length with:
+//
+//	len(mockedPrivateAPI.WatchCentralsCalls())
+func (mock *PrivateAPIMock) WatchCentralsCalls() []struct {
+	Ctx               context.Context
+	ID                string
+	LocalVarOptionals *private.WatchCentralsOpts
+} {
+	var calls []struct {
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *private.WatchCentralsOpts
+	}
+	mock.lockWatchCentrals.RLock()
+	calls = mock.calls.WatchCentrals
+	mock.lockWatchCentrals.RUnlock()
+	return calls
+}
+
 // Ensure, that AdminAPIMock does implement AdminAPI.
 // If this is not the case, regenerate this file with moq.
 var _ AdminAPI = &AdminAPIMock{}
@@ -437,13 +661,13 @@ var _ AdminAPI = &AdminAPIMock{}
 //			CreateCentralFunc: func(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload) (admin.CentralRequest, *http.Response, error) {
 //				panic("mock out the CreateCentral method")
 //			},
-//			DeleteDbCentralByIdFunc: func(ctx context.Context, id string) (*http.Response, error) {
+//			DeleteDbCentralByIdFunc: func(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error) {
 //				panic("mock out the DeleteDbCentralById method")
 //			},
 //			GetCentralsFunc: func(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error) {
 //				panic("mock out the GetCentrals method")
 //			},
-//			UpdateCentralByIdFunc: func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error) {
+//			UpdateCentralByIdFunc: func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error) {
 //				panic("mock out the UpdateCentralById method")
 //			},
 //		}
@@ -457,13 +681,13 @@ type AdminAPIMock struct {
 	CreateCentralFunc func(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload) (admin.CentralRequest, *http.Response, error)
 
 	// DeleteDbCentralByIdFunc mocks the DeleteDbCentralById method.
-	DeleteDbCentralByIdFunc func(ctx context.Context, id string) (*http.Response, error)
+	DeleteDbCentralByIdFunc func(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error)
 
 	// GetCentralsFunc mocks the GetCentrals method.
 	GetCentralsFunc func(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error)
 
 	// UpdateCentralByIdFunc mocks the UpdateCentralById method.
-	UpdateCentralByIdFunc func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error)
+	UpdateCentralByIdFunc func(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -482,6 +706,8 @@ type AdminAPIMock struct {
 			Ctx context.Context
 			// ID is the id argument value.
 			ID string
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 		}
 		// GetCentrals holds details about calls to the GetCentrals method.
 		GetCentrals []struct {
@@ -498,6 +724,8 @@ type AdminAPIMock struct {
 			ID string
 			// CentralUpdateRequest is the centralUpdateRequest argument value.
 			CentralUpdateRequest admin.CentralUpdateRequest
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *admin.UpdateCentralByIdOpts
 		}
 	}
 	lockCreateCentral       sync.RWMutex
@@ -547,21 +775,23 @@ func (mock *AdminAPIMock) CreateCentralCalls() []struct {
 }
 
 // DeleteDbCentralById calls DeleteDbCentralByIdFunc.
-func (mock *AdminAPIMock) DeleteDbCentralById(ctx context.Context, id string) (*http.Response, error) {
+func (mock *AdminAPIMock) DeleteDbCentralById(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error) {
 	if mock.DeleteDbCentralByIdFunc == nil {
 		panic("AdminAPIMock.DeleteDbCentralByIdFunc: method is nil but AdminAPI.DeleteDbCentralById was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		ID  string
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 	}{
-		Ctx: ctx,
-		ID:  id,
+		Ctx:               ctx,
+		ID:                id,
+		LocalVarOptionals: localVarOptionals,
 	}
 	mock.lockDeleteDbCentralById.Lock()
 	mock.calls.DeleteDbCentralById = append(mock.calls.DeleteDbCentralById, callInfo)
 	mock.lockDeleteDbCentralById.Unlock()
-	return mock.DeleteDbCentralByIdFunc(ctx, id)
+	return mock.DeleteDbCentralByIdFunc(ctx, id, localVarOptionals)
 }
 
 // DeleteDbCentralByIdCalls gets all the calls that were made to DeleteDbCentralById.
@@ -569,12 +799,14 @@ func (mock *AdminAPIMock) DeleteDbCentralById(ctx context.Context, id string) (*
 //
 //	len(mockedAdminAPI.DeleteDbCentralByIdCalls())
 func (mock *AdminAPIMock) DeleteDbCentralByIdCalls() []struct {
-	Ctx context.Context
-	ID  string
+	Ctx               context.Context
+	ID                string
+	LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 } {
 	var calls []struct {
-		Ctx context.Context
-		ID  string
+		Ctx               context.Context
+		ID                string
+		LocalVarOptionals *admin.DeleteDbCentralByIdOpts
 	}
 	mock.lockDeleteDbCentralById.RLock()
 	calls = mock.calls.DeleteDbCentralById
@@ -619,7 +851,7 @@ func (mock *AdminAPIMock) GetCentralsCalls() []struct {
 }
 
 // UpdateCentralById calls UpdateCentralByIdFunc.
-func (mock *AdminAPIMock) UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error) {
+func (mock *AdminAPIMock) UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error) {
 	if mock.UpdateCentralByIdFunc == nil {
 		panic("AdminAPIMock.UpdateCentralByIdFunc: method is nil but AdminAPI.UpdateCentralById was just called")
 	}
@@ -627,15 +859,17 @@ func (mock *AdminAPIMock) UpdateCentralById(ctx context.Context, id string, cent
 		Ctx                  context.Context
 		ID                   string
 		CentralUpdateRequest admin.CentralUpdateRequest
+		LocalVarOptionals    *admin.UpdateCentralByIdOpts
 	}{
 		Ctx:                  ctx,
 		ID:                   id,
 		CentralUpdateRequest: centralUpdateRequest,
+		LocalVarOptionals:    localVarOptionals,
 	}
 	mock.lockUpdateCentralById.Lock()
 	mock.calls.UpdateCentralById = append(mock.calls.UpdateCentralById, callInfo)
 	mock.lockUpdateCentralById.Unlock()
-	return mock.UpdateCentralByIdFunc(ctx, id, centralUpdateRequest)
+	return mock.UpdateCentralByIdFunc(ctx, id, centralUpdateRequest, localVarOptionals)
 }
 
 // UpdateCentralByIdCalls gets all the calls that were made to UpdateCentralById.
@@ -646,11 +880,13 @@ func (mock *AdminAPIMock) UpdateCentralByIdCalls() []struct {
 	Ctx                  context.Context
 	ID                   string
 	CentralUpdateRequest admin.CentralUpdateRequest
+	LocalVarOptionals    *admin.UpdateCentralByIdOpts
 } {
 	var calls []struct {
 		Ctx                  context.Context
 		ID                   string
 		CentralUpdateRequest admin.CentralUpdateRequest
+		LocalVarOptionals    *admin.UpdateCentralByIdOpts
 	}
 	mock.lockUpdateCentralById.RLock()
 	calls = mock.calls.UpdateCentralById
@@ -25,15 +25,19 @@ type PublicAPI interface {
 type PrivateAPI interface {
 	GetDataPlaneClusterAgentConfig(ctx context.Context, id string) (private.DataplaneClusterAgentConfig, *http.Response, error)
 	GetCentrals(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error)
+	WatchCentrals(ctx context.Context, id string, localVarOptionals *private.WatchCentralsOpts) (private.ManagedCentralWatchEventList, *http.Response, error)
 	UpdateCentralClusterStatus(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error)
+	UpdateAgentClusterStatus(ctx context.Context, id string, dataPlaneClusterUpdateStatusRequest private.DataPlaneClusterUpdateStatusRequest) (*http.Response, error)
+	GetCentralBackupRequests(ctx context.Context, id string) (private.ManagedCentralBackupRequestList, *http.Response, error)
+	UpdateCentralBackupRequestStatus(ctx context.Context, id string, backupRequestId string, managedCentralBackupRequestStatusUpdateRequest private.ManagedCentralBackupRequestStatusUpdateRequest) (*http.Response, error)
 }
 
 // AdminAPI is a wrapper interface for the fleetmanager client admin API.
 type AdminAPI interface {
 	GetCentrals(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error)
 	CreateCentral(ctx context.Context, async bool, centralRequestPayload admin.CentralRequestPayload) (admin.CentralRequest, *http.Response, error)
-	UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest) (admin.Central, *http.Response, error)
-	DeleteDbCentralById(ctx context.Context, id string) (*http.Response, error)
+	UpdateCentralById(ctx context.Context, id string, centralUpdateRequest admin.CentralUpdateRequest, localVarOptionals *admin.UpdateCentralByIdOpts) (admin.Central, *http.Response, error)
+	DeleteDbCentralById(ctx context.Context, id string, localVarOptionals *admin.DeleteDbCentralByIdOpts) (*http.Response, error)
 }
 
 var (
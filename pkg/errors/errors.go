@@ -193,6 +193,22 @@ const (
 	ErrorInstancePlanNotSupported       ServiceErrorCode = 42
 	ErrorInstancePlanNotSupportedReason string           = "Instance plan not supported"
 
+	// Idempotency key reused with a different request
+	ErrorIdempotencyKeyReused       ServiceErrorCode = 43
+	ErrorIdempotencyKeyReusedReason string           = "Idempotency key was already used for a different request"
+
+	// Rate limit of the organisation exceeded
+	ErrorRateLimitExceeded       ServiceErrorCode = 44
+	ErrorRateLimitExceededReason string           = "Rate limit exceeded"
+
+	// Precondition of a conditional request, e.g. If-Match, is not met
+	ErrorPreconditionFailed       ServiceErrorCode = 45
+	ErrorPreconditionFailedReason string           = "Precondition failed"
+
+	// Central is protected against deletion
+	ErrorDeletionProtected       ServiceErrorCode = 46
+	ErrorDeletionProtectedReason string           = "Central is protected against deletion"
+
 	// Too Many requests error. Used by rate limiting
 	ErrorTooManyRequests       ServiceErrorCode = 429
 	ErrorTooManyRequestsReason string           = "Too Many requests"
@@ -274,6 +290,10 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorMaxLimitForServiceAccountsReached, ErrorMaxLimitForServiceAccountsReachedReason, http.StatusForbidden, nil},
 		ServiceError{ErrorInstancePlanNotSupported, ErrorInstancePlanNotSupportedReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorInvalidCloudAccountID, ErrorInvalidCloudAccountIDReason, http.StatusBadRequest, nil},
+		ServiceError{ErrorIdempotencyKeyReused, ErrorIdempotencyKeyReusedReason, http.StatusUnprocessableEntity, nil},
+		ServiceError{ErrorRateLimitExceeded, ErrorRateLimitExceededReason, http.StatusTooManyRequests, nil},
+		ServiceError{ErrorPreconditionFailed, ErrorPreconditionFailedReason, http.StatusPreconditionFailed, nil},
+		ServiceError{ErrorDeletionProtected, ErrorDeletionProtectedReason, http.StatusConflict, nil},
 	}
 }
 
@@ -589,6 +609,16 @@ func BadRequest(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorBadRequest, reason, values...)
 }
 
+// PreconditionFailed ...
+func PreconditionFailed(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorPreconditionFailed, reason, values...)
+}
+
+// DeletionProtected ...
+func DeletionProtected(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorDeletionProtected, reason, values...)
+}
+
 // FailedToParseSearch ...
 func FailedToParseSearch(reason string, values ...interface{}) *ServiceError {
 	message := fmt.Sprintf("%s: %s", ErrorFailedToParseSearchReason, reason)
@@ -1,9 +1,17 @@
 package handlers
 
 import (
+	"encoding/json"
 	"reflect"
+	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
 )
 
+// listItemIdentityFields are returned for every item of a list, regardless of the requested fields.
+var listItemIdentityFields = []string{"id", "kind", "href"}
+
 // DetermineListRange Prepare a 'list' of non-db-backed resources
 func DetermineListRange(obj interface{}, page int, size int) (list []interface{}, total int) {
 	items := reflect.ValueOf(obj)
@@ -20,3 +28,71 @@ func DetermineListRange(obj interface{}, page int, size int) (list []interface{}
 
 	return list, total
 }
+
+// ProjectListFields restricts the items of a list response to the given JSON fields. The id, kind and href of
+// the items are always returned. list must be a struct with an Items slice, it is returned unchanged if no fields
+// are given.
+func ProjectListFields(list interface{}, fields []string) (interface{}, *errors.ServiceError) {
+	if len(fields) == 0 {
+		return list, nil
+	}
+	itemsField, ok := reflect.TypeOf(list).FieldByName("Items")
+	if !ok || itemsField.Type.Kind() != reflect.Slice {
+		return nil, errors.GeneralError("%T is not a list", list)
+	}
+	known := jsonFieldNames(itemsField.Type.Elem())
+	selected := map[string]bool{}
+	for _, field := range append(fields, listItemIdentityFields...) {
+		if !known[field] && !shared.Contains(listItemIdentityFields, field) {
+			return nil, errors.BadRequest("unknown field '%s'", field)
+		}
+		selected[field] = true
+	}
+
+	b, err := json.Marshal(list)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to select fields of %T", list)
+	}
+	var projected map[string]interface{}
+	if err := json.Unmarshal(b, &projected); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to select fields of %T", list)
+	}
+	items, _ := projected["items"].([]interface{})
+	for _, item := range items {
+		if item, ok := item.(map[string]interface{}); ok {
+			for field := range item {
+				if !selected[field] {
+					delete(item, field)
+				}
+			}
+		}
+	}
+	return projected, nil
+}
+
+// jsonFieldNames returns the names of the JSON fields of a struct type.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	names := map[string]bool{}
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch {
+		case name == "-" || !field.IsExported():
+		case name == "" && field.Anonymous:
+			for embedded := range jsonFieldNames(field.Type) {
+				names[embedded] = true
+			}
+		case name == "":
+			names[field.Name] = true
+		default:
+			names[name] = true
+		}
+	}
+	return names
+}
@@ -37,6 +37,11 @@ const (
 	CentralRequestsStatusSinceCreated = "central_requests_status_since_created_in_seconds"
 	CentralRequestsStatusCount        = "central_requests_status_count"
 
+	// CentralPlacementDecisionCount - metric name for the number of Central placement decisions
+	CentralPlacementDecisionCount = "central_placement_decision_count"
+	labelPlacementStrategy        = "strategy"
+	labelPlacementReason          = "reason"
+
 	// ClusterOperationsSuccessCount - name of the metric for cluster-related successful operations
 	ClusterOperationsSuccessCount = "cluster_operations_success_count"
 	// ClusterOperationsTotalCount - name of the metric for all cluster-related operations
@@ -76,6 +81,22 @@ const (
 	LabelMethod     = "method"
 	LabelPath       = "path"
 
+	// WebhookDeliveriesTotal - metric name for the number of webhook delivery attempts
+	WebhookDeliveriesTotal = "webhook_deliveries_total"
+	// WebhookDeliveryDuration - metric name for the webhook delivery request duration in seconds
+	WebhookDeliveryDuration = "webhook_delivery_duration_in_seconds"
+	// WebhookDeliveriesPending - metric name for the number of webhook deliveries waiting for an attempt
+	WebhookDeliveriesPending = "webhook_deliveries_pending"
+	labelWebhookEventType    = "event_type"
+	labelWebhookResult       = "result"
+
+	// RateLimitedRequestsTotal - metric name for the number of API requests rejected by the per organisation rate limits
+	RateLimitedRequestsTotal = "rate_limited_requests_total"
+	// RateLimitConcurrentRequests - metric name for the number of API requests in progress that are subject to rate limits
+	RateLimitConcurrentRequests = "rate_limit_concurrent_requests"
+	labelRateLimitClass         = "class"
+	labelRateLimitReason        = "reason"
+
 	LabelDatabaseQueryStatus = "status"
 	LabelDatabaseQueryType   = "query"
 	LabelRegion              = "region"
@@ -465,6 +486,34 @@ func IncreaseCentralTotalOperationsCountMetric(operation constants2.CentralOpera
 	centralOperationsTotalCountMetric.With(labels).Inc()
 }
 
+// centralPlacementDecisionCountMetricLabels is the slice of labels to add to the Central placement decision metric
+var centralPlacementDecisionCountMetricLabels = []string{
+	labelPlacementStrategy,
+	labelPlacementReason,
+	LabelClusterID,
+}
+
+// create a new counterVec for Central placement decisions
+var centralPlacementDecisionCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      CentralPlacementDecisionCount,
+		Help:      "number of Central placement decisions by strategy, reason and selected cluster",
+	},
+	centralPlacementDecisionCountMetricLabels,
+)
+
+// IncreaseCentralPlacementDecisionCountMetric - increase counter for the centralPlacementDecisionCountMetric.
+// An empty clusterID denotes that no suitable cluster has been found.
+func IncreaseCentralPlacementDecisionCountMetric(strategy, reason, clusterID string) {
+	labels := prometheus.Labels{
+		labelPlacementStrategy: strategy,
+		labelPlacementReason:   reason,
+		LabelClusterID:         clusterID,
+	}
+	centralPlacementDecisionCountMetric.With(labels).Inc()
+}
+
 // #### Metrics for Centrals - End ####
 
 // #### Metrics for Reconcilers - Start ####
@@ -612,6 +661,113 @@ func UpdateObservatoriumRequestDurationMetric(code int, path, method string, ela
 
 // #### Metrics for Observatorium - End ####
 
+// #### Metrics for Webhooks ####
+
+// WebhookDeliveryResult is the outcome of a webhook delivery attempt
+type WebhookDeliveryResult string
+
+const (
+	// WebhookDeliveryResultSuccess - the receiver accepted the notification
+	WebhookDeliveryResultSuccess WebhookDeliveryResult = "success"
+	// WebhookDeliveryResultFailure - the attempt failed and will be retried
+	WebhookDeliveryResultFailure WebhookDeliveryResult = "failure"
+	// WebhookDeliveryResultDeadLetter - the last attempt failed and the notification was moved to the dead letters
+	WebhookDeliveryResultDeadLetter WebhookDeliveryResult = "dead_letter"
+)
+
+var webhookDeliveriesTotalMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: FleetManager,
+	Name:      WebhookDeliveriesTotal,
+	Help:      "number of webhook delivery attempts partitioned by event type and result",
+}, []string{labelWebhookEventType, labelWebhookResult})
+
+// IncreaseWebhookDeliveriesTotal increases the webhook delivery attempt count metric with the following labels:
+//   - event_type: the type of the notification (i.e. "central.ready")
+//   - result: the outcome of the attempt (i.e. "success", "failure" or "dead_letter")
+func IncreaseWebhookDeliveriesTotal(eventType string, result WebhookDeliveryResult) {
+	labels := prometheus.Labels{
+		labelWebhookEventType: eventType,
+		labelWebhookResult:    string(result),
+	}
+	webhookDeliveriesTotalMetric.With(labels).Inc()
+}
+
+var webhookDeliveryDurationMetric = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Subsystem: FleetManager,
+		Name:      WebhookDeliveryDuration,
+		Help:      "Webhook delivery request duration in seconds.",
+		Buckets:   []float64{0.1, 0.5, 1.0, 5.0, 10.0, 30.0},
+	},
+	[]string{labelWebhookEventType},
+)
+
+// UpdateWebhookDeliveryDurationMetric observes the duration of a webhook delivery request
+func UpdateWebhookDeliveryDurationMetric(eventType string, elapsed time.Duration) {
+	labels := prometheus.Labels{
+		labelWebhookEventType: eventType,
+	}
+	webhookDeliveryDurationMetric.With(labels).Observe(elapsed.Seconds())
+}
+
+var webhookDeliveriesPendingMetric = prometheus.NewGauge(prometheus.GaugeOpts{
+	Subsystem: FleetManager,
+	Name:      WebhookDeliveriesPending,
+	Help:      "number of webhook deliveries waiting for an attempt",
+})
+
+// UpdateWebhookDeliveriesPendingMetric sets the number of webhook deliveries waiting for an attempt
+func UpdateWebhookDeliveriesPendingMetric(count int64) {
+	webhookDeliveriesPendingMetric.Set(float64(count))
+}
+
+// #### Metrics for Webhooks - End ####
+
+// #### Metrics for Rate Limits ####
+
+// RateLimitReason is the limit that caused an API request to be rejected
+type RateLimitReason string
+
+const (
+	// RateLimitReasonRate - the organisation exceeded the rate of requests
+	RateLimitReasonRate RateLimitReason = "rate"
+	// RateLimitReasonConcurrency - the organisation exceeded the number of concurrent requests
+	RateLimitReasonConcurrency RateLimitReason = "concurrency"
+)
+
+var rateLimitedRequestsTotalMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: FleetManager,
+	Name:      RateLimitedRequestsTotal,
+	Help:      "number of API requests rejected by the per organisation rate limits partitioned by route class and reason",
+}, []string{labelRateLimitClass, labelRateLimitReason})
+
+// IncreaseRateLimitedRequestsTotal increases the rejected API request count metric with the following labels:
+//   - class: the route class of the request (i.e. "central-create" or "metrics")
+//   - reason: the exceeded limit (i.e. "rate" or "concurrency")
+func IncreaseRateLimitedRequestsTotal(class string, reason RateLimitReason) {
+	labels := prometheus.Labels{
+		labelRateLimitClass:  class,
+		labelRateLimitReason: string(reason),
+	}
+	rateLimitedRequestsTotalMetric.With(labels).Inc()
+}
+
+var rateLimitConcurrentRequestsMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: FleetManager,
+	Name:      RateLimitConcurrentRequests,
+	Help:      "number of API requests in progress that are subject to rate limits partitioned by route class",
+}, []string{labelRateLimitClass})
+
+// AddRateLimitConcurrentRequests adds delta to the number of API requests in progress of the route class
+func AddRateLimitConcurrentRequests(class string, delta int) {
+	labels := prometheus.Labels{
+		labelRateLimitClass: class,
+	}
+	rateLimitConcurrentRequestsMetric.With(labels).Add(float64(delta))
+}
+
+// #### Metrics for Rate Limits - End ####
+
 // #### Metrics for Database ####
 
 // register database query count metric
@@ -708,6 +864,7 @@ func init() {
 	prometheus.MustRegister(centralOperationsTotalCountMetric)
 	prometheus.MustRegister(centralStatusSinceCreatedMetric)
 	prometheus.MustRegister(CentralStatusCountMetric)
+	prometheus.MustRegister(centralPlacementDecisionCountMetric)
 
 	// metrics for reconcilers
 	prometheus.MustRegister(reconcilerDurationMetric)
@@ -721,6 +878,15 @@ func init() {
 	prometheus.MustRegister(observatoriumRequestCountMetric)
 	prometheus.MustRegister(observatoriumRequestDurationMetric)
 
+	// metrics for webhooks
+	prometheus.MustRegister(webhookDeliveriesTotalMetric)
+	prometheus.MustRegister(webhookDeliveryDurationMetric)
+	prometheus.MustRegister(webhookDeliveriesPendingMetric)
+
+	// metrics for rate limits
+	prometheus.MustRegister(rateLimitedRequestsTotalMetric)
+	prometheus.MustRegister(rateLimitConcurrentRequestsMetric)
+
 	// metrics for database
 	prometheus.MustRegister(databaseRequestCountMetric)
 	prometheus.MustRegister(databaseQueryDurationMetric)
@@ -775,6 +941,7 @@ func Reset() {
 	centralOperationsTotalCountMetric.Reset()
 	centralStatusSinceCreatedMetric.Reset()
 	CentralStatusCountMetric.Reset()
+	centralPlacementDecisionCountMetric.Reset()
 
 	reconcilerDurationMetric.Reset()
 	reconcilerSuccessCountMetric.Reset()
@@ -785,6 +952,12 @@ func Reset() {
 
 	ResetMetricsForObservatorium()
 
+	webhookDeliveriesTotalMetric.Reset()
+	webhookDeliveryDurationMetric.Reset()
+	webhookDeliveriesPendingMetric.Set(0)
+	rateLimitedRequestsTotalMetric.Reset()
+	rateLimitConcurrentRequestsMetric.Reset()
+
 	databaseRequestCountMetric.Reset()
 	databaseQueryDurationMetric.Reset()
 }
@@ -114,6 +114,10 @@ func NewAPIServer(options ServerOptions) *APIServer {
 		gorillahandlers.AllowedHeaders([]string{
 			"Authorization",
 			"Content-Type",
+			"If-Match",
+		}),
+		gorillahandlers.ExposedHeaders([]string{
+			"ETag",
 		}),
 		gorillahandlers.MaxAge(int((10 * time.Minute).Seconds())),
 	)(mainHandler)
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// ParseLabelSelector parses a Kubernetes-style label selector, e.g. "env=prod,team in (secops,platform),!deprecated".
+// The numeric comparisons gt and lt are not supported.
+func ParseLabelSelector(selector string) (labels.Requirements, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing label selector: %w", err)
+	}
+	requirements, _ := parsed.Requirements()
+	for _, requirement := range requirements {
+		switch requirement.Operator() {
+		case selection.GreaterThan, selection.LessThan:
+			return nil, fmt.Errorf("label selector operator %q is not supported", requirement.Operator())
+		}
+	}
+	return requirements, nil
+}
+
+// LabelSelectorCondition returns the condition selecting the rows whose labels, stored as JSON object in column, match
+// the label selector. As in Kubernetes, rows without a label match the != and notin requirements of the label.
+func LabelSelectorCondition(column, selector string) (string, []interface{}, error) {
+	requirements, err := ParseLabelSelector(selector)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var conditions []string
+	var args []interface{}
+	label := column + " ->> ?"
+	for _, requirement := range requirements {
+		key := requirement.Key()
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			conditions = append(conditions, label+" IN (?)")
+			args = append(args, key, requirement.Values().List())
+		case selection.NotEquals, selection.NotIn:
+			conditions = append(conditions, "("+label+" IS NULL OR "+label+" NOT IN (?))")
+			args = append(args, key, key, requirement.Values().List())
+		case selection.Exists:
+			conditions = append(conditions, label+" IS NOT NULL")
+			args = append(args, key)
+		case selection.DoesNotExist:
+			conditions = append(conditions, label+" IS NULL")
+			args = append(args, key)
+		default:
+			return "", nil, fmt.Errorf("label selector operator %q is not supported", requirement.Operator())
+		}
+	}
+	if len(conditions) == 0 {
+		return "TRUE", nil, nil
+	}
+	return strings.Join(conditions, " AND "), args, nil
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelSelectorCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		selector      string
+		wantCondition string
+		wantArgs      []interface{}
+		wantErr       bool
+	}{
+		{
+			name:          "equality",
+			selector:      "env=prod",
+			wantCondition: "labels ->> ? IN (?)",
+			wantArgs:      []interface{}{"env", []string{"prod"}},
+		},
+		{
+			name:          "set based requirements",
+			selector:      "team in (secops,platform),env notin (dev)",
+			wantCondition: "(labels ->> ? IS NULL OR labels ->> ? NOT IN (?)) AND labels ->> ? IN (?)",
+			wantArgs:      []interface{}{"env", "env", []string{"dev"}, "team", []string{"platform", "secops"}},
+		},
+		{
+			name:          "existence",
+			selector:      "team,!deprecated",
+			wantCondition: "labels ->> ? IS NULL AND labels ->> ? IS NOT NULL",
+			wantArgs:      []interface{}{"deprecated", "team"},
+		},
+		{
+			name:          "empty selector matches everything",
+			selector:      "",
+			wantCondition: "TRUE",
+		},
+		{
+			name:     "numeric comparison is not supported",
+			selector: "replicas>1",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid selector",
+			selector: "env in prod",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, args, err := LabelSelectorCondition("labels", tt.selector)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCondition, condition)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
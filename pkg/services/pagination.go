@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// pageTokenTieBreaker is appended to every order so that items with equal values of the order by fields keep
+// their relative order across pages.
+const pageTokenTieBreaker = "id"
+
+var paginationSchemaCache = &sync.Map{}
+
+// PageToken is the position of a page in a list ordered by a set of columns. It is passed to clients as an
+// opaque string, see Encode and DecodePageToken.
+type PageToken struct {
+	// Page is the number of the page the token points to.
+	Page int `json:"p"`
+	// OrderBy is the order of the list, the token is invalid for lists with a different order.
+	OrderBy string `json:"o"`
+	// After holds the values of the ordering columns of the last item of the previous page.
+	After []json.RawMessage `json:"a"`
+}
+
+// Encode returns the opaque string representation of the token.
+func (t *PageToken) Encode() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshalling page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodePageToken parses a page token returned by Encode.
+func DecodePageToken(s string) (*PageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decoding page token: %w", err)
+	}
+	var token PageToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, fmt.Errorf("unmarshalling page token: %w", err)
+	}
+	if token.Page < 2 || len(token.After) == 0 {
+		return nil, fmt.Errorf("page token does not point to a page")
+	}
+	return &token, nil
+}
+
+type orderByColumn struct {
+	field *schema.Field
+	desc  bool
+}
+
+// ListPage loads a page of the items selected by dbConn into items, which must be a pointer to a slice of gorm
+// models. The items are ordered by the orderBy clauses, e.g. "name desc", followed by the ID so that the order
+// is stable.
+//
+// The page is selected by listArgs.PageToken if set, in which case the items following the last item of the
+// previous page are loaded (keyset pagination). Otherwise, listArgs.Page is used as an offset. The returned
+// paging meta contains the token of the next page if there is one, and the number of items of the page as
+// size.
+func ListPage(dbConn *gorm.DB, listArgs *ListArguments, orderBy []string, items interface{}) (*api.PagingMeta, *errors.ServiceError) {
+	dbConn = dbConn.Session(&gorm.Session{})
+	itemSchema, err := schema.Parse(items, paginationSchemaCache, dbConn.NamingStrategy)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list %T", items)
+	}
+
+	columns, svcErr := parseOrderBy(itemSchema, orderBy)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	orderKey := orderByKey(columns)
+
+	var total int64
+	if err := dbConn.Model(items).Count(&total).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count %s", itemSchema.Table)
+	}
+	pagingMeta := &api.PagingMeta{
+		Page:  listArgs.Page,
+		Total: int(total),
+	}
+
+	for _, column := range columns {
+		dbConn = dbConn.Order(clause.OrderByColumn{Column: clause.Column{Name: column.field.DBName}, Desc: column.desc})
+	}
+	if listArgs.PageToken != "" {
+		token, err := DecodePageToken(listArgs.PageToken)
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorBadRequest, err, "invalid page token")
+		}
+		if token.OrderBy != orderKey || len(token.After) != len(columns) {
+			return nil, errors.BadRequest("page token was issued for a list with a different order")
+		}
+		condition, values, err := keysetCondition(dbConn, columns, token.After)
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorBadRequest, err, "invalid page token")
+		}
+		dbConn = dbConn.Where(condition, values...)
+		pagingMeta.Page = token.Page
+	} else if listArgs.Page > 1 {
+		dbConn = dbConn.Offset((listArgs.Page - 1) * listArgs.Size)
+	}
+
+	// One more item than requested is loaded to find out whether there is a next page.
+	if err := dbConn.Limit(listArgs.Size + 1).Find(items).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list %s", itemSchema.Table)
+	}
+
+	list := reflect.ValueOf(items).Elem()
+	if list.Len() > listArgs.Size {
+		list.Set(list.Slice(0, listArgs.Size))
+		next := &PageToken{Page: pagingMeta.Page + 1, OrderBy: orderKey}
+		if next.Page < 2 {
+			next.Page = 2
+		}
+		last := list.Index(list.Len() - 1)
+		for _, column := range columns {
+			value, _ := column.field.ValueOf(context.Background(), last)
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create page token")
+			}
+			next.After = append(next.After, raw)
+		}
+		if pagingMeta.NextPageToken, err = next.Encode(); err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create page token")
+		}
+	}
+	pagingMeta.Size = list.Len()
+
+	return pagingMeta, nil
+}
+
+// parseOrderBy resolves order by clauses like "name desc" to the columns of the schema.
+func parseOrderBy(itemSchema *schema.Schema, orderBy []string) ([]orderByColumn, *errors.ServiceError) {
+	var columns []orderByColumn
+	hasTieBreaker := false
+	for _, orderByClause := range orderBy {
+		keywords := strings.Fields(strings.ToLower(orderByClause))
+		if len(keywords) == 0 || len(keywords) > 2 {
+			return nil, errors.BadRequest("invalid order by clause '%s'", orderByClause)
+		}
+		field := itemSchema.LookUpField(keywords[0])
+		if field == nil || field.DBName == "" {
+			return nil, errors.BadRequest("unknown order by field '%s'", keywords[0])
+		}
+		columns = append(columns, orderByColumn{field: field, desc: len(keywords) == 2 && keywords[1] == "desc"})
+		hasTieBreaker = hasTieBreaker || field.DBName == pageTokenTieBreaker
+	}
+	if !hasTieBreaker {
+		field := itemSchema.LookUpField(pageTokenTieBreaker)
+		if field == nil {
+			return nil, errors.GeneralError("%s has no %s column", itemSchema.Table, pageTokenTieBreaker)
+		}
+		columns = append(columns, orderByColumn{field: field})
+	}
+	return columns, nil
+}
+
+func orderByKey(columns []orderByColumn) string {
+	var keys []string
+	for _, column := range columns {
+		key := column.field.DBName
+		if column.desc {
+			key += " desc"
+		}
+		keys = append(keys, key)
+	}
+	return strings.Join(keys, ",")
+}
+
+// keysetCondition returns the condition selecting the items that follow the item with the given column values.
+// For columns (a, b) this is "(a > ?) OR (a = ? AND b > ?)", with < for descending columns.
+func keysetCondition(dbConn *gorm.DB, columns []orderByColumn, after []json.RawMessage) (string, []interface{}, error) {
+	var values []interface{}
+	for i, column := range columns {
+		value := reflect.New(column.field.FieldType)
+		if err := json.Unmarshal(after[i], value.Interface()); err != nil {
+			return "", nil, fmt.Errorf("decoding value of %s: %w", column.field.DBName, err)
+		}
+		values = append(values, value.Elem().Interface())
+	}
+
+	var alternatives []string
+	var args []interface{}
+	for i, column := range columns {
+		var conditions []string
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, dbConn.Statement.Quote(columns[j].field.DBName)+" = ?")
+			args = append(args, values[j])
+		}
+		operator := " > ?"
+		if column.desc {
+			operator = " < ?"
+		}
+		conditions = append(conditions, dbConn.Statement.Quote(column.field.DBName)+operator)
+		args = append(args, values[i])
+		alternatives = append(alternatives, "("+strings.Join(conditions, " AND ")+")")
+	}
+	return strings.Join(alternatives, " OR "), args, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type paginatedItem struct {
+	api.Meta
+	Name string
+}
+
+func TestListPage_ReturnsNextPageToken(t *testing.T) {
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT count(*) FROM "paginated_items"`).
+		WithReply([]map[string]interface{}{{"count": 5}})
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "paginated_items" WHERE "paginated_items"."deleted_at" IS NULL ORDER BY "name" DESC,"id" LIMIT 3`).
+		WithReply([]map[string]interface{}{
+			{"id": "a", "name": "c"},
+			{"id": "b", "name": "b"},
+			{"id": "c", "name": "a"},
+		})
+	dbConn := db.NewMockConnectionFactory(nil).New()
+
+	var items []paginatedItem
+	paging, svcErr := ListPage(dbConn, &ListArguments{Page: 1, Size: 2}, []string{"name desc"}, &items)
+	require.Nil(t, svcErr)
+	require.Len(t, items, 2)
+	assert.Equal(t, 1, paging.Page)
+	assert.Equal(t, 2, paging.Size)
+	assert.Equal(t, 5, paging.Total)
+
+	token, err := DecodePageToken(paging.NextPageToken)
+	require.NoError(t, err)
+	assert.Equal(t, 2, token.Page)
+	assert.Equal(t, "name desc,id", token.OrderBy)
+	require.Len(t, token.After, 2)
+	assert.JSONEq(t, `"b"`, string(token.After[0]))
+	assert.JSONEq(t, `"b"`, string(token.After[1]))
+}
+
+func TestListPage_SelectsPageOfToken(t *testing.T) {
+	token, err := (&PageToken{Page: 3, OrderBy: "name desc,id", After: rawValues(`"b"`, `"b"`)}).Encode()
+	require.NoError(t, err)
+
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT count(*) FROM "paginated_items"`).
+		WithReply([]map[string]interface{}{{"count": 5}})
+	list := mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "paginated_items" WHERE (("name" < $1) OR ("name" = $2 AND "id" > $3)) AND "paginated_items"."deleted_at" IS NULL ORDER BY "name" DESC,"id" LIMIT 3`).
+		WithReply([]map[string]interface{}{{"id": "c", "name": "a"}})
+	dbConn := db.NewMockConnectionFactory(nil).New()
+
+	var items []paginatedItem
+	paging, svcErr := ListPage(dbConn, &ListArguments{Page: 1, Size: 2, PageToken: token}, []string{"name desc"}, &items)
+	require.Nil(t, svcErr)
+	assert.True(t, list.Triggered)
+	require.Len(t, items, 1)
+	assert.Equal(t, 3, paging.Page)
+	assert.Equal(t, 1, paging.Size)
+	assert.Empty(t, paging.NextPageToken)
+}
+
+func TestListPage_RejectsInvalidArguments(t *testing.T) {
+	otherOrderToken, err := (&PageToken{Page: 2, OrderBy: "name,id", After: rawValues(`"b"`, `"b"`)}).Encode()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		listArgs *ListArguments
+		orderBy  []string
+	}{
+		{
+			name:     "should reject a token of a list with a different order",
+			listArgs: &ListArguments{Page: 1, Size: 2, PageToken: otherOrderToken},
+			orderBy:  []string{"name desc"},
+		},
+		{
+			name:     "should reject a malformed token",
+			listArgs: &ListArguments{Page: 1, Size: 2, PageToken: "not-a-token"},
+			orderBy:  []string{"name desc"},
+		},
+		{
+			name:     "should reject an unknown order by field",
+			listArgs: &ListArguments{Page: 1, Size: 2},
+			orderBy:  []string{"unknown"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			var items []paginatedItem
+			_, svcErr := ListPage(db.NewMockConnectionFactory(nil).New(), tt.listArgs, tt.orderBy, &items)
+			require.NotNil(t, svcErr)
+			assert.Equal(t, errors.ErrorBadRequest, svcErr.Code)
+		})
+	}
+}
+
+func rawValues(values ...string) (raw []json.RawMessage) {
+	for _, v := range values {
+		raw = append(raw, json.RawMessage(v))
+	}
+	return raw
+}
@@ -4,11 +4,21 @@ package services
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
-var validColumns = []string{"region", "name", "cloud_provider", "status", "owner"}
+// PublicColumns are the columns of central requests that can be searched by all users.
+var PublicColumns = []string{"region", "name", "cloud_provider", "status", "owner", "created_at", "updated_at"}
+
+// AdminColumns are the columns of central requests that can be searched by admins.
+var AdminColumns = append([]string{"cluster_id", "organisation_id", "instance_type", "desired_central_version"}, PublicColumns...)
+
+// timestampColumns are compared as timestamps. Their values must be RFC3339 timestamps or dates.
+var timestampColumns = []string{"created_at", "updated_at"}
+
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02"}
 
 // BraceTokenFamily ...
 const (
@@ -18,17 +28,33 @@ const (
 	ColumnTokenFamily      = "COLUMN"
 	ValueTokenFamily       = "VALUE"
 	QuotedValueTokenFamily = "QUOTED"
+	NullTokenFamily        = "NULL"
+	ListTokenFamily        = "LIST"
 
-	OpenBrace   = "OPEN_BRACE"
-	ClosedBrace = "CLOSED_BRACE"
-	Column      = "COLUMN"
-	Value       = "VALUE"
-	QuotedValue = "QUOTED_VALUE"
-	Eq          = "EQ"
-	NotEq       = "NOT_EQ"
-	LikeState   = "LIKE"
-	AndState    = "AND"
-	OrState     = "OR"
+	OpenBrace       = "OPEN_BRACE"
+	ClosedBrace     = "CLOSED_BRACE"
+	Column          = "COLUMN"
+	Value           = "VALUE"
+	QuotedValue     = "QUOTED_VALUE"
+	Eq              = "EQ"
+	NotEq           = "NOT_EQ"
+	Lt              = "LT"
+	Lte             = "LTE"
+	Gt              = "GT"
+	Gte             = "GTE"
+	LikeState       = "LIKE"
+	NotState        = "NOT"
+	InState         = "IN"
+	IsState         = "IS"
+	IsNotState      = "IS_NOT"
+	NullState       = "NULL"
+	OpenList        = "OPEN_LIST"
+	ListSeparator   = "LIST_SEPARATOR"
+	ClosedList      = "CLOSED_LIST"
+	ListValue       = "LIST_VALUE"
+	ListQuotedValue = "LIST_QUOTED_VALUE"
+	AndState        = "AND"
+	OrState         = "OR"
 )
 
 // MaximumComplexity ...
@@ -57,29 +83,59 @@ var _ QueryParser = &queryParser{}
 // initStateMachine
 // This will be our grammar (each Token will eat the spaces after the Token itself):
 // Tokens:
-// OPEN_BRACE       = (
-// CLOSED_BRACE     = )
-// COLUMN -         = [A-Za-z][A-Za-z0-9_]*
-// VALUE            = [^ ^(^)]+
-// QUOTED_VALUE     = `'([^']|\\')*'`
-// EQ               = =
-// NOT_EQ           = <>
-// LIKE             = [Ll][Ii][Kk][Ee]
-// AND              = [Aa][Nn][Dd]
-// OR               = [Oo][Rr]
+// OPEN_BRACE        = (
+// CLOSED_BRACE      = )
+// COLUMN -          = [A-Za-z][A-Za-z0-9_]*
+// VALUE             = [^ ^(^)]+
+// QUOTED_VALUE      = `'([^']|\\')*'`
+// EQ                = =
+// NOT_EQ            = <>
+// LT                = <
+// LTE               = <=
+// GT                = >
+// GTE               = >=
+// LIKE              = [Ll][Ii][Kk][Ee]
+// NOT               = [Nn][Oo][Tt]
+// IN                = [Ii][Nn]
+// IS                = [Ii][Ss]
+// IS_NOT            = [Nn][Oo][Tt]
+// NULL              = [Nn][Uu][Ll][Ll]
+// OPEN_LIST         = (
+// LIST_VALUE        = [^ ^(^)^,]+
+// LIST_QUOTED_VALUE = `'([^']|\\')*'`
+// LIST_SEPARATOR    = ,
+// CLOSED_LIST       = )
+// AND               = [Aa][Nn][Dd]
+// OR                = [Oo][Rr]
 //
 // VALID TRANSITIONS:
-// START        -> COLUMN | OPEN_BRACE
-// OPEN_BRACE   -> OPEN_BRACE | COLUMN
-// COLUMN       -> EQ | NOT_EQ | LIKE
-// EQ           -> VALUE | QUOTED_VALUE
-// NOT_EQ       -> VALUE | QUOTED_VALUE
-// LIKE         -> VALUE | QUOTED_VALUE
-// VALUE        -> OR | AND | CLOSED_BRACE | [END]
-// QUOTED_VALUE -> OR | AND | CLOSED_BRACE | [END]
-// CLOSED_BRACE -> OR | AND | CLOSED_BRACE | [END]
-// AND          -> COLUMN | OPEN_BRACE
-// OR           -> COLUMN | OPEN_BRACE
+// START             -> COLUMN | OPEN_BRACE
+// OPEN_BRACE        -> OPEN_BRACE | COLUMN
+// COLUMN            -> EQ | NOT_EQ | LT | LTE | GT | GTE | LIKE | NOT | IN | IS
+// EQ                -> VALUE | QUOTED_VALUE
+// NOT_EQ            -> VALUE | QUOTED_VALUE
+// LT                -> VALUE | QUOTED_VALUE
+// LTE               -> VALUE | QUOTED_VALUE
+// GT                -> VALUE | QUOTED_VALUE
+// GTE               -> VALUE | QUOTED_VALUE
+// LIKE              -> VALUE | QUOTED_VALUE
+// NOT               -> LIKE | IN
+// IN                -> OPEN_LIST
+// IS                -> IS_NOT | NULL
+// IS_NOT            -> NULL
+// OPEN_LIST         -> LIST_VALUE | LIST_QUOTED_VALUE
+// LIST_VALUE        -> LIST_SEPARATOR | CLOSED_LIST
+// LIST_QUOTED_VALUE -> LIST_SEPARATOR | CLOSED_LIST
+// LIST_SEPARATOR    -> LIST_VALUE | LIST_QUOTED_VALUE
+// VALUE             -> OR | AND | CLOSED_BRACE | [END]
+// QUOTED_VALUE      -> OR | AND | CLOSED_BRACE | [END]
+// NULL              -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_LIST       -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_BRACE      -> OR | AND | CLOSED_BRACE | [END]
+// AND               -> COLUMN | OPEN_BRACE
+// OR                -> COLUMN | OPEN_BRACE
+//
+// The comparison operators LT, LTE, GT and GTE are only valid for timestamp columns, LIKE is not.
 func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 
 	// counts the number of joins
@@ -109,6 +165,31 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 		return nil
 	}
 
+	// the column of the condition being parsed, used to validate operators and values
+	currentColumn := ""
+	addValue := func(value string) error {
+		if !contains(timestampColumns, currentColumn) {
+			p.dbqry.Values = append(p.dbqry.Values, value)
+			return nil
+		}
+		for _, layout := range timestampLayouts {
+			if timestamp, err := time.Parse(layout, value); err == nil {
+				p.dbqry.Values = append(p.dbqry.Values, timestamp)
+				return nil
+			}
+		}
+		return errors.Errorf("invalid timestamp '%s' for column '%s'", value, currentColumn)
+	}
+	unquote := func(value string) string {
+		// unescape
+		tmp := strings.ReplaceAll(value, `\'`, "'")
+		// remove quotes:
+		if len(tmp) > 1 {
+			tmp = string([]rune(tmp)[1 : len(tmp)-1])
+		}
+		return tmp
+	}
+
 	onNewToken := func(token *ParsedToken) error {
 		switch token.family {
 		case BraceTokenFamily:
@@ -118,18 +199,28 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			p.dbqry.Query += token.value
 			return nil
 		case ValueTokenFamily:
-			p.dbqry.Query += " ?"
-			p.dbqry.Values = append(p.dbqry.Values, token.value)
-			return nil
+			if token.tokenName == ListValue {
+				p.dbqry.Query += "?"
+			} else {
+				p.dbqry.Query += " ?"
+			}
+			return addValue(token.value)
 		case QuotedValueTokenFamily:
-			p.dbqry.Query += " ?"
-			// unescape
-			tmp := strings.ReplaceAll(token.value, `\'`, "'")
-			// remove quotes:
-			if len(tmp) > 1 {
-				tmp = string([]rune(tmp)[1 : len(tmp)-1])
+			if token.tokenName == ListQuotedValue {
+				p.dbqry.Query += "?"
+			} else {
+				p.dbqry.Query += " ?"
+			}
+			return addValue(unquote(token.value))
+		case ListTokenFamily:
+			switch token.tokenName {
+			case OpenList:
+				p.dbqry.Query += " ("
+			case ListSeparator:
+				p.dbqry.Query += ", "
+			default:
+				p.dbqry.Query += ")"
 			}
-			p.dbqry.Values = append(p.dbqry.Values, tmp)
 			return nil
 		case LogicalOpTokenFamily:
 			complexity++
@@ -144,8 +235,26 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			if !contains(p.dbqry.ValidColumns, columnName) {
 				return fmt.Errorf("invalid column name: '%s'", token.value)
 			}
+			currentColumn = columnName
 			p.dbqry.Query += columnName
 			return nil
+		case OpTokenFamily:
+			isTimestamp := contains(timestampColumns, currentColumn)
+			switch token.tokenName {
+			case Lt, Lte, Gt, Gte:
+				if !isTimestamp {
+					return errors.Errorf("operator '%s' is only supported for timestamp columns", token.value)
+				}
+			case LikeState:
+				if isTimestamp {
+					return errors.Errorf("operator '%s' is not supported for timestamp columns", token.value)
+				}
+			}
+			p.dbqry.Query += " " + strings.ToUpper(token.value)
+			return nil
+		case NullTokenFamily:
+			p.dbqry.Query += " NULL"
+			return nil
 		default:
 			p.dbqry.Query += " " + token.value
 			return nil
@@ -161,19 +270,47 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			{Name: QuotedValue, Family: QuotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
 			{Name: Eq, Family: OpTokenFamily, AcceptPattern: `=`},
 			{Name: NotEq, Family: OpTokenFamily, AcceptPattern: `<>`},
+			{Name: Lt, Family: OpTokenFamily, AcceptPattern: `<`},
+			{Name: Lte, Family: OpTokenFamily, AcceptPattern: `<=`},
+			{Name: Gt, Family: OpTokenFamily, AcceptPattern: `>`},
+			{Name: Gte, Family: OpTokenFamily, AcceptPattern: `>=`},
 			{Name: LikeState, Family: OpTokenFamily, AcceptPattern: `[Ll][Ii][Kk][Ee]`},
+			{Name: NotState, Family: OpTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: InState, Family: OpTokenFamily, AcceptPattern: `[Ii][Nn]`},
+			{Name: IsState, Family: OpTokenFamily, AcceptPattern: `[Ii][Ss]`},
+			{Name: IsNotState, Family: OpTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: NullState, Family: NullTokenFamily, AcceptPattern: `[Nn][Uu][Ll][Ll]`},
+			{Name: OpenList, Family: ListTokenFamily, AcceptPattern: `\(`},
+			{Name: ListValue, Family: ValueTokenFamily, AcceptPattern: `[^',][^ ^(^)^,]*`},
+			{Name: ListQuotedValue, Family: QuotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
+			{Name: ListSeparator, Family: ListTokenFamily, AcceptPattern: `,`},
+			{Name: ClosedList, Family: ListTokenFamily, AcceptPattern: `\)`},
 			{Name: AndState, Family: LogicalOpTokenFamily, AcceptPattern: `[Aa][Nn][Dd]`},
 			{Name: OrState, Family: LogicalOpTokenFamily, AcceptPattern: `[Oo][Rr]`},
 		},
 		Transitions: []TransitionDefinition{
 			{TokenName: StartState, ValidTransitions: []string{Column, OpenBrace}},
 			{TokenName: OpenBrace, ValidTransitions: []string{Column, OpenBrace}},
-			{TokenName: Column, ValidTransitions: []string{Eq, NotEq, LikeState}},
+			{TokenName: Column, ValidTransitions: []string{Eq, NotEq, Lt, Lte, Gt, Gte, LikeState, NotState, InState, IsState}},
 			{TokenName: Eq, ValidTransitions: []string{QuotedValue, Value}},
 			{TokenName: NotEq, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: Lt, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: Lte, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: Gt, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: Gte, ValidTransitions: []string{QuotedValue, Value}},
 			{TokenName: LikeState, ValidTransitions: []string{QuotedValue, Value}},
+			{TokenName: NotState, ValidTransitions: []string{LikeState, InState}},
+			{TokenName: InState, ValidTransitions: []string{OpenList}},
+			{TokenName: IsState, ValidTransitions: []string{IsNotState, NullState}},
+			{TokenName: IsNotState, ValidTransitions: []string{NullState}},
+			{TokenName: OpenList, ValidTransitions: []string{ListQuotedValue, ListValue}},
+			{TokenName: ListValue, ValidTransitions: []string{ListSeparator, ClosedList}},
+			{TokenName: ListQuotedValue, ValidTransitions: []string{ListSeparator, ClosedList}},
+			{TokenName: ListSeparator, ValidTransitions: []string{ListQuotedValue, ListValue}},
 			{TokenName: QuotedValue, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
 			{TokenName: Value, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
+			{TokenName: NullState, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
+			{TokenName: ClosedList, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
 			{TokenName: ClosedBrace, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
 			{TokenName: AndState, ValidTransitions: []string{Column, OpenBrace}},
 			{TokenName: OrState, ValidTransitions: []string{Column, OpenBrace}},
@@ -223,7 +360,7 @@ func (p *queryParser) Parse(sql string) (*DBQuery, error) {
 func NewQueryParser(columns ...string) QueryParser {
 	query := DBQuery{}
 	if len(columns) == 0 {
-		query.ValidColumns = validColumns
+		query.ValidColumns = PublicColumns
 	} else {
 		query.ValidColumns = columns
 	}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -11,6 +12,7 @@ func Test_QueryParser(t *testing.T) {
 	tests := []struct {
 		name      string
 		qry       string
+		columns   []string
 		outQry    string
 		outValues []interface{}
 		wantErr   bool
@@ -116,12 +118,94 @@ func Test_QueryParser(t *testing.T) {
 			qry:     "((cloud_provider = Value and name = value1) and (owner = value2 or region=b  ) or badcolumn=c or name=e and region LIKE '%test%'",
 			wantErr: true,
 		},
+		{
+			name:      "IN list",
+			qry:       "status in (ready, 'failed',provisioning)",
+			outQry:    "status IN (?, ?, ?)",
+			outValues: []interface{}{"ready", "failed", "provisioning"},
+		},
+		{
+			name:      "NOT IN list with quoted separator",
+			qry:       "region NOT IN ('us,east', eu-west-1) and name = test",
+			outQry:    "region NOT IN (?, ?) and name = ?",
+			outValues: []interface{}{"us,east", "eu-west-1", "test"},
+		},
+		{
+			name:    "Empty IN list",
+			qry:     "status in ()",
+			wantErr: true,
+		},
+		{
+			name:    "IN list with trailing separator",
+			qry:     "status in (ready,)",
+			wantErr: true,
+		},
+		{
+			name:    "Unterminated IN list",
+			qry:     "status in (ready",
+			wantErr: true,
+		},
+		{
+			name:      "NOT LIKE",
+			qry:       "name not like 'test%' or (owner like me%)",
+			outQry:    "name NOT LIKE ? or (owner LIKE ?)",
+			outValues: []interface{}{"test%", "me%"},
+		},
+		{
+			name:    "NOT without operator",
+			qry:     "name not test",
+			wantErr: true,
+		},
+		{
+			name:      "Timestamp comparisons",
+			qry:       "created_at >= 2023-05-01 and updated_at < '2023-05-02T10:00:00Z'",
+			outQry:    "created_at >= ? and updated_at < ?",
+			outValues: []interface{}{time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 5, 2, 10, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "Invalid timestamp",
+			qry:     "created_at > yesterday",
+			wantErr: true,
+		},
+		{
+			name:    "Comparison of a string column",
+			qry:     "name > test",
+			wantErr: true,
+		},
+		{
+			name:    "LIKE on a timestamp column",
+			qry:     "created_at like '2023%'",
+			wantErr: true,
+		},
+		{
+			name:      "IS NULL and IS NOT NULL",
+			qry:       "owner is null or (region IS NOT NULL and name = test)",
+			outQry:    "owner IS NULL or (region IS NOT NULL and name = ?)",
+			outValues: []interface{}{"test"},
+		},
+		{
+			name:    "IS without NULL",
+			qry:     "owner is test",
+			wantErr: true,
+		},
+		{
+			name:    "Admin column in public search",
+			qry:     "cluster_id = test",
+			wantErr: true,
+		},
+		{
+			name:      "Admin columns in admin search",
+			qry:       "cluster_id = test and organisation_id in (a, b) and instance_type = standard and desired_central_version like '4.%'",
+			columns:   AdminColumns,
+			outQry:    "cluster_id = ? and organisation_id IN (?, ?) and instance_type = ? and desired_central_version LIKE ?",
+			outValues: []interface{}{"test", "a", "b", "standard", "4.%"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			RegisterTestingT(t)
-			qry, err := NewQueryParser().Parse(tt.qry)
+			qry, err := NewQueryParser(tt.columns...).Parse(tt.qry)
 
 			if err != nil && !tt.wantErr {
 				t.Errorf("QueryParser() error = %v, wantErr = %v", err, tt.wantErr)
@@ -9,6 +9,7 @@ const (
 	Literal
 	QuotedLiteral
 	NoToken
+	Separator
 )
 
 // Token ...
@@ -86,6 +87,22 @@ func (s *scanner) Init(txt string) {
 				Value:     string(currentChar),
 				Position:  i,
 			})
+		case ',':
+			if quoted {
+				tokens = append(tokens, Token{
+					TokenType: QuotedLiteral,
+					Value:     ",",
+					Position:  i,
+				})
+			} else {
+				// found list separator Token
+				sendCurrentTokens()
+				s.tokens = append(s.tokens, Token{
+					TokenType: Separator,
+					Value:     ",",
+					Position:  i,
+				})
+			}
 		case '=':
 			fallthrough
 		case '<':
@@ -24,6 +24,12 @@ type ListArguments struct {
 	Preloads []string
 	Search   string
 	OrderBy  []string
+	// PageToken is the next_page_token of the previous page. It takes precedence over Page.
+	PageToken string
+	// Fields are the fields of the items to return, all fields are returned if it is empty.
+	Fields []string
+	// LabelSelector is a Kubernetes-style selector of the labels of the items, see ParseLabelSelector.
+	LabelSelector string
 }
 
 // NewListArguments - Create ListArguments from url query parameters with sane defaults
@@ -54,6 +60,19 @@ func NewListArguments(params url.Values) *ListArguments {
 			listArgs.OrderBy[i] = strings.Trim(s, " ")
 		}
 	}
+	if v := params.Get("page_token"); v != "" {
+		listArgs.PageToken = v
+	}
+	if v := params.Get("fields"); v != "" {
+		for _, field := range strings.Split(v, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				listArgs.Fields = append(listArgs.Fields, field)
+			}
+		}
+	}
+	if v := params.Get("label_selector"); v != "" {
+		listArgs.LabelSelector = v
+	}
 	return listArgs
 }
 
@@ -65,6 +84,19 @@ func (la *ListArguments) Validate() error {
 	if la.Size < 1 {
 		return errors.Errorf("size must be equal or greater than 1")
 	}
+	if la.PageToken != "" {
+		if la.Page > 1 {
+			return errors.Errorf("page and page_token must not be used together")
+		}
+		if _, err := DecodePageToken(la.PageToken); err != nil {
+			return errors.Errorf("invalid page_token")
+		}
+	}
+	if la.LabelSelector != "" {
+		if _, err := ParseLabelSelector(la.LabelSelector); err != nil {
+			return errors.Errorf("invalid label_selector: %v", err)
+		}
+	}
 
 	if len(la.OrderBy) > 0 {
 		space := regexp.MustCompile(`\s+`)
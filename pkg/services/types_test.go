@@ -83,3 +83,51 @@ func Test_ValidateOrderBy(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidatePageToken(t *testing.T) {
+	token, err := (&PageToken{Page: 2, OrderBy: "name,id", After: rawValues(`"a"`, `"b"`)}).Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		params  map[string][]string
+		wantErr bool
+	}{
+		{
+			name:    "Page token",
+			params:  map[string][]string{"page_token": {token}},
+			wantErr: false,
+		},
+		{
+			name:    "Page token with page",
+			params:  map[string][]string{"page_token": {token}, "page": {"2"}},
+			wantErr: true,
+		},
+		{
+			name:    "Malformed page token",
+			params:  map[string][]string{"page_token": {"not-a-token"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			la := NewListArguments(tt.params)
+			err := la.Validate()
+			if tt.wantErr {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func Test_NewListArgumentsFields(t *testing.T) {
+	RegisterTestingT(t)
+	la := NewListArguments(map[string][]string{"fields": {"name, status,,region"}})
+	Expect(la.Fields).To(Equal([]string{"name", "status", "region"}))
+}